@@ -0,0 +1,24 @@
+# Requires Terraform 1.14+
+
+# Fail over to the disaster-recovery cluster: stop replicating orders from the
+# primary cluster, reopen it as a regular index and make it the write index
+# of the orders-write alias.
+action "elasticstack_elasticsearch_ccr_promote" "orders" {
+  config {
+    index   = "orders"
+    aliases = ["orders-write"]
+  }
+}
+
+# Promote a data stream replicated by an auto-follow pattern. Every backing
+# index that is still a follower is unfollowed before the data stream itself
+# is promoted and starts accepting writes.
+action "elasticstack_elasticsearch_ccr_promote" "logs" {
+  config {
+    data_stream = "logs-app-default"
+
+    timeouts {
+      invoke = "30m"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+# Requires Terraform 1.14+
+
+# Move the indices stuck on a failed rollover to the warm phase.
+action "elasticstack_elasticsearch_ilm_move_to_step" "skip_rollover" {
+  config {
+    indices = ["logs-app-*"]
+
+    current_step = {
+      phase  = "hot"
+      action = "rollover"
+      name   = "ERROR"
+    }
+
+    next_step = {
+      phase = "warm"
+    }
+  }
+}
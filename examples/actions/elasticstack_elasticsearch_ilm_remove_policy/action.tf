@@ -0,0 +1,9 @@
+# Requires Terraform 1.14+
+
+# Stop managing the archived indices with the logs-app policy.
+action "elasticstack_elasticsearch_ilm_remove_policy" "archive" {
+  config {
+    indices = ["logs-app-archive-*"]
+    policy  = "logs-app"
+  }
+}
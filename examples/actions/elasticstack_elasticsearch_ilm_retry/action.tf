@@ -0,0 +1,27 @@
+# Requires Terraform 1.14+
+
+# Retry the indices that failed while the policy was being changed, once the
+# policy change is applied.
+action "elasticstack_elasticsearch_ilm_retry" "logs" {
+  config {
+    indices = ["logs-app-*"]
+    policy  = elasticstack_elasticsearch_index_lifecycle.logs.name
+  }
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "logs" {
+  name = "logs-app"
+
+  hot {
+    rollover {
+      max_age = "1d"
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.elasticstack_elasticsearch_ilm_retry.logs]
+    }
+  }
+}
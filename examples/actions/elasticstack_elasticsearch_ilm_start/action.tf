@@ -0,0 +1,4 @@
+# Requires Terraform 1.14+
+
+# Start ILM again after cluster maintenance.
+action "elasticstack_elasticsearch_ilm_start" "maintenance" {}
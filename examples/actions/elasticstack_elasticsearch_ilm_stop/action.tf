@@ -0,0 +1,13 @@
+# Requires Terraform 1.14+
+
+# Stop ILM before cluster maintenance, and wait until the operations in
+# progress complete.
+action "elasticstack_elasticsearch_ilm_stop" "maintenance" {
+  config {
+    wait_for_completion = true
+
+    timeouts {
+      invoke = "30m"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# Requires Terraform 1.14+
+
+# Stop writes to the old index before migrating its documents.
+action "elasticstack_elasticsearch_index_block" "freeze_writes" {
+  config {
+    indices = ["products-v1"]
+    block   = "write"
+  }
+}
@@ -0,0 +1,14 @@
+# Requires Terraform 1.14+
+
+# Clone an index before an experiment, without waiting for its replicas.
+action "elasticstack_elasticsearch_index_clone" "products" {
+  config {
+    source              = "products"
+    target              = "products-experiment"
+    wait_for_completion = false
+
+    settings = jsonencode({
+      "index.number_of_replicas" = 0
+    })
+  }
+}
@@ -0,0 +1,8 @@
+# Requires Terraform 1.14+
+
+# Close last year's indices to release their cluster resources.
+action "elasticstack_elasticsearch_index_close" "old_logs" {
+  config {
+    indices = ["logs-2025.*"]
+  }
+}
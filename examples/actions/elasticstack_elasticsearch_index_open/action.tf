@@ -0,0 +1,9 @@
+# Requires Terraform 1.14+
+
+# Reopen an archived index and wait until all its shard copies are started.
+action "elasticstack_elasticsearch_index_open" "audit" {
+  config {
+    indices                = ["logs-2025.03.14"]
+    wait_for_active_shards = "all"
+  }
+}
@@ -0,0 +1,25 @@
+# Requires Terraform 1.14+
+
+# Shrink a hot index to a single shard. The action adds a write block to the
+# source, relocates a copy of every shard to shrink-node-1, and waits until
+# the target is green.
+action "elasticstack_elasticsearch_index_shrink" "metrics" {
+  config {
+    source           = "metrics-2026.10"
+    target           = "metrics-2026.10-shrunk"
+    number_of_shards = 1
+    shrink_node      = "shrink-node-1"
+
+    settings = jsonencode({
+      "index.codec" = "best_compression"
+    })
+
+    aliases = jsonencode({
+      "metrics-archive" = {}
+    })
+
+    timeouts {
+      invoke = "1h"
+    }
+  }
+}
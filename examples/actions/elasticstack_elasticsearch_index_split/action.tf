@@ -0,0 +1,12 @@
+# Requires Terraform 1.14+
+
+# Split an index that outgrew its shards. The source is expected to be
+# read-only already.
+action "elasticstack_elasticsearch_index_split" "orders" {
+  config {
+    source           = "orders-v1"
+    target           = "orders-v2"
+    number_of_shards = 6
+    add_write_block  = false
+  }
+}
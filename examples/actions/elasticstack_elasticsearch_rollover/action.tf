@@ -0,0 +1,33 @@
+# Requires Terraform 1.14+
+
+# Roll the data stream over whenever its index template changes, so new
+# mappings apply to incoming documents right away.
+action "elasticstack_elasticsearch_rollover" "logs" {
+  config {
+    target = elasticstack_elasticsearch_data_stream.logs.name
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "logs" {
+  name           = "logs-app"
+  index_patterns = ["logs-app-*"]
+
+  data_stream {}
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.elasticstack_elasticsearch_rollover.logs]
+    }
+  }
+}
+
+# Conditional rollover: only rolls over when one of the conditions is met.
+action "elasticstack_elasticsearch_rollover" "conditional" {
+  config {
+    target                 = "logs-app-default"
+    max_age                = "7d"
+    max_docs               = 10000000
+    max_primary_shard_size = "50gb"
+  }
+}
@@ -0,0 +1,8 @@
+# Requires Terraform 1.14+
+
+action "elasticstack_fleet_agents_reassign" "canary" {
+  config {
+    kuery     = "tags:canary"
+    policy_id = elasticstack_fleet_agent_policy.canary.policy_id
+  }
+}
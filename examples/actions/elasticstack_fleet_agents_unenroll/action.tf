@@ -0,0 +1,8 @@
+# Requires Terraform 1.14+
+
+action "elasticstack_fleet_agents_unenroll" "decommissioned" {
+  config {
+    agent_ids = ["3f2b5a2e-6d3c-4c7a-9a0e-1b2c3d4e5f60"]
+    revoke    = true
+  }
+}
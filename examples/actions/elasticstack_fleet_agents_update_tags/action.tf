@@ -0,0 +1,9 @@
+# Requires Terraform 1.14+
+
+action "elasticstack_fleet_agents_update_tags" "promote" {
+  config {
+    kuery          = "tags:canary and status:online"
+    tags_to_add    = ["stable"]
+    tags_to_remove = ["canary"]
+  }
+}
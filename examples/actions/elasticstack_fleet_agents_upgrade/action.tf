@@ -0,0 +1,31 @@
+# Requires Terraform 1.14+
+
+resource "elasticstack_fleet_agent_policy" "edge" {
+  name              = "Edge agents"
+  namespace         = "default"
+  required_versions = { "9.1.0" = 100 }
+}
+
+action "elasticstack_fleet_agents_upgrade" "edge" {
+  config {
+    kuery            = "policy_id:\"${elasticstack_fleet_agent_policy.edge.policy_id}\""
+    version          = "9.1.0"
+    rollout_duration = "2h"
+    start_time       = "2026-01-01T02:00:00Z"
+
+    timeouts {
+      invoke = "3h"
+    }
+  }
+}
+
+resource "terraform_data" "upgrade_edge" {
+  input = "9.1.0"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.elasticstack_fleet_agents_upgrade.edge]
+    }
+  }
+}
@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ccr_follower_stats" "all" {}
+
+# Fail the check when a follower has stopped replicating or falls behind the
+# leader, so a disaster-recovery drill does not promote stale data.
+check "ccr_followers_healthy" {
+  assert {
+    condition = length([
+      for f in data.elasticstack_elasticsearch_ccr_follower_stats.all.followers : f.index
+      if length(f.fatal_exceptions) > 0 || coalesce(f.operations_lag, 0) > 1000
+    ]) == 0
+    error_message = "Unhealthy CCR followers: ${join(", ", [
+      for f in data.elasticstack_elasticsearch_ccr_follower_stats.all.followers : f.index
+      if length(f.fatal_exceptions) > 0 || coalesce(f.operations_lag, 0) > 1000
+    ])}"
+  }
+}
@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Fails at plan time when the component template does not exist.
+data "elasticstack_elasticsearch_component_template" "mappings" {
+  name = "logs-platform@mappings"
+}
+
+resource "elasticstack_elasticsearch_index_template" "app" {
+  name           = "logs-app"
+  index_patterns = ["logs-app-*"]
+  composed_of    = [data.elasticstack_elasticsearch_component_template.mappings.name]
+}
+
+# Compose every component template published by the platform team.
+data "elasticstack_elasticsearch_component_template" "platform" {
+  name_pattern = "logs-platform@*"
+}
+
+output "platform_component_templates" {
+  value = [for t in data.elasticstack_elasticsearch_component_template.platform.component_templates : t.name]
+}
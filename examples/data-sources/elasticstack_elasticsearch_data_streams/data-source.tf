@@ -0,0 +1,27 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Data streams created by the nginx Fleet integration, with their size.
+data "elasticstack_elasticsearch_data_streams" "nginx" {
+  name          = "logs-nginx.*"
+  include_stats = true
+}
+
+# Keep the nginx logs for 90 days, overriding the retention of the
+# integration's index template on every data stream it created.
+resource "elasticstack_elasticsearch_data_stream_lifecycle" "nginx" {
+  for_each = {
+    for ds in data.elasticstack_elasticsearch_data_streams.nginx.data_streams : ds.name => ds
+    if ds.managed_by == "Data stream lifecycle"
+  }
+
+  name           = each.key
+  data_retention = "90d"
+}
+
+output "nginx_store_size_bytes" {
+  value = {
+    for ds in data.elasticstack_elasticsearch_data_streams.nginx.data_streams : ds.name => ds.store_size_bytes
+  }
+}
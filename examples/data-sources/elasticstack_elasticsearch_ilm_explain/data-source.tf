@@ -0,0 +1,21 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+data "elasticstack_elasticsearch_ilm_explain" "logs" {
+  index        = "logs-*"
+  only_managed = true
+}
+
+# Fail the check when any managed index is stuck, for example after a policy
+# change that its current phase cannot satisfy.
+check "logs_ilm_healthy" {
+  assert {
+    condition = length([
+      for i in data.elasticstack_elasticsearch_ilm_explain.logs.indices : i.index if i.step == "ERROR"
+    ]) == 0
+    error_message = "ILM is stuck in ERROR for: ${join(", ", [
+      for i in data.elasticstack_elasticsearch_ilm_explain.logs.indices : "${i.index} (${i.failed_step})" if i.step == "ERROR"
+    ])}"
+  }
+}
@@ -0,0 +1,35 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+# Fails at plan time when the policy owned by another team does not exist.
+data "elasticstack_elasticsearch_index_lifecycle" "logs" {
+  name = "logs-platform"
+}
+
+resource "elasticstack_elasticsearch_index_template" "app" {
+  name           = "logs-app"
+  index_patterns = ["logs-app-*"]
+
+  template {
+    settings = jsonencode({
+      "index.lifecycle.name" = data.elasticstack_elasticsearch_index_lifecycle.logs.name
+    })
+  }
+}
+
+check "logs_policy_deletes_data" {
+  assert {
+    condition     = data.elasticstack_elasticsearch_index_lifecycle.logs.delete != null
+    error_message = "The logs-platform policy has no delete phase."
+  }
+}
+
+# List every policy managed by the platform team.
+data "elasticstack_elasticsearch_index_lifecycle" "platform" {
+  name_pattern = "logs-platform*,metrics-platform*"
+}
+
+output "platform_policies" {
+  value = [for p in data.elasticstack_elasticsearch_index_lifecycle.platform.policies : p.name]
+}
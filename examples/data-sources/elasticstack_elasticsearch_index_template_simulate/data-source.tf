@@ -0,0 +1,41 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_component_template" "logs_settings" {
+  name = "logs-myapp@settings"
+
+  template {
+    settings = jsonencode({
+      number_of_shards = "2"
+    })
+  }
+}
+
+# Resolve the effective shape of a new index, after all matching templates
+# are composed by priority.
+data "elasticstack_elasticsearch_index_template_simulate" "myapp" {
+  index_name = "logs-myapp-default"
+
+  depends_on = [elasticstack_elasticsearch_component_template.logs_settings]
+}
+
+check "myapp_shards" {
+  assert {
+    condition     = jsondecode(data.elasticstack_elasticsearch_index_template_simulate.myapp.settings).index.number_of_shards == "2"
+    error_message = "New logs-myapp indices do not get 2 primary shards."
+  }
+}
+
+# Simulate an index template definition before it is written.
+data "elasticstack_elasticsearch_index_template_simulate" "draft" {
+  template = jsonencode({
+    index_patterns = ["logs-myapp-*"]
+    priority       = 500
+    composed_of    = [elasticstack_elasticsearch_component_template.logs_settings.name]
+  })
+}
+
+output "draft_overlapping_templates" {
+  value = [for t in data.elasticstack_elasticsearch_index_template_simulate.draft.overlapping : t.name]
+}
@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "edge" {
+  name      = "Edge agents"
+  namespace = "default"
+}
+
+data "elasticstack_fleet_agent_policy_full" "edge" {
+  policy_id = elasticstack_fleet_agent_policy.edge.policy_id
+}
+
+resource "kubernetes_config_map" "elastic_agent" {
+  metadata {
+    name      = "elastic-agent-config"
+    namespace = "kube-system"
+  }
+
+  data = {
+    "elastic-agent.yml" = data.elasticstack_fleet_agent_policy_full.edge.content
+  }
+}
@@ -0,0 +1,23 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "edge" {
+  name      = "Edge agents"
+  namespace = "default"
+}
+
+data "elasticstack_fleet_agents" "edge" {
+  policy_id = elasticstack_fleet_agent_policy.edge.policy_id
+  statuses  = ["online", "unhealthy", "updating"]
+}
+
+check "edge_rollout" {
+  assert {
+    condition = alltrue([
+      for agent in data.elasticstack_fleet_agents.edge.agents :
+      agent.status == "online" && alltrue([for c in agent.components : c.status == "HEALTHY"])
+    ])
+    error_message = "Not every edge agent is online and healthy."
+  }
+}
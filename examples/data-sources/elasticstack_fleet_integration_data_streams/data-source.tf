@@ -0,0 +1,17 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_fleet_integration_data_streams" "tcp_prod" {
+  package   = "tcp"
+  namespace = "prod"
+}
+
+output "tcp_prod_data_streams" {
+  value = {
+    for ds in data.elasticstack_fleet_integration_data_streams.tcp_prod.data_streams : ds.name => {
+      size_in_bytes = ds.size_in_bytes
+      last_activity = ds.last_activity
+    }
+  }
+}
@@ -0,0 +1,11 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_fleet_output_health" "remote" {
+  output_id = "remote-elasticsearch-output"
+}
+
+output "remote_output_health" {
+  value = "${data.elasticstack_fleet_output_health.remote.state}: ${data.elasticstack_fleet_output_health.remote.message}"
+}
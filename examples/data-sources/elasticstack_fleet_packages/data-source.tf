@@ -0,0 +1,19 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_fleet_packages" "catalog" {
+  category = "network"
+  version_constraints = {
+    tcp = "~> 1.16"
+  }
+}
+
+resource "elasticstack_fleet_integration" "tcp" {
+  name    = "tcp"
+  version = data.elasticstack_fleet_packages.catalog.resolved_versions["tcp"]
+}
+
+output "network_packages" {
+  value = [for p in data.elasticstack_fleet_packages.catalog.packages : "${p.name} ${p.latest_version}"]
+}
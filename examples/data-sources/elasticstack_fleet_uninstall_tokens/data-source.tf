@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_fleet_uninstall_tokens" "endpoints" {
+  policy_id = elasticstack_fleet_agent_policy.endpoints.policy_id
+}
+
+resource "vault_kv_secret_v2" "uninstall_token" {
+  mount = "secret"
+  name  = "fleet/endpoints/uninstall-token"
+
+  data_json = jsonencode({
+    token = data.elasticstack_fleet_uninstall_tokens.endpoints.token
+  })
+}
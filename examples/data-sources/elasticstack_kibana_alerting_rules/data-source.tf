@@ -0,0 +1,16 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_kibana_alerting_rules" "production" {
+  space_id = "default"
+  tags     = ["production"]
+  enabled  = true
+}
+
+check "no_rules_in_error" {
+  assert {
+    condition     = alltrue([for rule in data.elasticstack_kibana_alerting_rules.production.rules : rule.last_execution_status != "error"])
+    error_message = "At least one production alerting rule is in error state."
+  }
+}
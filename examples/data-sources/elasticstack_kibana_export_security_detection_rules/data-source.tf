@@ -0,0 +1,14 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+data "elasticstack_kibana_export_security_detection_rules" "staging" {
+  space_id = "staging"
+  rule_ids = ["0d5a5f8e-8e3c-4c4f-9a3a-2f8b1d9c6e11", "7b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"]
+}
+
+resource "elasticstack_kibana_import_security_detection_rules" "production" {
+  space_id      = "production"
+  file_contents = data.elasticstack_kibana_export_security_detection_rules.staging.exported_rules
+  overwrite     = true
+}
@@ -0,0 +1,14 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test_policy" {
+  name        = "Test Policy"
+  namespace   = "default"
+  description = "Test Agent Policy"
+}
+
+ephemeral "elasticstack_fleet_enrollment_token" "bootstrap" {
+  policy_id = elasticstack_fleet_agent_policy.test_policy.policy_id
+  name      = "bootstrap"
+}
@@ -43,6 +43,9 @@ resource "elasticstack_elasticsearch_index_template" "my_data_stream_template" {
 resource "elasticstack_elasticsearch_data_stream" "my_data_stream" {
   name = "my-stream"
 
+  // roll over on the next write once the index template changes
+  rollover_on_template_change = "lazy"
+
   // make sure that template is created before the data stream
   depends_on = [
     elasticstack_elasticsearch_index_template.my_data_stream_template
@@ -31,3 +31,18 @@ resource "elasticstack_elasticsearch_index" "my_index" {
   number_of_replicas = 2
   search_idle_after  = "20s"
 }
+
+# Changing number_of_shards or the analysis settings of this index reindexes
+# it into "products-v2" and moves the "products-search" alias, instead of
+# replacing it. Clients should read and write through the alias.
+resource "elasticstack_elasticsearch_index" "migrated" {
+  name                = "products"
+  deletion_protection = false
+
+  number_of_shards = 2
+
+  migration = {
+    alias  = "products-search"
+    slices = "auto"
+  }
+}
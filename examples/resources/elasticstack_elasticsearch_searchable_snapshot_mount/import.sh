@@ -0,0 +1 @@
+terraform import elasticstack_elasticsearch_searchable_snapshot_mount.logs_2025 <mounted_index_name>
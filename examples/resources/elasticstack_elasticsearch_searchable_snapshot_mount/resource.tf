@@ -0,0 +1,26 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "archive" {
+  name = "archive"
+
+  fs {
+    location = "/mount/backups/archive"
+  }
+}
+
+# Keep last year's logs searchable from the frozen tier.
+resource "elasticstack_elasticsearch_searchable_snapshot_mount" "logs_2025" {
+  repository    = elasticstack_elasticsearch_snapshot_repository.archive.name
+  snapshot      = "logs-2025"
+  index         = "logs-2025"
+  renamed_index = "archive-logs-2025"
+  storage       = "shared_cache"
+
+  index_settings = jsonencode({
+    "index.routing.allocation.include._tier_preference" = "data_frozen"
+  })
+
+  ignore_index_settings = ["index.refresh_interval"]
+}
@@ -0,0 +1,8 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_fleet_settings" "settings" {
+  prerelease_integrations_enabled = false
+  delete_unenrolled_agents        = true
+}
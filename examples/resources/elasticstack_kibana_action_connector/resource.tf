@@ -131,3 +131,26 @@ resource "elasticstack_kibana_action_connector" "teams-connector" {
     webhookUrl = "<your-teams-webhook-url>"
   })
 }
+
+# Typed blocks are available for common connector types as an alternative to
+# the `config` and `secrets` JSON attributes. They are validated at plan time
+# and default optional values the same way Kibana does.
+resource "elasticstack_kibana_action_connector" "typed-webhook" {
+  name              = "typed-webhook"
+  connector_type_id = ".webhook"
+  webhook = {
+    url       = "<your-webhookUrl>"
+    has_auth  = true
+    auth_type = "webhook-authentication-basic"
+    user      = "<your-user>"
+    password  = "<your-password>"
+  }
+}
+
+resource "elasticstack_kibana_action_connector" "typed-index" {
+  name              = "typed-index"
+  connector_type_id = ".index"
+  index = {
+    index = ".kibana"
+  }
+}
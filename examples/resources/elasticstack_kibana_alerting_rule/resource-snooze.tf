@@ -0,0 +1,37 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+// Snooze the rule every Saturday night during a maintenance migration and mute one noisy alert.
+resource "elasticstack_kibana_alerting_rule" "snoozed" {
+  name         = "snoozed-rule"
+  consumer     = "alerts"
+  rule_type_id = ".index-threshold"
+  interval     = "1m"
+
+  params = jsonencode({
+    aggType             = "count"
+    thresholdComparator = ">"
+    timeWindowSize      = 10
+    timeWindowUnit      = "m"
+    groupBy             = "top"
+    termField           = "host.name"
+    termSize            = 10
+    threshold           = [100]
+    index               = ["logs-*"]
+    timeField           = "@timestamp"
+  })
+
+  muted_alert_ids = ["noisy-host-01"]
+
+  snooze_schedule = [{
+    start    = "2026-01-03T22:00:00.000Z"
+    duration = "4h"
+    timezone = "Europe/Paris"
+    recurring = {
+      every       = "1w"
+      on_week_day = ["SA"]
+      end         = "2026-03-01T00:00:00.000Z"
+    }
+  }]
+}
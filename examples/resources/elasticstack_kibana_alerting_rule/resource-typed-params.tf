@@ -0,0 +1,58 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+// Typed params are an alternative to the raw `params` JSON for common rule types.
+resource "elasticstack_kibana_alerting_rule" "index_threshold" {
+  name         = "typed-index-threshold"
+  consumer     = "alerts"
+  rule_type_id = ".index-threshold"
+  interval     = "1m"
+
+  index_threshold_params = {
+    index                = ["logs-*"]
+    time_field           = "@timestamp"
+    agg_type             = "avg"
+    agg_field            = "event.duration"
+    time_window_size     = 5
+    time_window_unit     = "m"
+    threshold            = [1000]
+    threshold_comparator = ">"
+  }
+}
+
+resource "elasticstack_kibana_alerting_rule" "esql" {
+  name         = "typed-esql-query"
+  consumer     = "alerts"
+  rule_type_id = ".es-query"
+  interval     = "5m"
+
+  es_query_params = {
+    search_type          = "esqlQuery"
+    esql_query           = { esql = "FROM logs-* | WHERE log.level == \"error\" | STATS count = COUNT(*)" }
+    time_field           = "@timestamp"
+    size                 = 0
+    time_window_size     = 5
+    time_window_unit     = "m"
+    threshold            = [0]
+    threshold_comparator = ">"
+  }
+}
+
+resource "elasticstack_kibana_alerting_rule" "slo_burn_rate" {
+  name         = "typed-slo-burn-rate"
+  consumer     = "slo"
+  rule_type_id = "slo.rules.burnRate"
+  interval     = "1m"
+
+  slo_burn_rate_params = {
+    slo_id = "my-slo-id"
+    windows = [{
+      id                  = "high"
+      burn_rate_threshold = 14.4
+      long_window         = { value = 1, unit = "h" }
+      short_window        = { value = 5, unit = "m" }
+      action_group        = "slo.burnRate.high"
+    }]
+  }
+}
@@ -0,0 +1,15 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_import_security_detection_rules" "detections" {
+  space_id                    = "security"
+  file_contents               = file("${path.module}/rules_export.ndjson")
+  overwrite                   = true
+  overwrite_exceptions        = true
+  overwrite_action_connectors = true
+}
+
+output "drifted_rules" {
+  value = [for rule in elasticstack_kibana_import_security_detection_rules.detections.rules : rule.rule_id if rule.status != "in_sync"]
+}
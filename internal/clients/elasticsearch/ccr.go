@@ -54,6 +54,30 @@ func GetFollowerIndex(ctx context.Context, apiClient *clients.ElasticsearchScope
 	return nil, nil
 }
 
+// GetFollowerIndices returns the follower indices matching index, which may be
+// a comma-separated list, a wildcard pattern or _all.
+func GetFollowerIndices(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) ([]types.FollowerIndex, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, diags := CallOrNotFound(func() (*followinfo.Response, error) {
+		return typedClient.Ccr.FollowInfo(index).Do(ctx)
+	})
+	if diags.HasError() || res == nil {
+		return nil, diags
+	}
+	return res.FollowerIndices, nil
+}
+
+// GetFollowStats returns the shard-level replication stats of every active
+// follower index from the cross-cluster replication stats API.
+func GetFollowStats(ctx context.Context, apiClient *clients.ElasticsearchScopedClient) ([]types.FollowIndexStats, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Ccr.Stats().Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res.FollowStats.Indices, nil
+}
+
 func PauseFollowerIndex(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, indexName string) fwdiags.Diagnostics {
 	typedClient := apiClient.GetESClient()
 	_, err := typedClient.Ccr.PauseFollow(indexName).Do(ctx)
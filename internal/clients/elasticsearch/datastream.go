@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/datastreamsstats"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/getdatastream"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/expandwildcard"
@@ -55,12 +56,49 @@ func GetDataStream(ctx context.Context, apiClient *clients.ElasticsearchScopedCl
 	return &ds, nil
 }
 
+// GetDataStreams returns the data streams matching name, which may be a
+// comma-separated list or a wildcard pattern.
+func GetDataStreams(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, name string) ([]types.DataStream, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, diags := CallOrNotFound(func() (*getdatastream.Response, error) {
+		return typedClient.Indices.GetDataStream().Name(name).Do(ctx)
+	})
+	if diags.HasError() || res == nil {
+		return nil, diags
+	}
+	return res.DataStreams, nil
+}
+
+// GetDataStreamsStats returns the store size and maximum timestamp of the data
+// streams matching name.
+func GetDataStreamsStats(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, name string) ([]types.DataStreamsStatsItem, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, diags := CallOrNotFound(func() (*datastreamsstats.Response, error) {
+		return typedClient.Indices.DataStreamsStats().Name(name).Do(ctx)
+	})
+	if diags.HasError() || res == nil {
+		return nil, diags
+	}
+	return res.DataStreams, nil
+}
+
 func DeleteDataStream(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, dataStreamName string) fwdiags.Diagnostics {
 	typedClient := apiClient.GetESClient()
 	_, err := typedClient.Indices.DeleteDataStream(dataStreamName).Do(ctx)
 	return DiagsOrNotFound(err)
 }
 
+// PromoteDataStream turns a replicated data stream into a regular data stream
+// that accepts rollovers and writes.
+func PromoteDataStream(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, dataStreamName string) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.Indices.PromoteDataStream(dataStreamName).Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
 func PutDataStreamLifecycle(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, dataStreamName string, expandWildcards string, lifecycle models.LifecycleSettings) fwdiags.Diagnostics {
 	typedClient := apiClient.GetESClient()
 
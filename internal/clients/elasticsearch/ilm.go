@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/elastic/go-elasticsearch/v8/typedapi/ilm/movetostep"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/ilm/putlifecycle"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
@@ -70,6 +71,16 @@ func GetIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, p
 	}
 }
 
+// GetIlms returns every ILM policy on the cluster, keyed by policy name.
+func GetIlms(ctx context.Context, apiClient *clients.ElasticsearchScopedClient) (map[string]types.Lifecycle, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Ilm.GetLifecycle().Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
 // GetIndicesWithILMPolicy returns the names of all indices currently using
 // the given ILM policy.
 //
@@ -134,6 +145,101 @@ func ClearILMPolicyFromIndices(ctx context.Context, apiClient *clients.Elasticse
 	return nil
 }
 
+// ExplainIlm returns the lifecycle state of the indices matching target, keyed
+// by index name. The typed client decodes managed and unmanaged indices into
+// distinct types behind an interface, so the body is decoded here instead.
+func ExplainIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, target string, onlyErrors, onlyManaged bool) (map[string]models.IlmExplainIndex, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	req := typedClient.Ilm.ExplainLifecycle(target)
+	if onlyErrors {
+		req = req.OnlyErrors(true)
+	}
+	if onlyManaged {
+		req = req.OnlyManaged(true)
+	}
+	res, err := req.Perform(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	defer res.Body.Close()
+
+	if diags := diagutil.CheckHTTPErrorFromFW(res, fmt.Sprintf("Unable to explain the lifecycle of %q", target)); diags.HasError() {
+		return nil, diags
+	}
+
+	var explained models.IlmExplainResponse
+	if err := json.NewDecoder(res.Body).Decode(&explained); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return explained.Indices, nil
+}
+
+// MoveIlmToStep moves index from currentStep to nextStep of its lifecycle
+// policy. Elasticsearch rejects the request when the index is not in
+// currentStep.
+func MoveIlmToStep(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string, currentStep, nextStep types.StepKey) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.Ilm.MoveToStep(index).Request(&movetostep.Request{
+		CurrentStep: currentStep,
+		NextStep:    nextStep,
+	}).Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
+// RetryIlm retries the failed lifecycle step of index.
+func RetryIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.Ilm.Retry(index).Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
+// RemoveIlmPolicy removes the lifecycle policy from index and stops managing
+// it. It returns the indices from which the policy could not be removed.
+func RemoveIlmPolicy(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) ([]string, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Ilm.RemovePolicy(index).Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res.FailedIndexes, nil
+}
+
+// GetIlmStatus returns the ILM operation mode: RUNNING, STOPPING or STOPPED.
+func GetIlmStatus(ctx context.Context, apiClient *clients.ElasticsearchScopedClient) (string, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Ilm.GetStatus().Do(ctx)
+	if err != nil {
+		return "", diagutil.FrameworkDiagFromError(err)
+	}
+	return res.OperationMode.String(), nil
+}
+
+func StartIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.Ilm.Start().Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
+// StopIlm requests ILM to stop. ILM is STOPPING until the operations in
+// progress complete, and STOPPED afterwards.
+func StopIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.Ilm.Stop().Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
 func DeleteIlm(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, policyName string) fwdiags.Diagnostics {
 	typedClient := apiClient.GetESClient()
 	_, err := typedClient.Ilm.DeleteLifecycle(policyName).Do(ctx)
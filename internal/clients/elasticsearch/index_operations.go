@@ -0,0 +1,190 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/cluster/health"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/addblock"
+	indicesclose "github.com/elastic/go-elasticsearch/v8/typedapi/indices/close"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/open"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	fwdiags "github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ResizeOperation is the index resize API to call: shrink, split or clone.
+type ResizeOperation string
+
+const (
+	ResizeShrink ResizeOperation = "shrink"
+	ResizeSplit  ResizeOperation = "split"
+	ResizeClone  ResizeOperation = "clone"
+)
+
+// ResizeIndexRequest holds the body fields for POST /{index}/_{operation}/{target}.
+type ResizeIndexRequest struct {
+	Settings map[string]any
+	Aliases  json.RawMessage
+}
+
+// AddIndexBlock adds block to the indices matching index and returns the
+// per-index block status.
+func AddIndexBlock(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index, block string) (*addblock.Response, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Indices.AddBlock(index, block).Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
+// OpenIndices opens the closed indices matching index. When waitForActiveShards
+// is nil Elasticsearch waits for the primary shards.
+func OpenIndices(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string, waitForActiveShards *string) (*open.Response, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	req := typedClient.Indices.Open(index)
+	if waitForActiveShards != nil {
+		req = req.WaitForActiveShards(*waitForActiveShards)
+	}
+	res, err := req.Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
+// CloseIndices closes the open indices matching index and returns the
+// per-index result.
+func CloseIndices(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string, waitForActiveShards *string) (*indicesclose.Response, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	req := typedClient.Indices.Close(index)
+	if waitForActiveShards != nil {
+		req = req.WaitForActiveShards(*waitForActiveShards)
+	}
+	res, err := req.Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
+// ResizeIndex shrinks, splits or clones source into the new index target.
+// The source must be read-only; shrink also requires a copy of every shard
+// on a single node.
+func ResizeIndex(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, operation ResizeOperation, source, target string, body *ResizeIndexRequest) fwdiags.Diagnostics {
+	payload := map[string]any{}
+	if body != nil {
+		if len(body.Settings) > 0 {
+			payload["settings"] = body.Settings
+		}
+		if len(body.Aliases) > 0 {
+			payload["aliases"] = body.Aliases
+		}
+	}
+	bodyBytes, err := json.Marshal(payload)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+
+	typedClient := apiClient.GetESClient()
+	switch operation {
+	case ResizeShrink:
+		_, err = typedClient.Indices.Shrink(source, target).Raw(bytes.NewReader(bodyBytes)).Do(ctx)
+	case ResizeSplit:
+		_, err = typedClient.Indices.Split(source, target).Raw(bytes.NewReader(bodyBytes)).Do(ctx)
+	case ResizeClone:
+		_, err = typedClient.Indices.Clone(source, target).Raw(bytes.NewReader(bodyBytes)).Do(ctx)
+	default:
+		err = fmt.Errorf("unsupported resize operation %q", operation)
+	}
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
+
+// GetIndexFlatSettings returns the settings of index with dot-separated keys,
+// or nil when the index does not exist.
+func GetIndexFlatSettings(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) (map[string]any, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Indices.GetSettings().Index(index).FlatSettings(true).Perform(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	defer res.Body.Close()
+
+	if notFound, diags := diagutil.CheckHTTPErrorOrNotFound(res, fmt.Sprintf("Unable to get the settings of index %q", index)); notFound || diags.HasError() {
+		return nil, diags
+	}
+
+	var settings map[string]struct {
+		Settings map[string]any `json:"settings"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&settings); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	indexSettings, ok := settings[index]
+	if !ok {
+		return nil, nil
+	}
+	return indexSettings.Settings, nil
+}
+
+// GetIndexHealth returns the cluster health restricted to index.
+func GetIndexHealth(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) (*health.Response, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Cluster.Health().Index(index).Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
+// GetIndexShards returns every shard copy of index with its state and the
+// node it is allocated to, from the cat shards API.
+func GetIndexShards(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) ([]types.ShardsRecord, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Cat.Shards().Index(index).Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
+
+// GetClosedIndices returns the names of the indices matching index that are
+// closed, from the cat indices API.
+func GetClosedIndices(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) ([]string, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Cat.Indices().Index(index).H("index", "status").Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	var closed []string
+	for _, record := range res {
+		if record.Index != nil && record.Status != nil && *record.Status == "close" {
+			closed = append(closed, *record.Index)
+		}
+	}
+	return closed, nil
+}
@@ -0,0 +1,137 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/reindex"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ReindexRequest holds the body fields and query parameters for POST /_reindex.
+type ReindexRequest struct {
+	Source   string
+	Dest     string
+	Pipeline string
+	Script   map[string]any
+	Slices   string
+}
+
+// ReindexTaskStatus is the outcome of a reindex task as reported by GET /_tasks/{task_id}.
+type ReindexTaskStatus struct {
+	Completed bool
+	Total     int64
+	Created   int64
+	Noops     int64
+	// Failures holds the task error and any per-document failures; a completed
+	// task with failures did not copy every document.
+	Failures []string
+}
+
+// StartReindex submits a reindex without waiting for completion and returns
+// the task ID to poll with [GetReindexTask].
+func StartReindex(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, req ReindexRequest) (string, fwdiag.Diagnostics) {
+	dest := map[string]any{"index": req.Dest}
+	if req.Pipeline != "" {
+		dest["pipeline"] = req.Pipeline
+	}
+	body := map[string]any{
+		"source": map[string]any{"index": req.Source},
+		"dest":   dest,
+	}
+	if len(req.Script) > 0 {
+		body["script"] = req.Script
+	}
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return "", diagutil.FrameworkDiagFromError(err)
+	}
+
+	typedClient := apiClient.GetESClient()
+	call := typedClient.Reindex().Raw(bytes.NewReader(bodyBytes)).WaitForCompletion(false)
+	if req.Slices != "" {
+		call = call.Slices(req.Slices)
+	}
+	res, err := call.Do(ctx)
+	if err != nil {
+		return "", diagutil.FrameworkDiagFromError(err)
+	}
+	if res.Task == nil {
+		return "", fwdiag.Diagnostics{fwdiag.NewErrorDiagnostic(
+			"Reindex did not return a task",
+			fmt.Sprintf("Reindexing %q into %q did not return a task ID.", req.Source, req.Dest),
+		)}
+	}
+	return fmt.Sprint(res.Task), nil
+}
+
+// GetReindexTask returns the status of a reindex task.
+func GetReindexTask(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, taskID string) (*ReindexTaskStatus, fwdiag.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Tasks.Get(taskID).Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+
+	status := &ReindexTaskStatus{Completed: res.Completed}
+	if res.Error != nil && res.Error.Reason != nil {
+		status.Failures = append(status.Failures, *res.Error.Reason)
+	}
+	if !res.Completed || len(res.Response) == 0 {
+		return status, nil
+	}
+
+	var reindexRes reindex.Response
+	if err := json.Unmarshal(res.Response, &reindexRes); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	status.Total = typeutils.Deref(reindexRes.Total)
+	status.Created = typeutils.Deref(reindexRes.Created)
+	status.Noops = typeutils.Deref(reindexRes.Noops)
+	for _, failure := range reindexRes.Failures {
+		reason := ""
+		if failure.Cause.Reason != nil {
+			reason = *failure.Cause.Reason
+		}
+		status.Failures = append(status.Failures, strings.TrimSpace(fmt.Sprintf("document %q: %s %s", failure.Id, failure.Cause.Type, reason)))
+	}
+	return status, nil
+}
+
+// CountDocuments refreshes index and returns its document count, so documents
+// written just before the call are included.
+func CountDocuments(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, index string) (int64, fwdiag.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	if _, err := typedClient.Indices.Refresh().Index(index).Do(ctx); err != nil {
+		return 0, diagutil.FrameworkDiagFromError(err)
+	}
+	res, err := typedClient.Count().Index(index).Do(ctx)
+	if err != nil {
+		return 0, diagutil.FrameworkDiagFromError(err)
+	}
+	return res.Count, nil
+}
@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/rollover"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/go-version"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// MinVersionLazyRollover is the first Elasticsearch version supporting the
+// lazy rollover query parameter.
+var MinVersionLazyRollover = version.Must(version.NewVersion("8.13.0"))
+
+// RolloverRequest holds the query parameters and conditions for POST /{target}/_rollover.
+// When no condition is set the target is rolled over unconditionally.
+type RolloverRequest struct {
+	MaxAge              *string
+	MaxDocs             *int64
+	MaxPrimaryShardSize *string
+	Lazy                bool
+	DryRun              bool
+}
+
+// Rollover invokes the Elasticsearch rollover API against a data stream or
+// index alias.
+func Rollover(ctx context.Context, client *clients.ElasticsearchScopedClient, target string, body *RolloverRequest) (*rollover.Response, fwdiag.Diagnostics) {
+	typedClient := client.GetESClient()
+
+	req := typedClient.Indices.Rollover(target)
+
+	if body != nil {
+		if body.Lazy {
+			req.Lazy(true)
+		}
+		if body.DryRun {
+			req.DryRun(true)
+		}
+
+		conditions := types.RolloverConditions{MaxDocs: body.MaxDocs}
+		hasConditions := body.MaxDocs != nil
+		if body.MaxAge != nil {
+			conditions.MaxAge = *body.MaxAge
+			hasConditions = true
+		}
+		if body.MaxPrimaryShardSize != nil {
+			conditions.MaxPrimaryShardSize = *body.MaxPrimaryShardSize
+			hasConditions = true
+		}
+		if hasConditions {
+			req.Conditions(&conditions)
+		}
+	}
+
+	res, err := req.Do(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return res, nil
+}
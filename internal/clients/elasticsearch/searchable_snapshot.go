@@ -0,0 +1,43 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/searchablesnapshots/mount"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	fwdiags "github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// MountSearchableSnapshot mounts an index of a snapshot as a searchable
+// snapshot index and waits until the mounted index is recovered. storage is
+// either full_copy or shared_cache.
+func MountSearchableSnapshot(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, repository, snapshot, storage string, req *mount.Request) fwdiags.Diagnostics {
+	typedClient := apiClient.GetESClient()
+	_, err := typedClient.SearchableSnapshots.Mount(repository, snapshot).
+		Storage(storage).
+		WaitForCompletion(true).
+		Request(req).
+		Do(ctx)
+	if err != nil {
+		return diagutil.FrameworkDiagFromError(err)
+	}
+	return nil
+}
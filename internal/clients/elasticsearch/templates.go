@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
@@ -80,6 +81,30 @@ func GetComponentTemplate(ctx context.Context, apiClient *clients.ElasticsearchS
 	return &tpl, nil
 }
 
+// GetComponentTemplates returns the component templates matching pattern, a
+// comma-separated list of names that may contain wildcards. No templates are
+// returned when nothing matches.
+func GetComponentTemplates(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, pattern string) ([]models.ComponentTemplateResponse, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Cluster.GetComponentTemplate().Name(pattern).Perform(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	defer res.Body.Close()
+
+	if notFound, d := diagutil.CheckHTTPErrorOrNotFound(res, "Unable to get component templates"); notFound || d.HasError() {
+		return nil, d
+	}
+
+	var resp struct {
+		ComponentTemplates []models.ComponentTemplateResponse `json:"component_templates"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return resp.ComponentTemplates, nil
+}
+
 func DeleteComponentTemplate(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, templateName string) fwdiags.Diagnostics {
 	typedClient := apiClient.GetESClient()
 	_, err := typedClient.Cluster.DeleteComponentTemplate(templateName).Do(ctx)
@@ -143,3 +168,52 @@ func DeleteIndexTemplate(ctx context.Context, apiClient *clients.ElasticsearchSc
 	_, err := typedClient.Indices.DeleteIndexTemplate(templateName).Do(ctx)
 	return DiagsOrNotFound(err)
 }
+
+// SimulateIndexTemplateForIndex returns the template that would be applied to
+// a new index named indexName by the index templates currently on the cluster.
+func SimulateIndexTemplateForIndex(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, indexName string) (*models.SimulatedIndexTemplate, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	res, err := typedClient.Indices.SimulateIndexTemplate(indexName).Perform(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	defer res.Body.Close()
+
+	return decodeSimulatedIndexTemplate(res, fmt.Sprintf("Unable to simulate the index template for index %q", indexName))
+}
+
+// SimulateIndexTemplate returns the template resolved from an index template.
+// With only templateName, the existing template of that name is simulated.
+// With a body, the body is simulated as if it was stored under templateName,
+// if set.
+func SimulateIndexTemplate(ctx context.Context, apiClient *clients.ElasticsearchScopedClient, templateName string, body []byte) (*models.SimulatedIndexTemplate, fwdiags.Diagnostics) {
+	typedClient := apiClient.GetESClient()
+	req := typedClient.Indices.SimulateTemplate()
+	if templateName != "" {
+		req = req.Name(templateName)
+	}
+	if len(body) > 0 {
+		req = req.Raw(bytes.NewReader(body))
+	}
+	res, err := req.Perform(ctx)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	defer res.Body.Close()
+
+	return decodeSimulatedIndexTemplate(res, "Unable to simulate the index template")
+}
+
+// decodeSimulatedIndexTemplate decodes a simulation response with .Perform()
+// rather than .Do(), for the same reasons as GetComponentTemplate.
+func decodeSimulatedIndexTemplate(res *http.Response, errMsg string) (*models.SimulatedIndexTemplate, fwdiags.Diagnostics) {
+	if diags := diagutil.CheckHTTPErrorFromFW(res, errMsg); diags.HasError() {
+		return nil, diags
+	}
+
+	var simulated models.SimulatedIndexTemplate
+	if err := json.NewDecoder(res.Body).Decode(&simulated); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return &simulated, nil
+}
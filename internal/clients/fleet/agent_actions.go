@@ -0,0 +1,193 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Agent action statuses reported by the action status API.
+const (
+	AgentActionStatusInProgress    = "IN_PROGRESS"
+	AgentActionStatusComplete      = "COMPLETE"
+	AgentActionStatusRolloutPassed = "ROLLOUT_PASSED"
+	AgentActionStatusFailed        = "FAILED"
+	AgentActionStatusExpired       = "EXPIRED"
+	AgentActionStatusCancelled     = "CANCELLED"
+)
+
+const agentActionStatusPerPage = 20
+
+// AgentSelector selects the agents a bulk action applies to, either by ID or
+// by KQL query. It is rendered as the `agents` field of the bulk APIs.
+type AgentSelector struct {
+	IDs   []string
+	Kuery string
+}
+
+// MarshalJSON renders the selector as an ID array or a KQL string.
+func (s AgentSelector) MarshalJSON() ([]byte, error) {
+	if s.IDs != nil {
+		return json.Marshal(s.IDs)
+	}
+	return json.Marshal(s.Kuery)
+}
+
+// BulkUpgradeAgentsRequest is the body of the bulk upgrade API.
+type BulkUpgradeAgentsRequest struct {
+	Agents                 AgentSelector `json:"agents"`
+	Version                string        `json:"version"`
+	SourceURI              string        `json:"source_uri,omitempty"`
+	Force                  bool          `json:"force,omitempty"`
+	SkipRateLimitCheck     bool          `json:"skipRateLimitCheck,omitempty"`
+	RolloutDurationSeconds *int64        `json:"rollout_duration_seconds,omitempty"`
+	StartTime              string        `json:"start_time,omitempty"`
+	IncludeInactive        bool          `json:"includeInactive,omitempty"`
+	BatchSize              *int64        `json:"batchSize,omitempty"`
+}
+
+// BulkReassignAgentsRequest is the body of the bulk reassign API.
+type BulkReassignAgentsRequest struct {
+	Agents          AgentSelector `json:"agents"`
+	PolicyID        string        `json:"policy_id"`
+	IncludeInactive bool          `json:"includeInactive,omitempty"`
+	BatchSize       *int64        `json:"batchSize,omitempty"`
+}
+
+// BulkUnenrollAgentsRequest is the body of the bulk unenroll API.
+type BulkUnenrollAgentsRequest struct {
+	Agents          AgentSelector `json:"agents"`
+	Revoke          bool          `json:"revoke,omitempty"`
+	Force           bool          `json:"force,omitempty"`
+	IncludeInactive bool          `json:"includeInactive,omitempty"`
+	BatchSize       *int64        `json:"batchSize,omitempty"`
+}
+
+// BulkUpdateAgentTagsRequest is the body of the bulk update agent tags API.
+type BulkUpdateAgentTagsRequest struct {
+	Agents          AgentSelector `json:"agents"`
+	TagsToAdd       []string      `json:"tagsToAdd,omitempty"`
+	TagsToRemove    []string      `json:"tagsToRemove,omitempty"`
+	IncludeInactive bool          `json:"includeInactive,omitempty"`
+	BatchSize       *int64        `json:"batchSize,omitempty"`
+}
+
+// BulkUpgradeAgents starts an upgrade of the selected agents and returns the
+// ID of the resulting action.
+func BulkUpgradeAgents(ctx context.Context, client *Client, spaceID string, body BulkUpgradeAgentsRequest) (string, diag.Diagnostics) {
+	return postAgentBulkAction(ctx, client, spaceID, "bulk_upgrade", body)
+}
+
+// BulkReassignAgents moves the selected agents to another agent policy and
+// returns the ID of the resulting action.
+func BulkReassignAgents(ctx context.Context, client *Client, spaceID string, body BulkReassignAgentsRequest) (string, diag.Diagnostics) {
+	return postAgentBulkAction(ctx, client, spaceID, "bulk_reassign", body)
+}
+
+// BulkUnenrollAgents unenrolls the selected agents and returns the ID of the
+// resulting action.
+func BulkUnenrollAgents(ctx context.Context, client *Client, spaceID string, body BulkUnenrollAgentsRequest) (string, diag.Diagnostics) {
+	return postAgentBulkAction(ctx, client, spaceID, "bulk_unenroll", body)
+}
+
+// BulkUpdateAgentTags adds and removes tags on the selected agents and returns
+// the ID of the resulting action.
+func BulkUpdateAgentTags(ctx context.Context, client *Client, spaceID string, body BulkUpdateAgentTagsRequest) (string, diag.Diagnostics) {
+	return postAgentBulkAction(ctx, client, spaceID, "bulk_update_agent_tags", body)
+}
+
+func postAgentBulkAction(ctx context.Context, client *Client, spaceID, endpoint string, body any) (string, diag.Diagnostics) {
+	var result struct {
+		ActionID string `json:"actionId"`
+	}
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodPost,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/agents/" + endpoint,
+		Body:    body,
+	}, &result)
+	if diags.HasError() {
+		return "", diags
+	}
+	if status != http.StatusOK {
+		return "", diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	return result.ActionID, nil
+}
+
+// AgentActionStatus is the progress of an agent action.
+type AgentActionStatus struct {
+	ActionID              string             `json:"actionId"`
+	Type                  string             `json:"type"`
+	Status                string             `json:"status"`
+	NbAgentsActionCreated int64              `json:"nbAgentsActionCreated"`
+	NbAgentsActioned      int64              `json:"nbAgentsActioned"`
+	NbAgentsAck           int64              `json:"nbAgentsAck"`
+	NbAgentsFailed        int64              `json:"nbAgentsFailed"`
+	LatestErrors          []AgentActionError `json:"latestErrors"`
+}
+
+// AgentActionError is an error reported by an agent for an action.
+type AgentActionError struct {
+	AgentID  string `json:"agentId"`
+	Hostname string `json:"hostname"`
+	Error    string `json:"error"`
+}
+
+// GetAgentActionStatus returns the status of the given agent action. It
+// returns nil when the action is not (yet) listed by Fleet.
+func GetAgentActionStatus(ctx context.Context, client *Client, spaceID, actionID string) (*AgentActionStatus, diag.Diagnostics) {
+	for page := 0; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("perPage", strconv.Itoa(agentActionStatusPerPage))
+
+		var result struct {
+			Items []AgentActionStatus `json:"items"`
+		}
+		status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+			Method:  http.MethodGet,
+			SpaceID: spaceID,
+			Path:    "/api/fleet/agents/action_status",
+			Query:   query,
+		}, &result)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if status != http.StatusOK {
+			return nil, diagutil.ReportUnknownHTTPError(status, body)
+		}
+
+		for i := range result.Items {
+			if result.Items[i].ActionID == actionID {
+				return &result.Items[i], nil
+			}
+		}
+		if len(result.Items) < agentActionStatusPerPage {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkAgentActions(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(context.Context, *fleet.Client) (string, diag.Diagnostics)
+		expectedPath string
+		expectedBody string
+	}{
+		{
+			name: "upgrade by kuery",
+			call: func(ctx context.Context, c *fleet.Client) (string, diag.Diagnostics) {
+				return fleet.BulkUpgradeAgents(ctx, c, "edge", fleet.BulkUpgradeAgentsRequest{
+					Agents:                 fleet.AgentSelector{Kuery: `policy_id:"policy-1"`},
+					Version:                "9.1.0",
+					RolloutDurationSeconds: new(int64(3600)),
+					StartTime:              "2026-01-01T00:00:00Z",
+				})
+			},
+			expectedPath: "/s/edge/api/fleet/agents/bulk_upgrade",
+			expectedBody: `{"agents":"policy_id:\"policy-1\"","version":"9.1.0","rollout_duration_seconds":3600,"start_time":"2026-01-01T00:00:00Z"}`,
+		},
+		{
+			name: "reassign by id",
+			call: func(ctx context.Context, c *fleet.Client) (string, diag.Diagnostics) {
+				return fleet.BulkReassignAgents(ctx, c, "default", fleet.BulkReassignAgentsRequest{
+					Agents:   fleet.AgentSelector{IDs: []string{"agent-1", "agent-2"}},
+					PolicyID: "policy-2",
+				})
+			},
+			expectedPath: "/api/fleet/agents/bulk_reassign",
+			expectedBody: `{"agents":["agent-1","agent-2"],"policy_id":"policy-2"}`,
+		},
+		{
+			name: "unenroll",
+			call: func(ctx context.Context, c *fleet.Client) (string, diag.Diagnostics) {
+				return fleet.BulkUnenrollAgents(ctx, c, "default", fleet.BulkUnenrollAgentsRequest{
+					Agents: fleet.AgentSelector{IDs: []string{"agent-1"}},
+					Revoke: true,
+				})
+			},
+			expectedPath: "/api/fleet/agents/bulk_unenroll",
+			expectedBody: `{"agents":["agent-1"],"revoke":true}`,
+		},
+		{
+			name: "update tags",
+			call: func(ctx context.Context, c *fleet.Client) (string, diag.Diagnostics) {
+				return fleet.BulkUpdateAgentTags(ctx, c, "default", fleet.BulkUpdateAgentTagsRequest{
+					Agents:       fleet.AgentSelector{Kuery: "tags:canary"},
+					TagsToAdd:    []string{"stable"},
+					TagsToRemove: []string{"canary"},
+				})
+			},
+			expectedPath: "/api/fleet/agents/bulk_update_agent_tags",
+			expectedBody: `{"agents":"tags:canary","tagsToAdd":["stable"],"tagsToRemove":["canary"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, tt.expectedPath, r.URL.Path)
+				var body json.RawMessage
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.JSONEq(t, tt.expectedBody, string(body))
+				fmt.Fprint(w, `{"actionId":"action-1"}`)
+			}))
+			defer server.Close()
+
+			actionID, diags := tt.call(context.Background(), newTestClient(t, server))
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			require.Equal(t, "action-1", actionID)
+		})
+	}
+}
+
+func TestGetAgentActionStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/fleet/agents/action_status", r.URL.Path)
+		switch r.URL.Query().Get("page") {
+		case "0":
+			items := make([]string, 20)
+			for i := range items {
+				items[i] = fmt.Sprintf(`{"actionId":"other-%d","status":"COMPLETE"}`, i)
+			}
+			fmt.Fprintf(w, `{"items":[%s]}`, strings.Join(items, ","))
+		default:
+			fmt.Fprint(w, `{"items":[{"actionId":"action-1","type":"UPGRADE","status":"FAILED","nbAgentsActionCreated":2,"nbAgentsFailed":1,"latestErrors":[{"agentId":"agent-1","hostname":"host-1","error":"download failed"}]}]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+
+	status, diags := fleet.GetAgentActionStatus(context.Background(), client, "default", "action-1")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, &fleet.AgentActionStatus{
+		ActionID:              "action-1",
+		Type:                  "UPGRADE",
+		Status:                fleet.AgentActionStatusFailed,
+		NbAgentsActionCreated: 2,
+		NbAgentsFailed:        1,
+		LatestErrors:          []fleet.AgentActionError{{AgentID: "agent-1", Hostname: "host-1", Error: "download failed"}},
+	}, status)
+
+	status, diags = fleet.GetAgentActionStatus(context.Background(), client, "default", "missing")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Nil(t, status)
+}
@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// FullAgentPolicyOptions controls how Fleet renders a full agent policy.
+type FullAgentPolicyOptions struct {
+	// Standalone renders the policy for agents that are not enrolled in Fleet.
+	Standalone bool
+	// Kubernetes renders the policy as an Elastic Agent Kubernetes manifest.
+	Kubernetes bool
+}
+
+func (opts FullAgentPolicyOptions) query() url.Values {
+	q := url.Values{}
+	q.Set("standalone", strconv.FormatBool(opts.Standalone))
+	q.Set("kubernetes", strconv.FormatBool(opts.Kubernetes))
+	return q
+}
+
+// GetFullAgentPolicy reads the fully rendered agent policy as returned by
+// /api/fleet/agent_policies/{id}/full. The item is returned verbatim: it is a
+// JSON object for regular policies and a JSON string holding the manifest
+// when Kubernetes rendering is requested. It returns nil when the policy does
+// not exist.
+func GetFullAgentPolicy(ctx context.Context, client *Client, spaceID, policyID string, opts FullAgentPolicyOptions) (json.RawMessage, diag.Diagnostics) {
+	status, bodyBytes, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/agent_policies/" + url.PathEscape(policyID) + "/full",
+		Query:   opts.query(),
+	}, nil)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch status {
+	case http.StatusOK:
+		var result struct {
+			Item json.RawMessage `json:"item"`
+		}
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, diagutil.FrameworkDiagFromError(err)
+		}
+		return result.Item, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, bodyBytes)
+	}
+}
+
+// DownloadAgentPolicy reads the agent policy as the YAML document Fleet offers
+// for download from /api/fleet/agent_policies/{id}/download. It returns nil
+// when the policy does not exist.
+func DownloadAgentPolicy(ctx context.Context, client *Client, spaceID, policyID string, opts FullAgentPolicyOptions) (*string, diag.Diagnostics) {
+	status, bodyBytes, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/agent_policies/" + url.PathEscape(policyID) + "/download",
+		Query:   opts.query(),
+	}, nil)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch status {
+	case http.StatusOK:
+		return new(string(bodyBytes)), nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, bodyBytes)
+	}
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentPolicyRendering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/s/edge/api/fleet/agent_policies/policy-1/full":
+			require.Equal(t, "true", r.URL.Query().Get("standalone"))
+			require.Equal(t, "false", r.URL.Query().Get("kubernetes"))
+			_, _ = w.Write([]byte(`{"item":{"id":"policy-1","revision":3}}`))
+		case "/s/edge/api/fleet/agent_policies/policy-1/download":
+			require.Equal(t, "true", r.URL.Query().Get("kubernetes"))
+			w.Header().Set("Content-Type", "text/x-yaml")
+			_, _ = w.Write([]byte("apiVersion: v1\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	item, diags := fleet.GetFullAgentPolicy(ctx, client, "edge", "policy-1", fleet.FullAgentPolicyOptions{Standalone: true})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.JSONEq(t, `{"id":"policy-1","revision":3}`, string(item))
+
+	manifest, diags := fleet.DownloadAgentPolicy(ctx, client, "edge", "policy-1", fleet.FullAgentPolicyOptions{Standalone: true, Kubernetes: true})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, new("apiVersion: v1\n"), manifest)
+
+	item, diags = fleet.GetFullAgentPolicy(ctx, client, "edge", "missing", fleet.FullAgentPolicyOptions{})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Nil(t, item)
+}
@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const agentsMaxPerPage = 100
+
+// Agent is an Elastic Agent enrolled in Fleet.
+type Agent struct {
+	ID             string           `json:"id"`
+	PolicyID       string           `json:"policy_id"`
+	PolicyRevision *int64           `json:"policy_revision"`
+	Status         string           `json:"status"`
+	LastCheckin    string           `json:"last_checkin"`
+	Tags           []string         `json:"tags"`
+	Agent          AgentInfo        `json:"agent"`
+	LocalMetadata  AgentMetadata    `json:"local_metadata"`
+	Components     []AgentComponent `json:"components"`
+}
+
+// AgentInfo holds the agent binary details.
+type AgentInfo struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// AgentMetadata holds the subset of the agent's local metadata used by the
+// provider.
+type AgentMetadata struct {
+	Host struct {
+		Hostname string `json:"hostname"`
+	} `json:"host"`
+}
+
+// AgentComponent is the health of one component run by an agent.
+type AgentComponent struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// AgentListParams are the filters for ListAgents. Empty fields are not
+// applied; all filters are combined with AND.
+type AgentListParams struct {
+	// Kuery is an arbitrary KQL query over agent fields.
+	Kuery    string
+	PolicyID string
+	// Statuses matches agents in any of the given statuses, e.g. online,
+	// offline, unhealthy or updating.
+	Statuses []string
+	Version  string
+	// Tags matches agents carrying any of the given tags.
+	Tags []string
+}
+
+// Query renders the params as a single KQL query.
+func (p AgentListParams) Query() string {
+	var clauses []string
+	if p.Kuery != "" {
+		clauses = append(clauses, "("+p.Kuery+")")
+	}
+	if p.PolicyID != "" {
+		clauses = append(clauses, "policy_id:"+kibanautil.KQLQuote(p.PolicyID))
+	}
+	if len(p.Statuses) > 0 {
+		clauses = append(clauses, "status:("+strings.Join(p.Statuses, " or ")+")")
+	}
+	if p.Version != "" {
+		clauses = append(clauses, "agent.version:"+kibanautil.KQLQuote(p.Version))
+	}
+	if len(p.Tags) > 0 {
+		tags := make([]string, 0, len(p.Tags))
+		for _, tag := range p.Tags {
+			tags = append(tags, kibanautil.KQLQuote(tag))
+		}
+		clauses = append(clauses, "tags:("+strings.Join(tags, " or ")+")")
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// showInactive reports whether the filter targets agents Fleet hides by
+// default.
+func (p AgentListParams) showInactive() bool {
+	for _, status := range p.Statuses {
+		if status == "inactive" || status == "unenrolled" {
+			return true
+		}
+	}
+	return false
+}
+
+type agentListResponse struct {
+	Items []Agent `json:"items"`
+	Total int     `json:"total"`
+}
+
+// ListAgents returns every agent in the space matching params, following the
+// API's pagination.
+func ListAgents(ctx context.Context, client *Client, spaceID string, params AgentListParams) ([]Agent, diag.Diagnostics) {
+	agents := []Agent{}
+
+	for page := 1; ; page++ {
+		result, diags := listAgentsPage(ctx, client, spaceID, params, page)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		agents = append(agents, result.Items...)
+		if len(result.Items) == 0 || len(agents) >= result.Total {
+			return agents, nil
+		}
+	}
+}
+
+func listAgentsPage(ctx context.Context, client *Client, spaceID string, params AgentListParams, page int) (*agentListResponse, diag.Diagnostics) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("perPage", strconv.Itoa(agentsMaxPerPage))
+	query.Set("sortField", "enrolled_at")
+	query.Set("sortOrder", "asc")
+	query.Set("showInactive", strconv.FormatBool(params.showInactive()))
+	if kuery := params.Query(); kuery != "" {
+		query.Set("kuery", kuery)
+	}
+
+	var result agentListResponse
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/agents",
+		Query:   query,
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+	return &result, nil
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAgents(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/s/edge/api/fleet/agents", r.URL.Path)
+		require.Equal(t, `(local_metadata.os.family:linux) and policy_id:"policy-1" and status:(online or unhealthy) and agent.version:"9.1.0" and tags:("blue" or "green")`, r.URL.Query().Get("kuery"))
+		require.Equal(t, "false", r.URL.Query().Get("showInactive"))
+
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			fmt.Fprint(w, `{"items":[{"id":"agent-1","policy_id":"policy-1","policy_revision":2,"status":"online","agent":{"id":"agent-1","version":"9.1.0"},"local_metadata":{"host":{"hostname":"host-1"}},"components":[{"id":"log-default","type":"log","status":"HEALTHY"}]}],"total":2}`)
+		default:
+			fmt.Fprint(w, `{"items":[{"id":"agent-2","policy_id":"policy-1","status":"unhealthy"}],"total":2}`)
+		}
+	}))
+	defer server.Close()
+
+	agents, diags := fleet.ListAgents(context.Background(), newTestClient(t, server), "edge", fleet.AgentListParams{
+		Kuery:    "local_metadata.os.family:linux",
+		PolicyID: "policy-1",
+		Statuses: []string{"online", "unhealthy"},
+		Version:  "9.1.0",
+		Tags:     []string{"blue", "green"},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []string{"1", "2"}, pages)
+	require.Len(t, agents, 2)
+	require.Equal(t, "host-1", agents[0].LocalMetadata.Host.Hostname)
+	require.Equal(t, new(int64(2)), agents[0].PolicyRevision)
+	require.Equal(t, []fleet.AgentComponent{{ID: "log-default", Type: "log", Status: "HEALTHY"}}, agents[0].Components)
+	require.Equal(t, "unhealthy", agents[1].Status)
+}
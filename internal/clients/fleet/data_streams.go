@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DataStream is a data stream managed by Fleet, as reported by the Fleet data
+// streams API.
+type DataStream struct {
+	Index          string `json:"index"`
+	Dataset        string `json:"dataset"`
+	Namespace      string `json:"namespace"`
+	Type           string `json:"type"`
+	Package        string `json:"package"`
+	PackageVersion string `json:"package_version"`
+	LastActivityMs int64  `json:"last_activity_ms"`
+	SizeInBytes    int64  `json:"size_in_bytes"`
+}
+
+// ListDataStreams lists the data streams managed by Fleet, including the
+// package that installed them, their size and their last activity. The EPM
+// data streams API (/api/fleet/epm/data_streams) only returns names, so the
+// Fleet data streams API is used instead.
+func ListDataStreams(ctx context.Context, client *Client, spaceID string) ([]DataStream, diag.Diagnostics) {
+	var result struct {
+		DataStreams []DataStream `json:"data_streams"`
+	}
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/data_streams",
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+	return result.DataStreams, nil
+}
+
+// DeleteDataStreams deletes the named data streams, along with their backing
+// indices, with the Elasticsearch delete data stream API. Fleet has no API to
+// delete data streams and the Fleet client has no Elasticsearch connection, so
+// the requests go through the Kibana console proxy API, which forwards them to
+// the Elasticsearch cluster of Kibana. Data streams that no longer exist are
+// ignored.
+func DeleteDataStreams(ctx context.Context, client *Client, names []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, name := range names {
+		diags.Append(deleteDataStream(ctx, client, name)...)
+	}
+	return diags
+}
+
+func deleteDataStream(ctx context.Context, client *Client, name string) diag.Diagnostics {
+	query := url.Values{}
+	query.Set("path", "/_data_stream/"+url.PathEscape(name))
+	query.Set("method", http.MethodDelete)
+
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method: http.MethodPost,
+		Path:   "/api/console/proxy",
+		Query:  query,
+	}, nil)
+	if diags.HasError() {
+		return diags
+	}
+
+	switch status {
+	case http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Failed to delete data stream",
+			fmt.Sprintf("Deleting data stream %q failed with status %d: %s", name, status, string(body)),
+		)}
+	}
+}
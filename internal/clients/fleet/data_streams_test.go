@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDataStreams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/s/team-a/api/fleet/data_streams", r.URL.Path)
+		fmt.Fprint(w, `{"data_streams":[
+			{"index":"logs-tcp.generic-prod","dataset":"tcp.generic","namespace":"prod","type":"logs","package":"tcp","package_version":"1.16.0","last_activity_ms":1700000000000,"size_in_bytes":2048}
+		]}`)
+	}))
+	defer server.Close()
+
+	dataStreams, diags := fleet.ListDataStreams(context.Background(), newTestClient(t, server), "team-a")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []fleet.DataStream{{
+		Index:          "logs-tcp.generic-prod",
+		Dataset:        "tcp.generic",
+		Namespace:      "prod",
+		Type:           "logs",
+		Package:        "tcp",
+		PackageVersion: "1.16.0",
+		LastActivityMs: 1700000000000,
+		SizeInBytes:    2048,
+	}}, dataStreams)
+}
+
+func TestDeleteDataStreams(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/console/proxy", r.URL.Path)
+		require.Equal(t, http.MethodDelete, r.URL.Query().Get("method"))
+		require.Empty(t, r.Header.Get("x-elastic-internal-origin"))
+
+		path := r.URL.Query().Get("path")
+		requested = append(requested, path)
+		if path == "/_data_stream/logs-tcp.generic-dev" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"type":"index_not_found_exception"},"status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"acknowledged":true}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	require.False(t, fleet.DeleteDataStreams(context.Background(), client, nil).HasError())
+	require.Nil(t, requested)
+
+	diags := fleet.DeleteDataStreams(context.Background(), client, []string{"logs-tcp.generic-prod", "logs-tcp.generic-dev"})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []string{"/_data_stream/logs-tcp.generic-prod", "/_data_stream/logs-tcp.generic-dev"}, requested)
+}
+
+func TestDeleteDataStreams_failure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error":{"type":"security_exception"},"status":403}`)
+	}))
+	defer server.Close()
+
+	diags := fleet.DeleteDataStreams(context.Background(), newTestClient(t, server), []string{"logs-tcp.generic-prod"})
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "logs-tcp.generic-prod")
+	require.Contains(t, diags[0].Detail(), "403")
+}
@@ -22,8 +22,10 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
 	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -92,3 +94,71 @@ func GetEnrollmentTokensByPolicyInSpace(ctx context.Context, client *Client, pol
 		return nil, diagutil.ReportUnknownHTTPError(httpResp.StatusCode, bodyBytes)
 	}
 }
+
+// CreateEnrollmentTokenRequest is the body of the create enrollment token API.
+type CreateEnrollmentTokenRequest struct {
+	PolicyID string  `json:"policy_id"`
+	Name     *string `json:"name,omitempty"`
+}
+
+type enrollmentTokenItemResponse struct {
+	Item kbapi.KibanaHTTPAPIsEnrollmentApiKey `json:"item"`
+}
+
+// CreateEnrollmentToken creates an enrollment token for an agent policy.
+func CreateEnrollmentToken(ctx context.Context, client *Client, spaceID string, body CreateEnrollmentTokenRequest) (*kbapi.KibanaHTTPAPIsEnrollmentApiKey, diag.Diagnostics) {
+	var result enrollmentTokenItemResponse
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodPost,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/enrollment_api_keys",
+		Body:    body,
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch status {
+	case http.StatusOK:
+		return &result.Item, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+}
+
+// GetEnrollmentToken reads a single enrollment token. It returns nil when the
+// token does not exist.
+func GetEnrollmentToken(ctx context.Context, client *Client, spaceID, keyID string) (*kbapi.KibanaHTTPAPIsEnrollmentApiKey, diag.Diagnostics) {
+	var result enrollmentTokenItemResponse
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/enrollment_api_keys/" + url.PathEscape(keyID),
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch status {
+	case http.StatusOK:
+		return &result.Item, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+}
+
+// RevokeEnrollmentToken revokes an enrollment token. Fleet keeps revoked
+// tokens as inactive, agents can no longer enroll with them.
+func RevokeEnrollmentToken(ctx context.Context, client *Client, spaceID, keyID string) diag.Diagnostics {
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodDelete,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/enrollment_api_keys/" + url.PathEscape(keyID),
+	}, nil)
+	if diags.HasError() {
+		return diags
+	}
+	return diagutil.HandleStatusResponse(status, body, http.StatusOK, http.StatusNotFound)
+}
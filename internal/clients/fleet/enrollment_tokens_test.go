@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollmentTokenLifecycle(t *testing.T) {
+	const token = `{"id":"key-1","api_key":"secret","api_key_id":"api-key-1","name":"site-a (key-1)","policy_id":"policy-1","active":true,"created_at":"2026-01-01T00:00:00.000Z"}`
+	revoked := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/s/site-a/api/fleet/enrollment_api_keys":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, map[string]any{"policy_id": "policy-1", "name": "site-a"}, body)
+			_, _ = w.Write([]byte(`{"action":"created","item":` + token + `}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/s/site-a/api/fleet/enrollment_api_keys/key-1":
+			if revoked {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"not found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"item":` + token + `}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/s/site-a/api/fleet/enrollment_api_keys/key-1":
+			revoked = true
+			_, _ = w.Write([]byte(`{"action":"deleted"}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	created, diags := fleet.CreateEnrollmentToken(ctx, client, "site-a", fleet.CreateEnrollmentTokenRequest{PolicyID: "policy-1", Name: new("site-a")})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, "key-1", created.Id)
+	require.Equal(t, "secret", created.ApiKey)
+
+	read, diags := fleet.GetEnrollmentToken(ctx, client, "site-a", "key-1")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.NotNil(t, read)
+	require.True(t, read.Active)
+
+	diags = fleet.RevokeEnrollmentToken(ctx, client, "site-a", "key-1")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	read, diags = fleet.GetEnrollmentToken(ctx, client, "site-a", "key-1")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Nil(t, read)
+
+	diags = fleet.RevokeEnrollmentToken(ctx, client, "site-a", "key-1")
+	require.False(t, diags.HasError(), "revoking a missing token must succeed: %v", diags)
+}
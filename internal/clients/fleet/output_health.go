@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Output health states reported by the output health API. UNKNOWN is reported
+// until an agent sends a health status for the output.
+const (
+	OutputHealthStateHealthy  = "HEALTHY"
+	OutputHealthStateDegraded = "DEGRADED"
+	OutputHealthStateUnknown  = "UNKNOWN"
+)
+
+// OutputHealth is the latest health status reported for an output.
+type OutputHealth struct {
+	State     string `json:"state"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+}
+
+// GetOutputHealth reads the latest health status of an output. It returns nil
+// when the output does not exist.
+func GetOutputHealth(ctx context.Context, client *Client, id, spaceID string) (*OutputHealth, diag.Diagnostics) {
+	var health OutputHealth
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/outputs/" + url.PathEscape(id) + "/health",
+	}, &health)
+	if diags.HasError() {
+		return nil, diags
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+
+	if health.State == "" {
+		health.State = OutputHealthStateUnknown
+	}
+	return &health, nil
+}
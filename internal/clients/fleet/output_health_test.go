@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOutputHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/s/team-a/api/fleet/outputs/kafka-1/health":
+			fmt.Fprint(w, `{"state":"DEGRADED","message":"kafka: client has run out of available brokers","timestamp":"2024-05-01T10:00:00.000Z"}`)
+		case "/api/fleet/outputs/new-output/health":
+			fmt.Fprint(w, `{"state":"","message":"","timestamp":""}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"statusCode":404,"message":"Output not found"}`)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	health, diags := fleet.GetOutputHealth(context.Background(), client, "kafka-1", "team-a")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, &fleet.OutputHealth{
+		State:     fleet.OutputHealthStateDegraded,
+		Message:   "kafka: client has run out of available brokers",
+		Timestamp: "2024-05-01T10:00:00.000Z",
+	}, health)
+
+	health, diags = fleet.GetOutputHealth(context.Background(), client, "new-output", "default")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, fleet.OutputHealthStateUnknown, health.State)
+
+	health, diags = fleet.GetOutputHealth(context.Background(), client, "missing", "default")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Nil(t, health)
+}
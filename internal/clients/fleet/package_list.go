@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Package install statuses reported by the package list API.
+const (
+	PackageStatusInstalled    = "installed"
+	PackageStatusNotInstalled = "not_installed"
+)
+
+// PackageListItem is a package of the Fleet package catalog. Version is the
+// latest version available to the cluster.
+type PackageListItem struct {
+	Name             string                   `json:"name"`
+	Title            string                   `json:"title"`
+	Version          string                   `json:"version"`
+	Description      string                   `json:"description"`
+	Type             string                   `json:"type"`
+	Release          string                   `json:"release"`
+	Categories       []string                 `json:"categories"`
+	Status           string                   `json:"status"`
+	InstallationInfo *PackageInstallationInfo `json:"installationInfo,omitempty"`
+	SavedObject      *struct {
+		Attributes struct {
+			Version string `json:"version"`
+		} `json:"attributes"`
+	} `json:"savedObject,omitempty"`
+}
+
+// PackageInstallationInfo describes the installed version of a package.
+type PackageInstallationInfo struct {
+	Version       string `json:"version"`
+	InstallStatus string `json:"install_status"`
+}
+
+// InstalledVersion returns the installed version of the package, or an empty
+// string when it is not installed.
+func (p PackageListItem) InstalledVersion() string {
+	if p.InstallationInfo != nil && p.InstallationInfo.Version != "" {
+		return p.InstallationInfo.Version
+	}
+	// Older Kibana versions only report the installation saved object.
+	if p.Status == PackageStatusInstalled && p.SavedObject != nil {
+		return p.SavedObject.Attributes.Version
+	}
+	return ""
+}
+
+// ListPackages lists the packages of the Fleet catalog, both available and
+// installed. With prerelease, the latest version of a package may be a
+// prerelease version. It shares the request and the pre-8.7 fallback of
+// GetPackages, but decodes the installation details that the generated
+// package list item does not expose.
+func ListPackages(ctx context.Context, client *Client, spaceID string, prerelease bool) ([]PackageListItem, diag.Diagnostics) {
+	resp, diags := getPackages(ctx, client, prerelease, spaceID)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var result struct {
+		Items []PackageListItem `json:"items"`
+	}
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+	return result.Items, nil
+}
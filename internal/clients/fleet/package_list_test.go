@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPackages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/s/team-a/api/fleet/epm/packages", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("prerelease"))
+		fmt.Fprint(w, `{"items":[
+			{"name":"tcp","title":"Custom TCP Logs","version":"1.17.0","categories":["network","custom"],"status":"installed","installationInfo":{"version":"1.16.0","install_status":"installed"}},
+			{"name":"udp","title":"Custom UDP Logs","version":"2.0.0","categories":["network"],"status":"installed","savedObject":{"attributes":{"version":"1.9.0"}}},
+			{"name":"netflow","title":"NetFlow Records","version":"2.1.0","categories":["network"],"status":"not_installed"}
+		]}`)
+	}))
+	defer server.Close()
+
+	packages, diags := fleet.ListPackages(context.Background(), newTestClient(t, server), "team-a", true)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Len(t, packages, 3)
+	require.Equal(t, "1.16.0", packages[0].InstalledVersion())
+	require.Equal(t, "1.9.0", packages[1].InstalledVersion())
+	require.Empty(t, packages[2].InstalledVersion())
+}
+
+func TestListPackages_retriesWithoutPrereleaseOnOlderStacks(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Has("prerelease") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"statusCode":400,"message":"[request query.prerelease]: definition for this key is missing"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items":[{"name":"tcp","version":"1.17.0","status":"installed","savedObject":{"attributes":{"version":"1.16.0"}}}]}`)
+	}))
+	defer server.Close()
+
+	packages, diags := fleet.ListPackages(context.Background(), newTestClient(t, server), "", false)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []string{"prerelease=false", ""}, queries)
+	require.Len(t, packages, 1)
+	require.Equal(t, "1.16.0", packages[0].InstalledVersion())
+}
+
+func TestListRegistryPackageVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search", r.URL.Path)
+		q := r.URL.Query()
+		require.Equal(t, "tcp", q.Get("package"))
+		require.Equal(t, "true", q.Get("all"))
+		require.Equal(t, "false", q.Get("prerelease"))
+		require.Equal(t, "9.1.0", q.Get("kibana.version"))
+		fmt.Fprint(w, `[
+			{"name":"tcp","version":"1.20.0"},
+			{"name":"tcp","version":"1.21.3"},
+			{"name":"tcp","version":"2.0.0"},
+			{"name":"tcp_extra","version":"5.0.0"}
+		]`)
+	}))
+	defer server.Close()
+
+	versions, diags := fleet.ListRegistryPackageVersions(context.Background(), server.Client(), fleet.RegistryVersionsRequest{
+		RegistryURL:   server.URL + "/",
+		Name:          "tcp",
+		KibanaVersion: "9.1.0-SNAPSHOT",
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []string{"1.20.0", "1.21.3", "2.0.0"}, versions)
+}
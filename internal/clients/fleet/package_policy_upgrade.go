@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// PackagePolicyUpgradeDryRun is the result of a package policy upgrade dry
+// run. Diff holds the current policy followed by the proposed policy.
+type PackagePolicyUpgradeDryRun struct {
+	Name       string                          `json:"name"`
+	HasErrors  bool                            `json:"hasErrors"`
+	Diff       []PackagePolicyUpgradeDiffItem  `json:"diff"`
+	StatusCode int                             `json:"statusCode,omitempty"`
+	Body       *PackagePolicyUpgradeResultBody `json:"body,omitempty"`
+}
+
+// PackagePolicyUpgradeDiffItem is a package policy in the non-simplified
+// format, as returned by the dry-run diff.
+type PackagePolicyUpgradeDiffItem struct {
+	Name        string                           `json:"name"`
+	Package     *PackagePolicyUpgradePackage     `json:"package,omitempty"`
+	Vars        map[string]PackagePolicyVar      `json:"vars,omitempty"`
+	Inputs      []PackagePolicyUpgradeInput      `json:"inputs"`
+	Errors      []PackagePolicyUpgradeValidation `json:"errors,omitempty"`
+	MissingVars []string                         `json:"missingVars,omitempty"`
+}
+
+// PackagePolicyUpgradePackage identifies the package of a diff item.
+type PackagePolicyUpgradePackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// PackagePolicyVar is a package policy variable in the non-simplified format.
+type PackagePolicyVar struct {
+	Type  string `json:"type,omitempty"`
+	Value any    `json:"value"`
+}
+
+// PackagePolicyUpgradeInput is a package policy input in the non-simplified
+// format.
+type PackagePolicyUpgradeInput struct {
+	Type           string                       `json:"type"`
+	PolicyTemplate string                       `json:"policy_template,omitempty"`
+	Enabled        bool                         `json:"enabled"`
+	Vars           map[string]PackagePolicyVar  `json:"vars,omitempty"`
+	Streams        []PackagePolicyUpgradeStream `json:"streams"`
+}
+
+// PackagePolicyUpgradeStream is a package policy input stream in the
+// non-simplified format.
+type PackagePolicyUpgradeStream struct {
+	Enabled    bool `json:"enabled"`
+	DataStream struct {
+		Dataset string `json:"dataset"`
+		Type    string `json:"type"`
+	} `json:"data_stream"`
+	Vars map[string]PackagePolicyVar `json:"vars,omitempty"`
+}
+
+// PackagePolicyUpgradeValidation is a validation error reported for the
+// proposed policy of a dry run.
+type PackagePolicyUpgradeValidation struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// PackagePolicyUpgradeResultBody carries the error message of a failed
+// upgrade or dry run.
+type PackagePolicyUpgradeResultBody struct {
+	Message string `json:"message"`
+}
+
+type packagePolicyUpgradeResult struct {
+	ID         string                          `json:"id"`
+	Name       string                          `json:"name"`
+	Success    bool                            `json:"success"`
+	StatusCode int                             `json:"statusCode,omitempty"`
+	Body       *PackagePolicyUpgradeResultBody `json:"body,omitempty"`
+}
+
+// DryRunUpgradePackagePolicy previews upgrading a package policy to the given
+// package version. An empty packageVersion previews an upgrade to the
+// installed package version.
+func DryRunUpgradePackagePolicy(ctx context.Context, client *Client, id, spaceID, packageVersion string) (*PackagePolicyUpgradeDryRun, diag.Diagnostics) {
+	body := map[string]any{"packagePolicyIds": []string{id}}
+	if packageVersion != "" {
+		body["packageVersion"] = packageVersion
+	}
+
+	var results []PackagePolicyUpgradeDryRun
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodPost,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/package_policies/upgrade/dryrun",
+		Body:    body,
+	}, &results)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	if len(results) == 0 {
+		return nil, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Empty upgrade dry run", fmt.Sprintf("Fleet returned no dry run result for package policy %q.", id)),
+		}
+	}
+	return &results[0], nil
+}
+
+// UpgradePackagePolicy upgrades a package policy to the installed version of
+// its package.
+func UpgradePackagePolicy(ctx context.Context, client *Client, id, spaceID string) diag.Diagnostics {
+	body := map[string]any{"packagePolicyIds": []string{id}}
+
+	var results []packagePolicyUpgradeResult
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodPost,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/package_policies/upgrade",
+		Body:    body,
+	}, &results)
+	if diags.HasError() {
+		return diags
+	}
+	if status != http.StatusOK {
+		return diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	for _, result := range results {
+		if result.ID != id || result.Success {
+			continue
+		}
+		message := "unknown error"
+		if result.Body != nil && result.Body.Message != "" {
+			message = result.Body.Message
+		}
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Failed to upgrade package policy", fmt.Sprintf("Upgrading package policy %q failed: %s", id, message)),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackagePolicyUpgrade(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var body json.RawMessage
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		switch r.URL.Path {
+		case "/s/team-a/api/fleet/package_policies/upgrade/dryrun":
+			require.JSONEq(t, `{"packagePolicyIds":["pp-1"],"packageVersion":"1.2.0"}`, string(body))
+			fmt.Fprint(w, `[{"name":"tcp-1","hasErrors":false,"diff":[
+				{"name":"tcp-1","package":{"name":"tcp","version":"1.1.0"},"inputs":[{"type":"tcp","policy_template":"tcp","enabled":true,"streams":[{"enabled":true,"data_stream":{"type":"logs","dataset":"tcp.generic"},"vars":{"listen_port":{"type":"integer","value":8080}}}]}]},
+				{"name":"tcp-1","package":{"name":"tcp","version":"1.2.0"},"inputs":[{"type":"tcp","policy_template":"tcp","enabled":true,"streams":[{"enabled":true,"data_stream":{"type":"logs","dataset":"tcp.generic"},"vars":{"listen_port":{"type":"integer","value":8080},"ssl":{"type":"yaml"}}}]}]}
+			]}]`)
+		case "/s/team-a/api/fleet/package_policies/upgrade":
+			require.JSONEq(t, `{"packagePolicyIds":["pp-1"]}`, string(body))
+			fmt.Fprint(w, `[{"id":"pp-1","name":"tcp-1","success":false,"statusCode":400,"body":{"message":"conflicting vars"}}]`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	dryRun, diags := fleet.DryRunUpgradePackagePolicy(ctx, client, "pp-1", "team-a", "1.2.0")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.False(t, dryRun.HasErrors)
+	require.Len(t, dryRun.Diff, 2)
+	require.Equal(t, "1.2.0", dryRun.Diff[1].Package.Version)
+	require.Equal(t, "tcp.generic", dryRun.Diff[1].Inputs[0].Streams[0].DataStream.Dataset)
+	require.Contains(t, dryRun.Diff[1].Inputs[0].Streams[0].Vars, "ssl")
+
+	diags = fleet.UpgradePackagePolicy(ctx, client, "pp-1", "team-a")
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "conflicting vars")
+}
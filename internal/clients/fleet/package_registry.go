@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultPackageRegistryURL is the Elastic Package Registry Fleet uses unless
+// Kibana is configured with a custom registry.
+const DefaultPackageRegistryURL = "https://epr.elastic.co"
+
+// RegistryVersionsRequest selects the versions of a package listed by
+// ListRegistryPackageVersions.
+type RegistryVersionsRequest struct {
+	RegistryURL string
+	Name        string
+	// KibanaVersion limits the versions to those compatible with this Kibana
+	// version. All versions are listed when empty.
+	KibanaVersion string
+	Prerelease    bool
+}
+
+// ListRegistryPackageVersions lists every published version of a package with
+// the package registry search API. Fleet only reports the latest and the
+// installed version of a package, the version history is only available from
+// the registry.
+func ListRegistryPackageVersions(ctx context.Context, httpClient *http.Client, req RegistryVersionsRequest) ([]string, diag.Diagnostics) {
+	query := url.Values{}
+	query.Set("package", req.Name)
+	query.Set("all", "true")
+	query.Set("prerelease", strconv.FormatBool(req.Prerelease))
+	if req.KibanaVersion != "" {
+		// Snapshot builds report e.g. 9.1.0-SNAPSHOT, which the registry does
+		// not treat as compatible with ^9.1.0.
+		query.Set("kibana.version", strings.SplitN(req.KibanaVersion, "-", 2)[0])
+	}
+
+	reqURL := strings.TrimRight(req.RegistryURL, "/") + "/search?" + query.Encode()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+
+	httpResp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(fmt.Errorf("unable to search the package registry: %w", err))
+	}
+	defer httpResp.Body.Close()
+
+	body, _ := io.ReadAll(httpResp.Body)
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(httpResp.StatusCode, body)
+	}
+
+	var results []struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(body, &results); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(err)
+	}
+
+	versions := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Name == req.Name {
+			versions = append(versions, result.Version)
+		}
+	}
+	return versions, nil
+}
@@ -206,6 +206,15 @@ func unpackGetPackagesItems(resp *kbapi.KibanaHTTPAPIsGetPackagesResponse, conte
 }
 
 func GetPackages(ctx context.Context, client *Client, prerelease bool, spaceID string) ([]kbapi.KibanaHTTPAPIsPackageListItem, diag.Diagnostics) {
+	resp, diags := getPackages(ctx, client, prerelease, spaceID)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return unpackGetPackagesItems(resp.JSON200, resp.ContentType())
+}
+
+// getPackages calls the package list API and returns its successful response.
+func getPackages(ctx context.Context, client *Client, prerelease bool, spaceID string) (*kbapi.GetFleetEpmPackagesResponse, diag.Diagnostics) {
 	params := kbapi.GetFleetEpmPackagesParams{
 		Prerelease: &prerelease,
 	}
@@ -217,11 +226,7 @@ func GetPackages(ctx context.Context, client *Client, prerelease bool, spaceID s
 
 	switch resp.StatusCode() {
 	case http.StatusOK:
-		items, diags := unpackGetPackagesItems(resp.JSON200, resp.ContentType())
-		if diags.HasError() {
-			return nil, diags
-		}
-		return items, nil
+		return resp, nil
 	case http.StatusBadRequest:
 		// Older Kibana versions (pre-8.7) do not recognise the prerelease query
 		// parameter and return 400 with "definition for this key is missing".
@@ -233,11 +238,7 @@ func GetPackages(ctx context.Context, client *Client, prerelease bool, spaceID s
 				return nil, diagutil.FrameworkDiagFromError(retryErr)
 			}
 			if retryResp.StatusCode() == http.StatusOK {
-				items, diags := unpackGetPackagesItems(retryResp.JSON200, retryResp.ContentType())
-				if diags.HasError() {
-					return nil, diags
-				}
-				return items, nil
+				return retryResp, nil
 			}
 			return nil, diagutil.ReportUnknownHTTPError(retryResp.StatusCode(), retryResp.Body)
 		}
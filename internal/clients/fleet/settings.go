@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Settings are the global Fleet settings.
+type Settings struct {
+	ID                                 string                          `json:"id"`
+	PrereleaseIntegrationsEnabled      bool                            `json:"prerelease_integrations_enabled"`
+	DeleteUnenrolledAgents             *DeleteUnenrolledAgentsSettings `json:"delete_unenrolled_agents,omitempty"`
+	SecretStorageRequirementsMet       *bool                           `json:"secret_storage_requirements_met,omitempty"`
+	OutputSecretStorageRequirementsMet *bool                           `json:"output_secret_storage_requirements_met,omitempty"`
+}
+
+// DeleteUnenrolledAgentsSettings controls the automatic deletion of
+// unenrolled agents.
+type DeleteUnenrolledAgentsSettings struct {
+	Enabled         bool `json:"enabled"`
+	IsPreconfigured bool `json:"is_preconfigured,omitempty"`
+}
+
+// UpdateSettingsRequest is the body of the update Fleet settings API. Nil
+// fields are left unchanged.
+type UpdateSettingsRequest struct {
+	PrereleaseIntegrationsEnabled *bool                           `json:"prerelease_integrations_enabled,omitempty"`
+	DeleteUnenrolledAgents        *DeleteUnenrolledAgentsSettings `json:"delete_unenrolled_agents,omitempty"`
+}
+
+// SpaceSettings are the Fleet settings of a Kibana space.
+type SpaceSettings struct {
+	AllowedNamespacePrefixes []string `json:"allowed_namespace_prefixes"`
+	ManagedBy                string   `json:"managed_by,omitempty"`
+}
+
+// GetSettings reads the global Fleet settings.
+func GetSettings(ctx context.Context, client *Client) (*Settings, diag.Diagnostics) {
+	var result struct {
+		Item Settings `json:"item"`
+	}
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method: http.MethodGet,
+		Path:   "/api/fleet/settings",
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	return &result.Item, nil
+}
+
+// UpdateSettings updates the global Fleet settings.
+func UpdateSettings(ctx context.Context, client *Client, body UpdateSettingsRequest) (*Settings, diag.Diagnostics) {
+	var result struct {
+		Item Settings `json:"item"`
+	}
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method: http.MethodPut,
+		Path:   "/api/fleet/settings",
+		Body:   body,
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	return &result.Item, nil
+}
+
+// GetSpaceSettings reads the Fleet settings of a Kibana space.
+func GetSpaceSettings(ctx context.Context, client *Client, spaceID string) (*SpaceSettings, diag.Diagnostics) {
+	var result struct {
+		Item SpaceSettings `json:"item"`
+	}
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/space_settings",
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	return &result.Item, nil
+}
+
+// UpdateSpaceSettings replaces the Fleet settings of a Kibana space.
+func UpdateSpaceSettings(ctx context.Context, client *Client, spaceID string, body SpaceSettings) (*SpaceSettings, diag.Diagnostics) {
+	var result struct {
+		Item SpaceSettings `json:"item"`
+	}
+	status, respBody, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodPut,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/space_settings",
+		Body:    body,
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, respBody)
+	}
+	return &result.Item, nil
+}
@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/fleet/settings":
+			fmt.Fprint(w, `{"item":{"id":"fleet-default-settings","prerelease_integrations_enabled":false,"secret_storage_requirements_met":true}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/fleet/settings":
+			var body json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.JSONEq(t, `{"prerelease_integrations_enabled":true,"delete_unenrolled_agents":{"enabled":true,"is_preconfigured":false}}`, string(body))
+			fmt.Fprint(w, `{"item":{"id":"fleet-default-settings","prerelease_integrations_enabled":true,"delete_unenrolled_agents":{"enabled":true,"is_preconfigured":false}}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/s/team-a/api/fleet/space_settings":
+			var body json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.JSONEq(t, `{"allowed_namespace_prefixes":["team_a"]}`, string(body))
+			fmt.Fprint(w, `{"item":{"allowed_namespace_prefixes":["team_a"]}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	settings, diags := fleet.GetSettings(ctx, client)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, new(true), settings.SecretStorageRequirementsMet)
+
+	settings, diags = fleet.UpdateSettings(ctx, client, fleet.UpdateSettingsRequest{
+		PrereleaseIntegrationsEnabled: new(true),
+		DeleteUnenrolledAgents:        &fleet.DeleteUnenrolledAgentsSettings{Enabled: true},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.True(t, settings.PrereleaseIntegrationsEnabled)
+	require.True(t, settings.DeleteUnenrolledAgents.Enabled)
+
+	spaceSettings, diags := fleet.UpdateSpaceSettings(ctx, client, "team-a", fleet.SpaceSettings{AllowedNamespacePrefixes: []string{"team_a"}})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []string{"team_a"}, spaceSettings.AllowedNamespacePrefixes)
+}
@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const uninstallTokensMaxPerPage = 100
+
+// UninstallToken is the uninstall token of an agent policy. Token is only
+// set when the token was read individually, the list API returns metadata.
+type UninstallToken struct {
+	ID         string  `json:"id"`
+	PolicyID   string  `json:"policy_id"`
+	PolicyName *string `json:"policy_name"`
+	CreatedAt  string  `json:"created_at"`
+	Token      string  `json:"token"`
+}
+
+// GetLatestUninstallToken returns the metadata of the latest uninstall token
+// of the given agent policy, or nil when Fleet has none.
+func GetLatestUninstallToken(ctx context.Context, client *Client, spaceID, policyID string) (*UninstallToken, diag.Diagnostics) {
+	for page := 1; ; page++ {
+		query := url.Values{}
+		// policyId is a partial match, the exact policy is selected below.
+		query.Set("policyId", policyID)
+		query.Set("page", strconv.Itoa(page))
+		query.Set("perPage", strconv.Itoa(uninstallTokensMaxPerPage))
+
+		var result struct {
+			Items []UninstallToken `json:"items"`
+			Total int              `json:"total"`
+		}
+		status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+			Method:  http.MethodGet,
+			SpaceID: spaceID,
+			Path:    "/api/fleet/uninstall_tokens",
+			Query:   query,
+		}, &result)
+		if diags.HasError() {
+			return nil, diags
+		}
+		switch status {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			return nil, nil
+		default:
+			return nil, diagutil.ReportUnknownHTTPError(status, body)
+		}
+
+		for i := range result.Items {
+			if result.Items[i].PolicyID == policyID {
+				return &result.Items[i], nil
+			}
+		}
+		if len(result.Items) == 0 || page*uninstallTokensMaxPerPage >= result.Total {
+			return nil, nil
+		}
+	}
+}
+
+// GetUninstallToken reads a single uninstall token, including the decrypted
+// token. It returns nil when the token does not exist.
+func GetUninstallToken(ctx context.Context, client *Client, spaceID, tokenID string) (*UninstallToken, diag.Diagnostics) {
+	var result struct {
+		Item UninstallToken `json:"item"`
+	}
+	status, body, diags := kibanaoapi.DoRawRequest(ctx, client, kibanaoapi.RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/fleet/uninstall_tokens/" + url.PathEscape(tokenID),
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	switch status {
+	case http.StatusOK:
+		return &result.Item, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+}
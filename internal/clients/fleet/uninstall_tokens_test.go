@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fleet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUninstallTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/s/endpoint/api/fleet/uninstall_tokens":
+			require.Equal(t, "policy-1", r.URL.Query().Get("policyId"))
+			fmt.Fprint(w, `{"items":[{"id":"token-10","policy_id":"policy-10","created_at":"2026-01-02T00:00:00Z"},{"id":"token-1","policy_id":"policy-1","policy_name":"Endpoints","created_at":"2026-01-01T00:00:00Z"}],"total":2,"page":1,"perPage":100}`)
+		case "/s/endpoint/api/fleet/uninstall_tokens/token-1":
+			fmt.Fprint(w, `{"item":{"id":"token-1","policy_id":"policy-1","policy_name":"Endpoints","created_at":"2026-01-01T00:00:00Z","token":"secret"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"statusCode":404,"error":"Not Found","message":"not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	latest, diags := fleet.GetLatestUninstallToken(ctx, client, "endpoint", "policy-1")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, &fleet.UninstallToken{ID: "token-1", PolicyID: "policy-1", PolicyName: new("Endpoints"), CreatedAt: "2026-01-01T00:00:00Z"}, latest)
+
+	token, diags := fleet.GetUninstallToken(ctx, client, "endpoint", latest.ID)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, "secret", token.Token)
+
+	token, diags = fleet.GetUninstallToken(ctx, client, "endpoint", "missing")
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Nil(t, token)
+}
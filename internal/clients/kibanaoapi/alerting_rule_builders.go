@@ -88,6 +88,9 @@ func ConvertResponseToModel(spaceID string, resp any) (*models.AlertingRule, dia
 				} `json:"timeframe"`
 			} `json:"alerts_filter"`
 		} `json:"actions"`
+		MuteAll        bool                 `json:"mute_all"`
+		MutedAlertIDs  []string             `json:"muted_alert_ids"`
+		SnoozeSchedule []alertingRuleSnooze `json:"snooze_schedule"`
 	}
 
 	if err := json.Unmarshal(data, &intermediate); err != nil {
@@ -155,6 +158,11 @@ func ConvertResponseToModel(spaceID string, resp any) (*models.AlertingRule, dia
 		}
 	}
 
+	snoozeSchedule := make([]models.AlertingRuleSnooze, 0, len(intermediate.SnoozeSchedule))
+	for _, snooze := range intermediate.SnoozeSchedule {
+		snoozeSchedule = append(snoozeSchedule, snooze.toModel())
+	}
+
 	var lastExecutionDate *time.Time
 	if intermediate.ExecutionStatus.LastExecutionDate != "" {
 		if parsed, err := time.Parse(time.RFC3339, intermediate.ExecutionStatus.LastExecutionDate); err == nil {
@@ -186,9 +194,12 @@ func ConvertResponseToModel(spaceID string, resp any) (*models.AlertingRule, dia
 			LastExecutionDate: lastExecutionDate,
 			Status:            status,
 		},
-		Actions:    actions,
-		AlertDelay: alertDelay,
-		Flapping:   flapping,
+		Actions:        actions,
+		AlertDelay:     alertDelay,
+		Flapping:       flapping,
+		MuteAll:        &intermediate.MuteAll,
+		MutedAlertIDs:  intermediate.MutedAlertIDs,
+		SnoozeSchedule: snoozeSchedule,
 	}, nil
 }
 
@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const alertingRuleFindMaxPerPage = 100
+
+// AlertingRuleFindParams are the filters for FindAlertingRules. Empty fields
+// are not applied.
+type AlertingRuleFindParams struct {
+	// Search is matched against the rule name.
+	Search     string
+	RuleTypeID string
+	Consumer   string
+	// Tags matches rules carrying any of the given tags.
+	Tags    []string
+	Enabled *bool
+}
+
+// filter renders the params as a KQL filter on the rule saved object.
+func (p AlertingRuleFindParams) filter() string {
+	var clauses []string
+	if p.RuleTypeID != "" {
+		clauses = append(clauses, "alert.attributes.alertTypeId:"+kibanautil.KQLQuote(p.RuleTypeID))
+	}
+	if p.Consumer != "" {
+		clauses = append(clauses, "alert.attributes.consumer:"+kibanautil.KQLQuote(p.Consumer))
+	}
+	if len(p.Tags) > 0 {
+		tags := make([]string, 0, len(p.Tags))
+		for _, tag := range p.Tags {
+			tags = append(tags, kibanautil.KQLQuote(tag))
+		}
+		clauses = append(clauses, "alert.attributes.tags:("+strings.Join(tags, " or ")+")")
+	}
+	if p.Enabled != nil {
+		clauses = append(clauses, "alert.attributes.enabled:"+strconv.FormatBool(*p.Enabled))
+	}
+	return strings.Join(clauses, " and ")
+}
+
+type alertingRuleFindResponse struct {
+	Page    int               `json:"page"`
+	PerPage int               `json:"per_page"`
+	Total   int               `json:"total"`
+	Data    []json.RawMessage `json:"data"`
+}
+
+// FindAlertingRules returns every rule in the space matching params, following
+// the _find API's pagination.
+func FindAlertingRules(ctx context.Context, client *Client, spaceID string, params AlertingRuleFindParams) ([]models.AlertingRule, diag.Diagnostics) {
+	var rules []models.AlertingRule
+
+	for page := 1; ; page++ {
+		result, diags := findAlertingRulesPage(ctx, client, spaceID, params, page)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, raw := range result.Data {
+			var data map[string]any
+			if err := json.Unmarshal(raw, &data); err != nil {
+				return nil, diagutil.ErrDiag("Failed to unmarshal alerting rule", err)
+			}
+			rule, diags := ConvertResponseToModel(spaceID, data)
+			if diags.HasError() {
+				return nil, diags
+			}
+			rules = append(rules, *rule)
+		}
+
+		if len(result.Data) == 0 || len(rules) >= result.Total {
+			return rules, nil
+		}
+	}
+}
+
+func findAlertingRulesPage(ctx context.Context, client *Client, spaceID string, params AlertingRuleFindParams, page int) (*alertingRuleFindResponse, diag.Diagnostics) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(alertingRuleFindMaxPerPage))
+	query.Set("sort_field", "name")
+	query.Set("sort_order", "asc")
+	if params.Search != "" {
+		query.Set("search", params.Search)
+		query.Set("search_fields", "name")
+	}
+	if filter := params.filter(); filter != "" {
+		query.Set("filter", filter)
+	}
+
+	var result alertingRuleFindResponse
+	status, body, diags := DoRawRequest(ctx, client, RawRequest{
+		Method:  http.MethodGet,
+		SpaceID: spaceID,
+		Path:    "/api/alerting/rules/_find",
+		Query:   query,
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportUnknownHTTPError(status, body)
+	}
+	return &result, nil
+}
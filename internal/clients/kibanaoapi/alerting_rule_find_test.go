@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	kibanaoapi "github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindAlertingRules(t *testing.T) {
+	const total = 150
+	var pages []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/s/my-space/api/alerting/rules/_find", r.URL.Path)
+		q := r.URL.Query()
+		require.Equal(t, `alert.attributes.alertTypeId:".index-threshold" and alert.attributes.tags:("prod" or "say \"hi\"") and alert.attributes.enabled:false`, q.Get("filter"))
+		require.Equal(t, "cpu", q.Get("search"))
+		require.Equal(t, "name", q.Get("search_fields"))
+
+		page, err := strconv.Atoi(q.Get("page"))
+		require.NoError(t, err)
+		pages = append(pages, page)
+
+		perPage, err := strconv.Atoi(q.Get("per_page"))
+		require.NoError(t, err)
+		var data []map[string]any
+		for i := (page - 1) * perPage; i < min(page*perPage, total); i++ {
+			data = append(data, map[string]any{
+				"id":           fmt.Sprintf("rule-%d", i),
+				"name":         fmt.Sprintf("cpu %d", i),
+				"consumer":     "alerts",
+				"rule_type_id": ".index-threshold",
+				"enabled":      false,
+				"schedule":     map[string]any{"interval": "1m"},
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"page": page, "per_page": perPage, "total": total, "data": data})
+	}))
+	defer server.Close()
+
+	client, err := kibanaoapi.NewClient(kibanaoapi.Config{URL: server.URL, Username: "test", Password: "test"})
+	require.NoError(t, err)
+
+	rules, diags := kibanaoapi.FindAlertingRules(context.Background(), client, "my-space", kibanaoapi.AlertingRuleFindParams{
+		Search:     "cpu",
+		RuleTypeID: ".index-threshold",
+		Tags:       []string{"prod", `say "hi"`},
+		Enabled:    new(false),
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Len(t, rules, total)
+	require.Equal(t, []int{1, 2}, pages)
+	require.Equal(t, "rule-149", rules[149].RuleID)
+	require.Equal(t, "my-space", rules[0].SpaceID)
+}
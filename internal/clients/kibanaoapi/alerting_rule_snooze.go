@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// alertingRuleSnooze is the wire form of a rule snooze, as returned in the
+// rule's snooze_schedule and accepted by the _snooze endpoint.
+type alertingRuleSnooze struct {
+	ID       string            `json:"id,omitempty"`
+	Duration int64             `json:"duration"`
+	RRule    alertingRuleRRule `json:"rRule"`
+}
+
+type alertingRuleRRule struct {
+	DTStart    string  `json:"dtstart"`
+	TZID       string  `json:"tzid"`
+	Freq       *int    `json:"freq,omitempty"`
+	Interval   *int    `json:"interval,omitempty"`
+	Count      *int    `json:"count,omitempty"`
+	Until      *string `json:"until,omitempty"`
+	ByWeekday  []any   `json:"byweekday,omitempty"`
+	ByMonthDay []int   `json:"bymonthday,omitempty"`
+	ByMonth    []int   `json:"bymonth,omitempty"`
+}
+
+func (s alertingRuleSnooze) toModel() models.AlertingRuleSnooze {
+	var byWeekday []string
+	for _, d := range s.RRule.ByWeekday {
+		// Kibana accepts weekdays as RRule strings (e.g. "MO", "+1TU"); older
+		// snoozes created from the UI may store them as 0-based numbers.
+		switch v := d.(type) {
+		case string:
+			byWeekday = append(byWeekday, v)
+		case float64:
+			byWeekday = append(byWeekday, rruleWeekdays[int(v)%len(rruleWeekdays)])
+		}
+	}
+
+	return models.AlertingRuleSnooze{
+		ID:       s.ID,
+		Duration: s.Duration,
+		RRule: models.AlertingRuleRRule{
+			DTStart:    s.RRule.DTStart,
+			TZID:       s.RRule.TZID,
+			Freq:       s.RRule.Freq,
+			Interval:   s.RRule.Interval,
+			Count:      s.RRule.Count,
+			Until:      s.RRule.Until,
+			ByWeekday:  byWeekday,
+			ByMonthDay: s.RRule.ByMonthDay,
+			ByMonth:    s.RRule.ByMonth,
+		},
+	}
+}
+
+var rruleWeekdays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+func alertingRuleSnoozeFromModel(s models.AlertingRuleSnooze) alertingRuleSnooze {
+	var byWeekday []any
+	for _, d := range s.RRule.ByWeekday {
+		byWeekday = append(byWeekday, d)
+	}
+
+	return alertingRuleSnooze{
+		ID:       s.ID,
+		Duration: s.Duration,
+		RRule: alertingRuleRRule{
+			DTStart:    s.RRule.DTStart,
+			TZID:       s.RRule.TZID,
+			Freq:       s.RRule.Freq,
+			Interval:   s.RRule.Interval,
+			Count:      s.RRule.Count,
+			Until:      s.RRule.Until,
+			ByWeekday:  byWeekday,
+			ByMonthDay: s.RRule.ByMonthDay,
+			ByMonth:    s.RRule.ByMonth,
+		},
+	}
+}
+
+// SnoozeAlertingRule adds a snooze to the rule. Kibana exposes this only as an
+// internal route, so the request carries the internal origin header.
+func SnoozeAlertingRule(ctx context.Context, client *Client, spaceID string, ruleID string, snooze models.AlertingRuleSnooze) diag.Diagnostics {
+	return postAlertingRuleAction(ctx, client, RawRequest{
+		SpaceID: spaceID,
+		Path:    "/internal/alerting/rule/" + url.PathEscape(ruleID) + "/_snooze",
+		Body:    map[string]any{"snooze_schedule": alertingRuleSnoozeFromModel(snooze)},
+	})
+}
+
+// UnsnoozeAlertingRule removes the given snoozes from the rule.
+func UnsnoozeAlertingRule(ctx context.Context, client *Client, spaceID string, ruleID string, scheduleIDs []string) diag.Diagnostics {
+	return postAlertingRuleAction(ctx, client, RawRequest{
+		SpaceID: spaceID,
+		Path:    "/internal/alerting/rule/" + url.PathEscape(ruleID) + "/_unsnooze",
+		Body:    map[string]any{"schedule_ids": scheduleIDs},
+	})
+}
+
+// MuteAllAlertingRule mutes (or, when mute is false, unmutes) every alert of the rule.
+func MuteAllAlertingRule(ctx context.Context, client *Client, spaceID string, ruleID string, mute bool) diag.Diagnostics {
+	action := "_mute_all"
+	if !mute {
+		action = "_unmute_all"
+	}
+	return postAlertingRuleAction(ctx, client, RawRequest{
+		SpaceID: spaceID,
+		Path:    "/api/alerting/rule/" + url.PathEscape(ruleID) + "/" + action,
+	})
+}
+
+// MuteAlertingRuleAlert mutes (or, when mute is false, unmutes) a single alert of the rule.
+func MuteAlertingRuleAlert(ctx context.Context, client *Client, spaceID string, ruleID string, alertID string, mute bool) diag.Diagnostics {
+	action := "_mute"
+	if !mute {
+		action = "_unmute"
+	}
+	return postAlertingRuleAction(ctx, client, RawRequest{
+		SpaceID: spaceID,
+		Path:    "/api/alerting/rule/" + url.PathEscape(ruleID) + "/alert/" + url.PathEscape(alertID) + "/" + action,
+	})
+}
+
+// postAlertingRuleAction posts to one of the rule action routes that are
+// missing from kbapi. They answer with 204, or 200 on older Kibana versions.
+func postAlertingRuleAction(ctx context.Context, client *Client, req RawRequest) diag.Diagnostics {
+	req.Method = http.MethodPost
+	status, body, diags := DoRawRequest(ctx, client, req, nil)
+	if diags.HasError() {
+		return diags
+	}
+	return diagutil.HandleStatusResponse(status, body, http.StatusNoContent, http.StatusOK)
+}
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kibanaoapi "github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_convertResponseToModel_snoozeAndMute(t *testing.T) {
+	response := map[string]any{
+		"id":              "id",
+		"name":            "name",
+		"consumer":        "alerts",
+		"rule_type_id":    ".index-threshold",
+		"schedule":        map[string]any{"interval": "1m"},
+		"mute_all":        true,
+		"muted_alert_ids": []string{"host-1"},
+		"snooze_schedule": []any{
+			map[string]any{
+				"id":       "snooze-1",
+				"duration": 3600000,
+				"rRule": map[string]any{
+					"dtstart":   "2026-01-01T00:00:00.000Z",
+					"tzid":      "UTC",
+					"freq":      2,
+					"interval":  1,
+					"byweekday": []any{"MO", float64(4)},
+				},
+			},
+		},
+	}
+
+	model, diags := kibanaoapi.ConvertResponseToModel("default", response)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, new(true), model.MuteAll)
+	require.Equal(t, []string{"host-1"}, model.MutedAlertIDs)
+	require.Equal(t, []models.AlertingRuleSnooze{{
+		ID:       "snooze-1",
+		Duration: 3600000,
+		RRule: models.AlertingRuleRRule{
+			DTStart:   "2026-01-01T00:00:00.000Z",
+			TZID:      "UTC",
+			Freq:      new(2),
+			Interval:  new(1),
+			ByWeekday: []string{"MO", "FR"},
+		},
+	}}, model.SnoozeSchedule)
+}
+
+func Test_alertingRuleSnoozeAndMuteRequests(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(context.Context, *kibanaoapi.Client) diag.Diagnostics
+		expectedPath string
+		expectedBody map[string]any
+		internal     bool
+	}{
+		{
+			name: "snooze",
+			call: func(ctx context.Context, c *kibanaoapi.Client) diag.Diagnostics {
+				return kibanaoapi.SnoozeAlertingRule(ctx, c, "my-space", "rule-1", models.AlertingRuleSnooze{
+					Duration: 60000,
+					RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T00:00:00.000Z", TZID: "UTC", Count: new(1)},
+				})
+			},
+			expectedPath: "/s/my-space/internal/alerting/rule/rule-1/_snooze",
+			expectedBody: map[string]any{"snooze_schedule": map[string]any{
+				"duration": float64(60000),
+				"rRule":    map[string]any{"dtstart": "2026-01-01T00:00:00.000Z", "tzid": "UTC", "count": float64(1)},
+			}},
+			internal: true,
+		},
+		{
+			name: "unsnooze",
+			call: func(ctx context.Context, c *kibanaoapi.Client) diag.Diagnostics {
+				return kibanaoapi.UnsnoozeAlertingRule(ctx, c, "default", "rule-1", []string{"snooze-1"})
+			},
+			expectedPath: "/internal/alerting/rule/rule-1/_unsnooze",
+			expectedBody: map[string]any{"schedule_ids": []any{"snooze-1"}},
+			internal:     true,
+		},
+		{
+			name: "unmute all",
+			call: func(ctx context.Context, c *kibanaoapi.Client) diag.Diagnostics {
+				return kibanaoapi.MuteAllAlertingRule(ctx, c, "default", "rule-1", false)
+			},
+			expectedPath: "/api/alerting/rule/rule-1/_unmute_all",
+		},
+		{
+			name: "mute alert",
+			call: func(ctx context.Context, c *kibanaoapi.Client) diag.Diagnostics {
+				return kibanaoapi.MuteAlertingRuleAlert(ctx, c, "default", "rule-1", "host/1", true)
+			},
+			expectedPath: "/api/alerting/rule/rule-1/alert/host%2F1/_mute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, tt.expectedPath, r.URL.EscapedPath())
+				if tt.internal {
+					require.NotEmpty(t, r.Header.Get("x-elastic-internal-origin"))
+				}
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if tt.expectedBody != nil {
+					var got map[string]any
+					require.NoError(t, json.Unmarshal(body, &got))
+					require.Equal(t, tt.expectedBody, got)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, err := kibanaoapi.NewClient(kibanaoapi.Config{URL: server.URL, Username: "test", Password: "test"})
+			require.NoError(t, err)
+
+			diags := tt.call(context.Background(), client)
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		})
+	}
+}
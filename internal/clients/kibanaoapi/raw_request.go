@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// RawRequest is a request to a Kibana endpoint that the generated kbapi client
+// does not cover. Prefer the generated operations wherever they exist.
+type RawRequest struct {
+	Method string
+	// Path is the API path. It is prefixed with the space when SpaceID is set.
+	Path    string
+	SpaceID string
+	Query   url.Values
+	// Body is sent as JSON when set.
+	Body any
+	// RawBody is sent verbatim with ContentType, e.g. for multipart uploads.
+	// It takes precedence over Body.
+	RawBody     []byte
+	ContentType string
+}
+
+// DoRawRequest sends req through the client's transport, which handles
+// authentication and the kbn-xsrf header. It returns the status code and body
+// of the response; a 200 response is also decoded into result when result is
+// non-nil. Non-2xx statuses are left to the caller.
+func DoRawRequest(ctx context.Context, client *Client, req RawRequest, result any) (int, []byte, diag.Diagnostics) {
+	var reqBody io.Reader
+	contentType := req.ContentType
+	switch {
+	case req.RawBody != nil:
+		reqBody = bytes.NewReader(req.RawBody)
+	case req.Body != nil:
+		payload, err := json.Marshal(req.Body)
+		if err != nil {
+			return 0, nil, diagutil.FrameworkDiagFromError(err)
+		}
+		reqBody = bytes.NewReader(payload)
+		contentType = "application/json"
+	}
+
+	reqURL := strings.TrimRight(client.URL, "/") + kibanautil.BuildSpaceAwarePath(req.SpaceID, req.Path)
+	if len(req.Query) > 0 {
+		reqURL += "?" + req.Query.Encode()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, reqURL, reqBody)
+	if err != nil {
+		return 0, nil, diagutil.FrameworkDiagFromError(err)
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if strings.HasPrefix(req.Path, "/internal/") {
+		httpReq.Header.Set("x-elastic-internal-origin", "Kibana")
+	}
+
+	httpResp, err := client.HTTP.Do(httpReq)
+	if err != nil {
+		return 0, nil, diagutil.FrameworkDiagFromError(fmt.Errorf("request to %s failed: %w", req.Path, err))
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return 0, nil, diagutil.FrameworkDiagFromError(fmt.Errorf("unable to read response of %s: %w", req.Path, err))
+	}
+
+	if httpResp.StatusCode == http.StatusOK && result != nil {
+		if err := json.Unmarshal(body, result); err != nil {
+			return 0, nil, diagutil.FrameworkDiagFromError(fmt.Errorf("unable to decode response of %s: %w", req.Path, err))
+		}
+	}
+	return httpResp.StatusCode, body, nil
+}
@@ -0,0 +1,235 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// detectionRulesFindBatchSize bounds the number of rule_ids per _find filter
+// so the request URL stays well below common proxy limits.
+const detectionRulesFindBatchSize = 50
+
+// DetectionRulesImportOptions are the query options of the detection rules
+// import API.
+type DetectionRulesImportOptions struct {
+	Overwrite                 bool
+	OverwriteExceptions       bool
+	OverwriteActionConnectors bool
+}
+
+// DetectionRulesImportError is a single per-rule failure reported by the
+// import API.
+type DetectionRulesImportError struct {
+	RuleID string `json:"rule_id"`
+	ID     string `json:"id"`
+	Error  struct {
+		StatusCode int    `json:"status_code"`
+		Message    string `json:"message"`
+	} `json:"error"`
+}
+
+// DetectionRulesImportResult is the response of the detection rules import API.
+type DetectionRulesImportResult struct {
+	Success                      bool                        `json:"success"`
+	SuccessCount                 int64                       `json:"success_count"`
+	RulesCount                   int64                       `json:"rules_count"`
+	Errors                       []DetectionRulesImportError `json:"errors"`
+	ExceptionsSuccess            bool                        `json:"exceptions_success"`
+	ExceptionsSuccessCount       int64                       `json:"exceptions_success_count"`
+	ActionConnectorsSuccess      bool                        `json:"action_connectors_success"`
+	ActionConnectorsSuccessCount int64                       `json:"action_connectors_success_count"`
+}
+
+// DetectionRuleRevision identifies the stored version of a detection rule.
+type DetectionRuleRevision struct {
+	ID        string `json:"id"`
+	RuleID    string `json:"rule_id"`
+	Revision  int64  `json:"revision"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DetectionRuleIDsFromNDJSON returns the rule_id of every rule in an NDJSON
+// export, in file order. Exception lists, exception items, action connectors
+// and the export summary line are skipped.
+func DetectionRuleIDsFromNDJSON(contents []byte) ([]string, error) {
+	var ruleIDs []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var obj map[string]any
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, fmt.Errorf("line %d is not a JSON object: %w", line, err)
+		}
+		if _, isList := obj["list_id"]; isList {
+			continue
+		}
+		if ruleID, ok := obj["rule_id"].(string); ok && ruleID != "" {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return ruleIDs, nil
+}
+
+// ImportDetectionRules imports an NDJSON rules export through the detection
+// engine import API.
+func ImportDetectionRules(ctx context.Context, client *Client, spaceID string, fileContents []byte, opts DetectionRulesImportOptions) (*DetectionRulesImportResult, diag.Diagnostics) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreateFormFile("file", "rules.ndjson")
+	if err != nil {
+		return nil, diagutil.FrameworkDiagFromError(fmt.Errorf("failed to create multipart form file: %w", err))
+	}
+	if _, err := part.Write(fileContents); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(fmt.Errorf("failed to write file contents to multipart form: %w", err))
+	}
+	if err := writer.Close(); err != nil {
+		return nil, diagutil.FrameworkDiagFromError(fmt.Errorf("failed to close multipart writer: %w", err))
+	}
+
+	query := url.Values{}
+	query.Set("overwrite", strconv.FormatBool(opts.Overwrite))
+	query.Set("overwrite_exceptions", strconv.FormatBool(opts.OverwriteExceptions))
+	query.Set("overwrite_action_connectors", strconv.FormatBool(opts.OverwriteActionConnectors))
+
+	var result DetectionRulesImportResult
+	status, body, diags := DoRawRequest(ctx, client, RawRequest{
+		Method:      http.MethodPost,
+		SpaceID:     spaceID,
+		Path:        "/api/detection_engine/rules/_import",
+		Query:       query,
+		RawBody:     buf.Bytes(),
+		ContentType: writer.FormDataContentType(),
+	}, &result)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportKibanaBoomHTTPError(status, "failed to import detection rules", body)
+	}
+	return &result, nil
+}
+
+// ExportDetectionRules exports the given rules as NDJSON. All rules in the
+// space are exported when ruleIDs is empty.
+func ExportDetectionRules(ctx context.Context, client *Client, spaceID string, ruleIDs []string, excludeExportDetails bool) ([]byte, diag.Diagnostics) {
+	query := url.Values{}
+	query.Set("exclude_export_details", strconv.FormatBool(excludeExportDetails))
+	query.Set("file_name", "rules.ndjson")
+
+	req := RawRequest{
+		Method:  http.MethodPost,
+		SpaceID: spaceID,
+		Path:    "/api/detection_engine/rules/_export",
+		Query:   query,
+	}
+	if len(ruleIDs) > 0 {
+		type exportObject struct {
+			RuleID string `json:"rule_id"`
+		}
+		objects := make([]exportObject, 0, len(ruleIDs))
+		for _, ruleID := range ruleIDs {
+			objects = append(objects, exportObject{RuleID: ruleID})
+		}
+		req.Body = map[string]any{"objects": objects}
+	}
+
+	status, body, diags := DoRawRequest(ctx, client, req, nil)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if status != http.StatusOK {
+		return nil, diagutil.ReportKibanaBoomHTTPError(status, "failed to export detection rules", body)
+	}
+	return body, nil
+}
+
+type detectionRulesFindResponse struct {
+	Page    int                     `json:"page"`
+	PerPage int                     `json:"perPage"`
+	Total   int                     `json:"total"`
+	Data    []DetectionRuleRevision `json:"data"`
+}
+
+// FindDetectionRuleRevisions returns the current revision of each of the given
+// rules, keyed by rule_id. Rules that do not exist are absent from the result.
+func FindDetectionRuleRevisions(ctx context.Context, client *Client, spaceID string, ruleIDs []string) (map[string]DetectionRuleRevision, diag.Diagnostics) {
+	revisions := make(map[string]DetectionRuleRevision, len(ruleIDs))
+
+	for start := 0; start < len(ruleIDs); start += detectionRulesFindBatchSize {
+		batch := ruleIDs[start:min(start+detectionRulesFindBatchSize, len(ruleIDs))]
+		quoted := make([]string, 0, len(batch))
+		for _, ruleID := range batch {
+			quoted = append(quoted, kibanautil.KQLQuote(ruleID))
+		}
+
+		filter := "alert.attributes.params.ruleId:(" + strings.Join(quoted, " OR ") + ")"
+		page := 1
+		perPage := len(batch)
+		params := &kbapi.FindRulesParams{
+			Filter:  &filter,
+			Page:    &page,
+			PerPage: &perPage,
+		}
+
+		resp, err := client.API.FindRulesWithResponse(ctx, params, kibanautil.SpaceAwarePathRequestEditor(spaceID))
+		if err != nil {
+			return nil, diagutil.FrameworkDiagFromError(fmt.Errorf("unable to find detection rules: %w", err))
+		}
+		if resp.StatusCode() != http.StatusOK {
+			return nil, diagutil.ReportKibanaBoomHTTPError(resp.StatusCode(), "failed to find detection rules", resp.Body)
+		}
+
+		// Only the identity and revision of each rule are needed, so the body
+		// is decoded directly rather than through the rule type union.
+		var result detectionRulesFindResponse
+		if err := json.Unmarshal(resp.Body, &result); err != nil {
+			return nil, diagutil.ErrDiag("Failed to unmarshal detection rules find response", err)
+		}
+		for _, rule := range result.Data {
+			revisions[rule.RuleID] = rule
+		}
+	}
+
+	return revisions, nil
+}
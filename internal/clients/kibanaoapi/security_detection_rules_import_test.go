@@ -0,0 +1,195 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kibanaoapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kibanaoapi "github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectionRuleIDsFromNDJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "rules, exceptions, connectors and summary",
+			contents: `{"rule_id":"rule-1","name":"one","type":"query"}
+{"list_id":"list-1","type":"detection"}
+{"item_id":"item-1","list_id":"list-1"}
+
+{"id":"connector-1","type":"action","attributes":{}}
+{"rule_id":"rule-2","name":"two","type":"eql"}
+{"exported_count":2,"exported_rules_count":2,"missing_rules":[]}
+`,
+			expected: []string{"rule-1", "rule-2"},
+		},
+		{
+			name:     "empty file",
+			contents: "\n",
+		},
+		{
+			name:     "invalid line",
+			contents: "{\"rule_id\":\"rule-1\"}\nnot json\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleIDs, err := kibanaoapi.DetectionRuleIDsFromNDJSON([]byte(tt.contents))
+			if tt.wantErr {
+				require.ErrorContains(t, err, "line 2")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ruleIDs)
+		})
+	}
+}
+
+func Test_ImportDetectionRules(t *testing.T) {
+	const contents = `{"rule_id":"rule-1"}` + "\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/s/my-space/api/detection_engine/rules/_import", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("overwrite"))
+		require.Equal(t, "false", r.URL.Query().Get("overwrite_exceptions"))
+		require.Equal(t, "true", r.URL.Query().Get("overwrite_action_connectors"))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		require.Equal(t, "rules.ndjson", header.Filename)
+		body, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, contents, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":false,"success_count":1,"rules_count":2,"errors":[{"rule_id":"rule-2","error":{"status_code":409,"message":"rule_id: \"rule-2\" already exists"}}],"exceptions_success":true,"exceptions_success_count":0,"action_connectors_success":true,"action_connectors_success_count":1}`))
+	}))
+	defer server.Close()
+
+	client, err := kibanaoapi.NewClient(kibanaoapi.Config{URL: server.URL, Username: "test", Password: "test"})
+	require.NoError(t, err)
+
+	result, diags := kibanaoapi.ImportDetectionRules(context.Background(), client, "my-space", []byte(contents), kibanaoapi.DetectionRulesImportOptions{
+		Overwrite:                 true,
+		OverwriteActionConnectors: true,
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.False(t, result.Success)
+	require.Equal(t, int64(1), result.SuccessCount)
+	require.Equal(t, int64(2), result.RulesCount)
+	require.Equal(t, int64(1), result.ActionConnectorsSuccessCount)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "rule-2", result.Errors[0].RuleID)
+	require.Equal(t, 409, result.Errors[0].Error.StatusCode)
+}
+
+func Test_ExportDetectionRules(t *testing.T) {
+	tests := []struct {
+		name         string
+		ruleIDs      []string
+		expectedBody string
+	}{
+		{
+			name:         "selected rules",
+			ruleIDs:      []string{"rule-1", "rule-2"},
+			expectedBody: `{"objects":[{"rule_id":"rule-1"},{"rule_id":"rule-2"}]}`,
+		},
+		{
+			name: "all rules",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/api/detection_engine/rules/_export", r.URL.Path)
+				require.Equal(t, "true", r.URL.Query().Get("exclude_export_details"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if tt.expectedBody == "" {
+					require.Empty(t, body)
+				} else {
+					require.JSONEq(t, tt.expectedBody, string(body))
+				}
+				_, _ = w.Write([]byte(`{"rule_id":"rule-1"}` + "\n"))
+			}))
+			defer server.Close()
+
+			client, err := kibanaoapi.NewClient(kibanaoapi.Config{URL: server.URL, Username: "test", Password: "test"})
+			require.NoError(t, err)
+
+			exported, diags := kibanaoapi.ExportDetectionRules(context.Background(), client, "default", tt.ruleIDs, true)
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			require.Equal(t, `{"rule_id":"rule-1"}`+"\n", string(exported))
+		})
+	}
+}
+
+func Test_FindDetectionRuleRevisions_batchesRuleIDs(t *testing.T) {
+	ruleIDs := make([]string, 120)
+	for i := range ruleIDs {
+		ruleIDs[i] = fmt.Sprintf("rule-%d", i)
+	}
+
+	var batchSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/detection_engine/rules/_find", r.URL.Path)
+		filter := r.URL.Query().Get("filter")
+		require.True(t, strings.HasPrefix(filter, "alert.attributes.params.ruleId:("), filter)
+
+		var data []map[string]any
+		for id := range strings.SplitSeq(strings.TrimSuffix(strings.TrimPrefix(filter, "alert.attributes.params.ruleId:("), ")"), " OR ") {
+			ruleID := strings.Trim(id, `"`)
+			// rule-7 was deleted.
+			if ruleID == "rule-7" {
+				continue
+			}
+			data = append(data, map[string]any{"id": "so-" + ruleID, "rule_id": ruleID, "revision": 3})
+		}
+		batchSizes = append(batchSizes, len(data))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"page": 1, "perPage": len(data), "total": len(data), "data": data})
+	}))
+	defer server.Close()
+
+	client, err := kibanaoapi.NewClient(kibanaoapi.Config{URL: server.URL, Username: "test", Password: "test"})
+	require.NoError(t, err)
+
+	revisions, diags := kibanaoapi.FindDetectionRuleRevisions(context.Background(), client, "default", ruleIDs)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Equal(t, []int{49, 50, 20}, batchSizes)
+	require.Len(t, revisions, 119)
+	require.NotContains(t, revisions, "rule-7")
+	require.Equal(t, kibanaoapi.DetectionRuleRevision{ID: "so-rule-119", RuleID: "rule-119", Revision: 3}, revisions["rule-119"])
+}
@@ -22,40 +22,15 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 func deleteFollowerIndex(ctx context.Context, client *clients.ElasticsearchScopedClient, indexName string, state Model) diag.Diagnostics {
-	return executeDeleteOperations(ctx, client, indexName, planDeleteOperations(state))
-}
-
-func executeDeleteOperations(
-	ctx context.Context,
-	client *clients.ElasticsearchScopedClient,
-	indexName string,
-	ops []apiOperation,
-) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	for _, op := range ops {
-		switch op {
-		case opPause:
-			diags.Append(elasticsearch.PauseFollowerIndex(ctx, client, indexName)...)
-		case opClose:
-			diags.Append(elasticsearch.CloseIndex(ctx, client, indexName)...)
-		case opUnfollow:
-			diags.Append(elasticsearch.UnfollowIndex(ctx, client, indexName)...)
-		case opDeleteIndex:
-			diags.Append(elasticsearch.DeleteIndex(ctx, client, indexName)...)
-		case opOpenIndex:
-			diags.Append(elasticsearch.OpenIndex(ctx, client, indexName)...)
-		default:
-			diags.AddError("Internal error", "Unexpected delete operation: "+op.String())
-		}
-		if diags.HasError() {
-			return diags
-		}
+	diags := ccr.ExecuteUnfollowOperations(ctx, client, indexName, planDeleteOperations(state), nil)
+	if diags.HasError() || !state.DeleteIndexOnDestroy.ValueBool() {
+		return diags
 	}
-
+	diags.Append(elasticsearch.DeleteIndex(ctx, client, indexName)...)
 	return diags
 }
@@ -166,7 +166,7 @@ func TestPlanDeleteOperations(t *testing.T) {
 		t.Parallel()
 		prior := Model{Status: types.StringValue(statusActive), DeleteIndexOnDestroy: types.BoolValue(false)}
 		assert.Equal(t,
-			[]apiOperation{opPause, opClose, opUnfollow, opOpenIndex},
+			[]ccr.UnfollowOperation{ccr.UnfollowOpPause, ccr.UnfollowOpClose, ccr.UnfollowOpUnfollow, ccr.UnfollowOpOpenIndex},
 			planDeleteOperations(prior),
 		)
 	})
@@ -175,16 +175,16 @@ func TestPlanDeleteOperations(t *testing.T) {
 		t.Parallel()
 		prior := Model{Status: types.StringValue(statusPaused), DeleteIndexOnDestroy: types.BoolValue(false)}
 		assert.Equal(t,
-			[]apiOperation{opClose, opUnfollow, opOpenIndex},
+			[]ccr.UnfollowOperation{ccr.UnfollowOpClose, ccr.UnfollowOpUnfollow, ccr.UnfollowOpOpenIndex},
 			planDeleteOperations(prior),
 		)
 	})
 
-	t.Run("delete index on destroy true", func(t *testing.T) {
+	t.Run("delete index on destroy true leaves the index closed", func(t *testing.T) {
 		t.Parallel()
 		prior := Model{Status: types.StringValue(statusActive), DeleteIndexOnDestroy: types.BoolValue(true)}
 		assert.Equal(t,
-			[]apiOperation{opPause, opClose, opUnfollow, opDeleteIndex},
+			[]ccr.UnfollowOperation{ccr.UnfollowOpPause, ccr.UnfollowOpClose, ccr.UnfollowOpUnfollow},
 			planDeleteOperations(prior),
 		)
 	})
@@ -194,7 +194,7 @@ func TestPlanDeleteOperations(t *testing.T) {
 		prior := Model{Status: types.StringValue(statusPaused), DeleteIndexOnDestroy: types.BoolValue(false)}
 		ops := planDeleteOperations(prior)
 		require.NotEmpty(t, ops)
-		assert.Equal(t, opOpenIndex, ops[len(ops)-1])
+		assert.Equal(t, ccr.UnfollowOpOpenIndex, ops[len(ops)-1])
 	})
 }
 
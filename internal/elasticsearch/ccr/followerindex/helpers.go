@@ -36,10 +36,6 @@ const (
 	opPause apiOperation = iota
 	opUpdateSettings
 	opResume
-	opClose
-	opUnfollow
-	opDeleteIndex
-	opOpenIndex
 )
 
 func (op apiOperation) String() string {
@@ -50,14 +46,6 @@ func (op apiOperation) String() string {
 		return "UpdateIndexSettings"
 	case opResume:
 		return "ResumeFollowerIndex"
-	case opClose:
-		return "CloseIndex"
-	case opUnfollow:
-		return "UnfollowIndex"
-	case opDeleteIndex:
-		return "DeleteIndex"
-	case opOpenIndex:
-		return "OpenIndex"
 	default:
 		return ccr.FormatUnknownOperation(int(op))
 	}
@@ -115,18 +103,10 @@ func planUpdateOperations(prior, plan Model) []apiOperation {
 	}
 }
 
-func planDeleteOperations(prior Model) []apiOperation {
-	ops := make([]apiOperation, 0, 5)
-	if prior.Status.ValueString() == statusActive {
-		ops = append(ops, opPause)
-	}
-	ops = append(ops, opClose, opUnfollow)
-	if prior.DeleteIndexOnDestroy.ValueBool() {
-		ops = append(ops, opDeleteIndex)
-	} else {
-		ops = append(ops, opOpenIndex)
-	}
-	return ops
+// planDeleteOperations returns the unfollow sequence run on destroy. The index
+// is only reopened when it is kept.
+func planDeleteOperations(prior Model) []ccr.UnfollowOperation {
+	return ccr.PlanUnfollowOperations(prior.Status.ValueString() == statusActive, !prior.DeleteIndexOnDestroy.ValueBool())
 }
 
 func settingsRawChanged(prior, plan Model) bool {
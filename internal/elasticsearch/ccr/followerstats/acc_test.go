@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package followerstats_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCCRFollowerStatsDataSource(t *testing.T) {
+	ccrEnv := acctest.PreCheckCCR(t)
+	leaderIndexName := sdkacctest.RandStringFromCharSet(12, sdkacctest.CharSetAlpha)
+	followerIndexName := sdkacctest.RandStringFromCharSet(12, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheckCCR(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"remote_cluster_alias": config.StringVariable(ccrEnv.RemoteClusterAlias),
+					"remote_proxy_address": config.StringVariable(ccrEnv.RemoteProxyAddress),
+					"leader_index_name":    config.StringVariable(leaderIndexName),
+					"follower_index_name":  config.StringVariable(followerIndexName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.index", followerIndexName),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.remote_cluster", ccrEnv.RemoteClusterAlias),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.leader_index", leaderIndexName),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.status", "active"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.operations_lag"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.fatal_exceptions.#", "0"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.shards.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ccr_follower_stats.test", "followers.0.shards.0.shard_id", "0"),
+				),
+			},
+		},
+	})
+}
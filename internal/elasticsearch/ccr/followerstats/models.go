@@ -0,0 +1,165 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package followerstats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID        types.String `tfsdk:"id"`
+	Index     types.String `tfsdk:"index"`
+	Followers types.List   `tfsdk:"followers"` // > followerTfModel
+}
+
+type followerTfModel struct {
+	Index                   types.String `tfsdk:"index"`
+	RemoteCluster           types.String `tfsdk:"remote_cluster"`
+	LeaderIndex             types.String `tfsdk:"leader_index"`
+	Status                  types.String `tfsdk:"status"`
+	OperationsLag           types.Int64  `tfsdk:"operations_lag"`
+	TimeSinceLastReadMillis types.Int64  `tfsdk:"time_since_last_read_millis"`
+	FailedReadRequests      types.Int64  `tfsdk:"failed_read_requests"`
+	FailedWriteRequests     types.Int64  `tfsdk:"failed_write_requests"`
+	FatalExceptions         types.List   `tfsdk:"fatal_exceptions"` // > types.String
+	Shards                  types.List   `tfsdk:"shards"`           // > shardTfModel
+}
+
+type shardTfModel struct {
+	ShardID                  types.Int64  `tfsdk:"shard_id"`
+	LeaderGlobalCheckpoint   types.Int64  `tfsdk:"leader_global_checkpoint"`
+	FollowerGlobalCheckpoint types.Int64  `tfsdk:"follower_global_checkpoint"`
+	OperationsLag            types.Int64  `tfsdk:"operations_lag"`
+	OperationsRead           types.Int64  `tfsdk:"operations_read"`
+	OperationsWritten        types.Int64  `tfsdk:"operations_written"`
+	TimeSinceLastReadMillis  types.Int64  `tfsdk:"time_since_last_read_millis"`
+	FailedReadRequests       types.Int64  `tfsdk:"failed_read_requests"`
+	FailedWriteRequests      types.Int64  `tfsdk:"failed_write_requests"`
+	FatalException           types.String `tfsdk:"fatal_exception"`
+}
+
+// follower joins the follower info of an index with its shard stats, which
+// only exist while replication is active.
+type follower struct {
+	info   estypes.FollowerIndex
+	shards []estypes.CcrShardStats
+}
+
+// mergeFollowers attaches stats to the matching follower info entries and
+// sorts the result by index name and shard ID. Stats of indices missing from
+// infos are dropped, so infos decides which followers are reported.
+func mergeFollowers(infos []estypes.FollowerIndex, stats []estypes.FollowIndexStats) []follower {
+	shardsByIndex := make(map[string][]estypes.CcrShardStats, len(stats))
+	for _, s := range stats {
+		shardsByIndex[s.Index] = append(shardsByIndex[s.Index], s.Shards...)
+	}
+
+	followers := make([]follower, 0, len(infos))
+	for _, info := range infos {
+		shards := shardsByIndex[info.FollowerIndex]
+		sort.Slice(shards, func(i, j int) bool { return shards[i].ShardId < shards[j].ShardId })
+		followers = append(followers, follower{info: info, shards: shards})
+	}
+	sort.Slice(followers, func(i, j int) bool { return followers[i].info.FollowerIndex < followers[j].info.FollowerIndex })
+	return followers
+}
+
+func shardLag(shard estypes.CcrShardStats) int64 {
+	return max(shard.LeaderGlobalCheckpoint-shard.FollowerGlobalCheckpoint, 0)
+}
+
+func formatFatalException(cause *estypes.ErrorCause) string {
+	if cause == nil {
+		return ""
+	}
+	if cause.Reason == nil || *cause.Reason == "" {
+		return cause.Type
+	}
+	return fmt.Sprintf("%s: %s", cause.Type, *cause.Reason)
+}
+
+func (model *tfModel) populateFromAPI(ctx context.Context, infos []estypes.FollowerIndex, stats []estypes.FollowIndexStats) (diags diag.Diagnostics) {
+	model.Followers = typeutils.SliceToListType(ctx, mergeFollowers(infos, stats), getFollowerType(ctx), path.Root("followers"), &diags,
+		func(item follower, meta typeutils.ListMeta) followerTfModel {
+			return newFollowerTfModel(ctx, item, meta)
+		})
+	return diags
+}
+
+func newFollowerTfModel(ctx context.Context, item follower, meta typeutils.ListMeta) followerTfModel {
+	result := followerTfModel{
+		Index:                   types.StringValue(item.info.FollowerIndex),
+		RemoteCluster:           types.StringValue(item.info.RemoteCluster),
+		LeaderIndex:             types.StringValue(item.info.LeaderIndex),
+		Status:                  types.StringValue(item.info.Status.String()),
+		OperationsLag:           types.Int64Null(),
+		TimeSinceLastReadMillis: types.Int64Null(),
+		FailedReadRequests:      types.Int64Null(),
+		FailedWriteRequests:     types.Int64Null(),
+	}
+
+	fatalExceptions := make([]string, 0)
+	if len(item.shards) > 0 {
+		var lag, sinceLastRead, failedReads, failedWrites int64
+		for _, shard := range item.shards {
+			lag += shardLag(shard)
+			sinceLastRead = max(sinceLastRead, shard.TimeSinceLastReadMillis)
+			failedReads += shard.FailedReadRequests
+			failedWrites += shard.FailedWriteRequests
+			if shard.FatalException != nil {
+				fatalExceptions = append(fatalExceptions, fmt.Sprintf("shard %d: %s", shard.ShardId, formatFatalException(shard.FatalException)))
+			}
+		}
+		result.OperationsLag = types.Int64Value(lag)
+		result.TimeSinceLastReadMillis = types.Int64Value(sinceLastRead)
+		result.FailedReadRequests = types.Int64Value(failedReads)
+		result.FailedWriteRequests = types.Int64Value(failedWrites)
+	}
+	result.FatalExceptions = typeutils.SliceToListTypeString(ctx, fatalExceptions, meta.Path.AtName("fatal_exceptions"), meta.Diags)
+
+	shards := item.shards
+	if shards == nil {
+		shards = []estypes.CcrShardStats{}
+	}
+	result.Shards = typeutils.SliceToListType(ctx, shards, getShardType(ctx), meta.Path.AtName("shards"), meta.Diags,
+		func(shard estypes.CcrShardStats, _ typeutils.ListMeta) shardTfModel {
+			return shardTfModel{
+				ShardID:                  types.Int64Value(int64(shard.ShardId)),
+				LeaderGlobalCheckpoint:   types.Int64Value(shard.LeaderGlobalCheckpoint),
+				FollowerGlobalCheckpoint: types.Int64Value(shard.FollowerGlobalCheckpoint),
+				OperationsLag:            types.Int64Value(shardLag(shard)),
+				OperationsRead:           types.Int64Value(shard.OperationsRead),
+				OperationsWritten:        types.Int64Value(shard.OperationsWritten),
+				TimeSinceLastReadMillis:  types.Int64Value(shard.TimeSinceLastReadMillis),
+				FailedReadRequests:       types.Int64Value(shard.FailedReadRequests),
+				FailedWriteRequests:      types.Int64Value(shard.FailedWriteRequests),
+				FatalException:           typeutils.NonEmptyStringishValue(formatFatalException(shard.FatalException)),
+			}
+		})
+	return result
+}
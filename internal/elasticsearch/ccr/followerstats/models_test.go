@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package followerstats
+
+import (
+	"context"
+	"testing"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/followerindexstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	infos := []estypes.FollowerIndex{
+		{FollowerIndex: "orders", RemoteCluster: "primary", LeaderIndex: "orders", Status: followerindexstatus.Active},
+		{FollowerIndex: "audit", RemoteCluster: "primary", LeaderIndex: "audit-leader", Status: followerindexstatus.Paused},
+	}
+	stats := []estypes.FollowIndexStats{
+		{
+			Index: "orders",
+			Shards: []estypes.CcrShardStats{
+				{
+					ShardId:                  1,
+					LeaderGlobalCheckpoint:   120,
+					FollowerGlobalCheckpoint: 100,
+					OperationsRead:           100,
+					OperationsWritten:        100,
+					TimeSinceLastReadMillis:  250,
+					FailedReadRequests:       2,
+					FatalException:           &estypes.ErrorCause{Type: "index_not_found_exception", Reason: new("no such index [orders]")},
+				},
+				{
+					ShardId:                  0,
+					LeaderGlobalCheckpoint:   50,
+					FollowerGlobalCheckpoint: 50,
+					TimeSinceLastReadMillis:  900,
+					FailedWriteRequests:      1,
+				},
+			},
+		},
+		// Stats of an index outside the requested followers are ignored.
+		{Index: "other", Shards: []estypes.CcrShardStats{{ShardId: 0}}},
+	}
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, infos, stats)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var followers []followerTfModel
+	require.False(t, model.Followers.ElementsAs(ctx, &followers, false).HasError())
+	require.Len(t, followers, 2)
+
+	paused := followers[0]
+	assert.Equal(t, "audit", paused.Index.ValueString())
+	assert.Equal(t, "audit-leader", paused.LeaderIndex.ValueString())
+	assert.Equal(t, "paused", paused.Status.ValueString())
+	assert.True(t, paused.OperationsLag.IsNull())
+	assert.True(t, paused.TimeSinceLastReadMillis.IsNull())
+	assert.Empty(t, paused.FatalExceptions.Elements())
+	assert.Empty(t, paused.Shards.Elements())
+
+	active := followers[1]
+	assert.Equal(t, "orders", active.Index.ValueString())
+	assert.Equal(t, "primary", active.RemoteCluster.ValueString())
+	assert.Equal(t, "active", active.Status.ValueString())
+	assert.Equal(t, int64(20), active.OperationsLag.ValueInt64())
+	assert.Equal(t, int64(900), active.TimeSinceLastReadMillis.ValueInt64())
+	assert.Equal(t, int64(2), active.FailedReadRequests.ValueInt64())
+	assert.Equal(t, int64(1), active.FailedWriteRequests.ValueInt64())
+
+	var fatalExceptions []string
+	require.False(t, active.FatalExceptions.ElementsAs(ctx, &fatalExceptions, false).HasError())
+	assert.Equal(t, []string{"shard 1: index_not_found_exception: no such index [orders]"}, fatalExceptions)
+
+	var shards []shardTfModel
+	require.False(t, active.Shards.ElementsAs(ctx, &shards, false).HasError())
+	require.Len(t, shards, 2)
+	assert.Equal(t, int64(0), shards[0].ShardID.ValueInt64())
+	assert.Equal(t, int64(0), shards[0].OperationsLag.ValueInt64())
+	assert.True(t, shards[0].FatalException.IsNull())
+	assert.Equal(t, int64(1), shards[1].ShardID.ValueInt64())
+	assert.Equal(t, int64(20), shards[1].OperationsLag.ValueInt64())
+	assert.Equal(t, int64(100), shards[1].OperationsRead.ValueInt64())
+	assert.Equal(t, "index_not_found_exception: no such index [orders]", shards[1].FatalException.ValueString())
+}
+
+func TestPopulateFromAPI_noFollowers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, nil, nil)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.False(t, model.Followers.IsNull())
+	assert.Empty(t, model.Followers.Elements())
+}
@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package followerstats
+
+import (
+	"context"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, esClient *clients.ElasticsearchScopedClient, config tfModel) (tfModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	index := config.Index.ValueString()
+	if index == "" {
+		index = "_all"
+	}
+	followers, infoDiags := elasticsearch.GetFollowerIndices(ctx, esClient, index)
+	diags.Append(infoDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	var stats []estypes.FollowIndexStats
+	if len(followers) > 0 {
+		var statsDiags diag.Diagnostics
+		stats, statsDiags = elasticsearch.GetFollowStats(ctx, esClient)
+		diags.Append(statsDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+	}
+
+	id, idDiags := esClient.ID(ctx, index+"/_ccr/info")
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	config.ID = types.StringValue(id.String())
+
+	diags.Append(config.populateFromAPI(ctx, followers, stats)...)
+	return config, diags
+}
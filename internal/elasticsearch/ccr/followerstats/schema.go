@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package followerstats
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier of the data source.",
+				Computed:    true,
+			},
+			"index": schema.StringAttribute{
+				Description: "Comma-separated list of follower indices to report on. Supports wildcards (`*`). Defaults to every follower index.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"followers": schema.ListNestedAttribute{
+				Description: "The replication state of each matching follower index, sorted by index name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.StringAttribute{
+							Description: "Name of the follower index.",
+							Computed:    true,
+						},
+						"remote_cluster": schema.StringAttribute{
+							Description: "Alias of the remote cluster holding the leader index.",
+							Computed:    true,
+						},
+						"leader_index": schema.StringAttribute{
+							Description: "Name of the leader index.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Replication status: `active` or `paused`.",
+							Computed:    true,
+						},
+						"operations_lag": schema.Int64Attribute{
+							Description: "Number of operations on the leader not yet replicated, summed over all shards. Null for paused followers.",
+							Computed:    true,
+						},
+						"time_since_last_read_millis": schema.Int64Attribute{
+							Description: "Longest time, in milliseconds, since any shard last read from the leader. Null for paused followers.",
+							Computed:    true,
+						},
+						"failed_read_requests": schema.Int64Attribute{
+							Description: "Number of failed reads from the leader, summed over all shards. Null for paused followers.",
+							Computed:    true,
+						},
+						"failed_write_requests": schema.Int64Attribute{
+							Description: "Number of failed bulk writes on the follower, summed over all shards. Null for paused followers.",
+							Computed:    true,
+						},
+						"fatal_exceptions": schema.ListAttribute{
+							Description: "Fatal exceptions that stopped replication, one per affected shard, formatted as `shard <id>: <type>: <reason>`.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"shards": schema.ListNestedAttribute{
+							Description: "Replication stats of each shard, sorted by shard ID.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"shard_id": schema.Int64Attribute{
+										Description: "Numerical shard ID.",
+										Computed:    true,
+									},
+									"leader_global_checkpoint": schema.Int64Attribute{
+										Description: "Global checkpoint of the leader shard.",
+										Computed:    true,
+									},
+									"follower_global_checkpoint": schema.Int64Attribute{
+										Description: "Global checkpoint of the follower shard.",
+										Computed:    true,
+									},
+									"operations_lag": schema.Int64Attribute{
+										Description: "Difference between the leader and follower global checkpoints.",
+										Computed:    true,
+									},
+									"operations_read": schema.Int64Attribute{
+										Description: "Number of operations read from the leader.",
+										Computed:    true,
+									},
+									"operations_written": schema.Int64Attribute{
+										Description: "Number of operations written on the follower.",
+										Computed:    true,
+									},
+									"time_since_last_read_millis": schema.Int64Attribute{
+										Description: "Time, in milliseconds, since the shard last read from the leader.",
+										Computed:    true,
+									},
+									"failed_read_requests": schema.Int64Attribute{
+										Description: "Number of failed reads from the leader.",
+										Computed:    true,
+									},
+									"failed_write_requests": schema.Int64Attribute{
+										Description: "Number of failed bulk writes on the follower.",
+										Computed:    true,
+									},
+									"fatal_exception": schema.StringAttribute{
+										Description: "The fatal exception that stopped replication of the shard, formatted as `<type>: <reason>`.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getFollowerType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["followers"].GetType().(attr.TypeWithElementType).ElementType()
+}
+
+func getShardType(ctx context.Context) attr.Type {
+	followerType := getFollowerType(ctx).(attr.TypeWithAttributeTypes)
+	return followerType.AttributeTypes()["shards"].(attr.TypeWithElementType).ElementType()
+}
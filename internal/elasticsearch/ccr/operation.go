@@ -20,9 +20,10 @@ package ccr
 import "fmt"
 
 // FormatUnknownOperation formats an unrecognised CCR API operation code as a
-// diagnostic string. Both CCR sub-packages (autofollow, followerindex) define
-// their own concrete operation constants and String() methods, but share this
-// helper for the default arm so the logging/tracing pattern stays consistent.
+// diagnostic string. The CCR sub-packages (autofollow, followerindex) and the
+// shared [UnfollowOperation] define their own concrete operation constants and
+// String() methods, but share this helper for the default arm so the
+// logging/tracing pattern stays consistent.
 //
 // Usage inside a sub-package's String() method:
 //
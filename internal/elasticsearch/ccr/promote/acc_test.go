@@ -0,0 +1,107 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promote_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionCCRPromote(t *testing.T) {
+	ccrEnv := acctest.PreCheckCCR(t)
+	leaderIndexName := sdkacctest.RandStringFromCharSet(12, sdkacctest.CharSetAlphaNum)
+	followerIndexName := sdkacctest.RandStringFromCharSet(12, sdkacctest.CharSetAlphaNum)
+	aliasName := sdkacctest.RandStringFromCharSet(12, sdkacctest.CharSetAlphaNum)
+
+	// The promoted index is no longer a follower, so the follower resource
+	// drops out of state and leaves the index behind.
+	t.Cleanup(func() {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			t.Logf("cleanup: %v", err)
+			return
+		}
+		if diags := esclient.DeleteIndex(context.Background(), client, followerIndexName); diags.HasError() {
+			t.Logf("cleanup: delete index %q: %v", followerIndexName, diags)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheckCCR(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("promote"),
+				ConfigVariables: config.Variables{
+					"remote_cluster_alias": config.StringVariable(ccrEnv.RemoteClusterAlias),
+					"remote_proxy_address": config.StringVariable(ccrEnv.RemoteProxyAddress),
+					"leader_index_name":    config.StringVariable(leaderIndexName),
+					"follower_index_name":  config.StringVariable(followerIndexName),
+					"alias_name":           config.StringVariable(aliasName),
+				},
+				ExpectNonEmptyPlan: true,
+				Check:              checkPromoted(followerIndexName, aliasName),
+			},
+		},
+	})
+}
+
+func checkPromoted(indexName, aliasName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.Background()
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+
+		follower, diags := esclient.GetFollowerIndex(ctx, client, indexName)
+		if diags.HasError() {
+			return fmt.Errorf("get follower index %q: %v", indexName, diags)
+		}
+		if follower != nil {
+			return fmt.Errorf("index %q is still a CCR follower after promotion", indexName)
+		}
+
+		holders, diags := esclient.GetAlias(ctx, client, aliasName)
+		if diags.HasError() {
+			return fmt.Errorf("get alias %q: %v", aliasName, diags)
+		}
+		aliases, ok := holders[indexName]
+		if !ok {
+			return fmt.Errorf("alias %q does not point at %q", aliasName, indexName)
+		}
+		alias := aliases.Aliases[aliasName]
+		if alias.IsWriteIndex == nil || !*alias.IsWriteIndex {
+			return fmt.Errorf("index %q is not the write index of alias %q", indexName, aliasName)
+		}
+		return nil
+	}
+}
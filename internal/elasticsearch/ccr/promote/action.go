@@ -0,0 +1,230 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promote
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultPromoteInvokeTimeout = 10 * time.Minute
+
+// Model holds the Terraform configuration for the CCR promote action.
+type Model struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+
+	Index      fwtypes.String `tfsdk:"index"`
+	DataStream fwtypes.String `tfsdk:"data_stream"`
+	Aliases    fwtypes.List   `tfsdk:"aliases"`
+}
+
+// NewAction returns the elasticstack_elasticsearch_ccr_promote action.
+func NewAction() action.Action {
+	return entitycore.NewElasticsearchAction[Model]("ccr_promote", entitycore.ElasticsearchActionOptions[Model]{
+		Schema:               getSchema,
+		Invoke:               invokePromote,
+		DefaultInvokeTimeout: defaultPromoteInvokeTimeout,
+	})
+}
+
+func getSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Promotes a cross-cluster replication follower index, or a replicated data stream, to a regular writable index or data stream, for example during a disaster-recovery failover. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"Each follower index is paused, closed, unfollowed and reopened in that order; a replicated data stream is then promoted with `POST /_data_stream/_promote/{name}`. " +
+			"Indices that are no longer followers skip the sequence and are only reopened if they are still closed, so a partly failed promotion can be retried. " +
+			"See the [unfollow API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-ccr-unfollow).",
+		Attributes: map[string]actionschema.Attribute{
+			"index": actionschema.StringAttribute{
+				MarkdownDescription: "Name of the follower index to promote.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("data_stream")),
+				},
+			},
+			"data_stream": actionschema.StringAttribute{
+				MarkdownDescription: "Name of the replicated data stream to promote. Every backing index that is still a follower is promoted first.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"aliases": actionschema.ListAttribute{
+				MarkdownDescription: "Aliases to point at the promoted `index` once it accepts writes. Each alias is removed from any other index and added to `index` as its write index in one atomic request.",
+				ElementType:         fwtypes.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.ConflictsWith(path.MatchRoot("data_stream")),
+				},
+			},
+		},
+	}
+}
+
+func invokePromote(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[Model]) diag.Diagnostics {
+	report := func(message string) {
+		if req.SendProgress != nil {
+			req.SendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+
+	if dataStream := req.Config.DataStream.ValueString(); typeutils.IsKnown(req.Config.DataStream) && dataStream != "" {
+		return promoteDataStream(ctx, client, dataStream, report)
+	}
+
+	var diags diag.Diagnostics
+	index := req.Config.Index.ValueString()
+	follower, getDiags := esclient.GetFollowerIndex(ctx, client, index)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if follower == nil {
+		reopened, reopenDiags := reopenClosedIndices(ctx, client, []string{index}, report)
+		diags.Append(reopenDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if len(reopened) == 0 {
+			diags.AddWarning(
+				"Index is not a follower",
+				fmt.Sprintf("%s is not a cross-cluster replication follower index; skipping the promotion sequence.", index),
+			)
+		}
+	} else {
+		diags.Append(promoteFollower(ctx, client, *follower, report)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !typeutils.IsKnown(req.Config.Aliases) {
+		return diags
+	}
+	aliases := typeutils.ListTypeToSliceString(ctx, req.Config.Aliases, path.Root("aliases"), &diags)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(repointAliases(ctx, client, index, aliases)...)
+	if diags.HasError() {
+		return diags
+	}
+	report(fmt.Sprintf("Pointed %s at %s", strings.Join(aliases, ", "), index))
+	return diags
+}
+
+func promoteDataStream(ctx context.Context, client *clients.ElasticsearchScopedClient, name string, report func(string)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ds, getDiags := esclient.GetDataStream(ctx, client, name)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if ds == nil {
+		diags.AddError("Data stream not found", fmt.Sprintf("Data stream %q does not exist.", name))
+		return diags
+	}
+
+	backing := make([]string, 0, len(ds.Indices))
+	for _, index := range ds.Indices {
+		backing = append(backing, index.IndexName)
+	}
+	var followers []types.FollowerIndex
+	if len(backing) > 0 {
+		var followersDiags diag.Diagnostics
+		followers, followersDiags = esclient.GetFollowerIndices(ctx, client, strings.Join(backing, ","))
+		diags.Append(followersDiags...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	for _, follower := range followers {
+		diags.Append(promoteFollower(ctx, client, follower, report)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	nonFollowers := slices.DeleteFunc(slices.Clone(backing), func(index string) bool {
+		return slices.ContainsFunc(followers, func(follower types.FollowerIndex) bool {
+			return follower.FollowerIndex == index
+		})
+	})
+	_, reopenDiags := reopenClosedIndices(ctx, client, nonFollowers, report)
+	diags.Append(reopenDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if ds.Replicated == nil || !*ds.Replicated {
+		if len(followers) == 0 {
+			diags.AddWarning(
+				"Data stream is not replicated",
+				fmt.Sprintf("%s is not a replicated data stream and has no follower backing indices; nothing was promoted.", name),
+			)
+		}
+		return diags
+	}
+
+	diags.Append(esclient.PromoteDataStream(ctx, client, name)...)
+	if diags.HasError() {
+		return diags
+	}
+	report(fmt.Sprintf("Promoted data stream %s", name))
+	return diags
+}
+
+func repointAliases(ctx context.Context, client *clients.ElasticsearchScopedClient, index string, aliases []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	holders := make(map[string][]string, len(aliases))
+	for _, alias := range aliases {
+		current, aliasDiags := esclient.GetAlias(ctx, client, alias)
+		diags.Append(aliasDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		for holder := range current {
+			holders[alias] = append(holders[alias], holder)
+		}
+	}
+
+	diags.Append(esclient.UpdateAliasesAtomic(ctx, client, repointAliasActions(index, aliases, holders))...)
+	return diags
+}
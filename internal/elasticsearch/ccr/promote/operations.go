@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promote
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/followerindexstatus"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// progressMessage describes a completed operation on index.
+func progressMessage(op ccr.UnfollowOperation, index string) string {
+	switch op {
+	case ccr.UnfollowOpPause:
+		return "Paused replication into " + index
+	case ccr.UnfollowOpClose:
+		return "Closed " + index
+	case ccr.UnfollowOpUnfollow:
+		return "Unfollowed " + index
+	case ccr.UnfollowOpOpenIndex:
+		return "Reopened " + index + " as a regular index"
+	default:
+		return op.String() + " " + index
+	}
+}
+
+// planPromoteOperations returns the calls that turn follower into a regular
+// index.
+func planPromoteOperations(follower types.FollowerIndex) []ccr.UnfollowOperation {
+	return ccr.PlanUnfollowOperations(follower.Status == followerindexstatus.Active, true)
+}
+
+func promoteFollower(ctx context.Context, client *clients.ElasticsearchScopedClient, follower types.FollowerIndex, report func(string)) diag.Diagnostics {
+	index := follower.FollowerIndex
+	return ccr.ExecuteUnfollowOperations(ctx, client, index, planPromoteOperations(follower), func(op ccr.UnfollowOperation) {
+		report(progressMessage(op, index))
+	})
+}
+
+// reopenClosedIndices opens those of indices that are closed and returns their
+// names. An index that is no longer a follower is still closed when an earlier
+// promotion failed between unfollowing and reopening it.
+func reopenClosedIndices(ctx context.Context, client *clients.ElasticsearchScopedClient, indices []string, report func(string)) ([]string, diag.Diagnostics) {
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	closed, diags := esclient.GetClosedIndices(ctx, client, strings.Join(indices, ","))
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, index := range closed {
+		diags.Append(esclient.OpenIndex(ctx, client, index)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		report(progressMessage(ccr.UnfollowOpOpenIndex, index))
+	}
+	return closed, diags
+}
+
+// repointAliasActions moves each alias to target as its write index. holders
+// maps each alias to the indices currently carrying it.
+func repointAliasActions(target string, aliases []string, holders map[string][]string) []esclient.AliasAction {
+	actions := make([]esclient.AliasAction, 0, len(aliases))
+	for _, alias := range aliases {
+		indices := slices.Clone(holders[alias])
+		slices.Sort(indices)
+		for _, index := range indices {
+			if index == target {
+				continue
+			}
+			actions = append(actions, esclient.AliasAction{Type: "remove", Index: index, Alias: alias})
+		}
+		actions = append(actions, esclient.AliasAction{Type: "add", Index: target, Alias: alias, IsWriteIndex: true})
+	}
+	return actions
+}
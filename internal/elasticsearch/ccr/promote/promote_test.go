@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promote
+
+import (
+	"testing"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/followerindexstatus"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanPromoteOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("active follower is paused first", func(t *testing.T) {
+		t.Parallel()
+		follower := estypes.FollowerIndex{FollowerIndex: "follower", Status: followerindexstatus.Active}
+		assert.Equal(t,
+			[]ccr.UnfollowOperation{ccr.UnfollowOpPause, ccr.UnfollowOpClose, ccr.UnfollowOpUnfollow, ccr.UnfollowOpOpenIndex},
+			planPromoteOperations(follower),
+		)
+	})
+
+	t.Run("paused follower skips pause", func(t *testing.T) {
+		t.Parallel()
+		follower := estypes.FollowerIndex{FollowerIndex: "follower", Status: followerindexstatus.Paused}
+		assert.Equal(t,
+			[]ccr.UnfollowOperation{ccr.UnfollowOpClose, ccr.UnfollowOpUnfollow, ccr.UnfollowOpOpenIndex},
+			planPromoteOperations(follower),
+		)
+	})
+}
+
+func TestRepointAliasActions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("alias not held anywhere", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t,
+			[]esclient.AliasAction{{Type: "add", Index: "dr", Alias: "orders", IsWriteIndex: true}},
+			repointAliasActions("dr", []string{"orders"}, nil),
+		)
+	})
+
+	t.Run("alias moved off other indices", func(t *testing.T) {
+		t.Parallel()
+		holders := map[string][]string{
+			"orders": {"primary-2", "dr", "primary-1"},
+			"audit":  {"audit-old"},
+		}
+		assert.Equal(t,
+			[]esclient.AliasAction{
+				{Type: "remove", Index: "primary-1", Alias: "orders"},
+				{Type: "remove", Index: "primary-2", Alias: "orders"},
+				{Type: "add", Index: "dr", Alias: "orders", IsWriteIndex: true},
+				{Type: "remove", Index: "audit-old", Alias: "audit"},
+				{Type: "add", Index: "dr", Alias: "audit", IsWriteIndex: true},
+			},
+			repointAliasActions("dr", []string{"orders", "audit"}, holders),
+		)
+	})
+}
+
+func TestProgressMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Unfollowed orders", progressMessage(ccr.UnfollowOpUnfollow, "orders"))
+	assert.Equal(t, "Reopened orders as a regular index", progressMessage(ccr.UnfollowOpOpenIndex, "orders"))
+	assert.Equal(t, "apiOperation(42) orders", progressMessage(ccr.UnfollowOperation(42), "orders"))
+}
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ccr
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// UnfollowOperation identifies a step of the sequence that turns a follower
+// index into a regular index.
+type UnfollowOperation int
+
+const (
+	UnfollowOpPause UnfollowOperation = iota
+	UnfollowOpClose
+	UnfollowOpUnfollow
+	UnfollowOpOpenIndex
+)
+
+func (op UnfollowOperation) String() string {
+	switch op {
+	case UnfollowOpPause:
+		return "PauseFollowerIndex"
+	case UnfollowOpClose:
+		return "CloseIndex"
+	case UnfollowOpUnfollow:
+		return "UnfollowIndex"
+	case UnfollowOpOpenIndex:
+		return "OpenIndex"
+	default:
+		return FormatUnknownOperation(int(op))
+	}
+}
+
+// PlanUnfollowOperations returns the calls that unfollow a follower index.
+// Paused followers skip the pause call, which would otherwise fail. Without
+// reopen the index is left closed, for callers that delete it afterwards.
+func PlanUnfollowOperations(active, reopen bool) []UnfollowOperation {
+	ops := make([]UnfollowOperation, 0, 4)
+	if active {
+		ops = append(ops, UnfollowOpPause)
+	}
+	ops = append(ops, UnfollowOpClose, UnfollowOpUnfollow)
+	if reopen {
+		ops = append(ops, UnfollowOpOpenIndex)
+	}
+	return ops
+}
+
+// ExecuteUnfollowOperations runs ops against indexName in order, stopping at
+// the first failure. report, when set, is called after each completed call.
+func ExecuteUnfollowOperations(
+	ctx context.Context,
+	client *clients.ElasticsearchScopedClient,
+	indexName string,
+	ops []UnfollowOperation,
+	report func(UnfollowOperation),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, op := range ops {
+		switch op {
+		case UnfollowOpPause:
+			diags.Append(esclient.PauseFollowerIndex(ctx, client, indexName)...)
+		case UnfollowOpClose:
+			diags.Append(esclient.CloseIndex(ctx, client, indexName)...)
+		case UnfollowOpUnfollow:
+			diags.Append(esclient.UnfollowIndex(ctx, client, indexName)...)
+		case UnfollowOpOpenIndex:
+			diags.Append(esclient.OpenIndex(ctx, client, indexName)...)
+		default:
+			diags.AddError("Internal error", "Unexpected unfollow operation: "+op.String())
+		}
+		if diags.HasError() {
+			return diags
+		}
+		if report != nil {
+			report(op)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ccr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanUnfollowOperations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		active   bool
+		reopen   bool
+		expected []UnfollowOperation
+	}{
+		{
+			name:     "active follower is paused first",
+			active:   true,
+			reopen:   true,
+			expected: []UnfollowOperation{UnfollowOpPause, UnfollowOpClose, UnfollowOpUnfollow, UnfollowOpOpenIndex},
+		},
+		{
+			name:     "paused follower skips pause",
+			reopen:   true,
+			expected: []UnfollowOperation{UnfollowOpClose, UnfollowOpUnfollow, UnfollowOpOpenIndex},
+		},
+		{
+			name:     "index left closed without reopen",
+			active:   true,
+			expected: []UnfollowOperation{UnfollowOpPause, UnfollowOpClose, UnfollowOpUnfollow},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, PlanUnfollowOperations(tt.active, tt.reopen))
+		})
+	}
+}
+
+func TestUnfollowOperationString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "UnfollowIndex", UnfollowOpUnfollow.String())
+	assert.Equal(t, "apiOperation(42)", UnfollowOperation(42).String())
+}
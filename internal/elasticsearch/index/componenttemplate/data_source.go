@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package componenttemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID                 types.String         `tfsdk:"id"`
+	Name               types.String         `tfsdk:"name"`
+	NamePattern        types.String         `tfsdk:"name_pattern"`
+	Metadata           jsontypes.Normalized `tfsdk:"metadata"`
+	Version            types.Int64          `tfsdk:"version"`
+	Template           types.Object         `tfsdk:"template"`
+	ComponentTemplates types.List           `tfsdk:"component_templates"` // > dataSourceComponentTemplateModel
+}
+
+type dataSourceComponentTemplateModel struct {
+	Name     types.String         `tfsdk:"name"`
+	Metadata jsontypes.Normalized `tfsdk:"metadata"`
+	Version  types.Int64          `tfsdk:"version"`
+	Template types.Object         `tfsdk:"template"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return entitycore.NewElasticsearchDataSource[dataSourceModel](
+		entitycore.ComponentElasticsearch,
+		"component_template",
+		getDataSourceSchema,
+		readDataSource,
+	)
+}
+
+func readDataSource(ctx context.Context, esClient *clients.ElasticsearchScopedClient, config dataSourceModel) (dataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		templates []models.ComponentTemplateResponse
+		idSuffix  string
+	)
+	if typeutils.IsKnown(config.Name) {
+		name := config.Name.ValueString()
+		idSuffix = name
+		tpl, getDiags := elasticsearch.GetComponentTemplate(ctx, esClient, name)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+		if tpl == nil {
+			diags.AddAttributeError(path.Root(attrName), "Component template not found", fmt.Sprintf("Component template %q does not exist.", name))
+			return config, diags
+		}
+		templates = []models.ComponentTemplateResponse{*tpl}
+	} else {
+		pattern := config.NamePattern.ValueString()
+		idSuffix = "_component_template/" + pattern
+		found, getDiags := elasticsearch.GetComponentTemplates(ctx, esClient, pattern)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+		templates = found
+	}
+
+	items, itemDiags := componentTemplatesToModels(ctx, templates)
+	diags.Append(itemDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	id, idDiags := esClient.ID(ctx, idSuffix)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	config.ID = types.StringValue(id.String())
+
+	config.Metadata = jsontypes.NewNormalizedNull()
+	config.Version = types.Int64Null()
+	config.Template = types.ObjectNull(templateAttrTypes())
+	if typeutils.IsKnown(config.Name) && len(items) == 1 {
+		config.Metadata = items[0].Metadata
+		config.Version = items[0].Version
+		config.Template = items[0].Template
+	}
+
+	config.ComponentTemplates = typeutils.SliceToListType(ctx, items, getDataSourceComponentTemplateType(ctx), path.Root("component_templates"), &diags,
+		func(item dataSourceComponentTemplateModel, _ typeutils.ListMeta) dataSourceComponentTemplateModel {
+			return item
+		})
+	return config, diags
+}
+
+// componentTemplatesToModels flattens the component templates with the
+// resource read logic, sorted by name.
+func componentTemplatesToModels(ctx context.Context, templates []models.ComponentTemplateResponse) ([]dataSourceComponentTemplateModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sorted := make([]models.ComponentTemplateResponse, len(templates))
+	copy(sorted, templates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	result := make([]dataSourceComponentTemplateModel, 0, len(sorted))
+	for i := range sorted {
+		data, flattenDiags := flattenToData(ctx, &sorted[i], Data{Template: types.ObjectNull(templateAttrTypes())})
+		diags.Append(flattenDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		result = append(result, dataSourceComponentTemplateModel{
+			Name:     data.Name,
+			Metadata: data.Metadata,
+			Version:  data.Version,
+			Template: data.Template,
+		})
+	}
+	return result, diags
+}
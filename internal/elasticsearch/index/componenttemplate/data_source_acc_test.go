@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package componenttemplate_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComponentTemplate(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_name", "name", name+"@settings"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_name", "version", "2"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_component_template.by_name", "template.settings", regexp.MustCompile(`number_of_shards":"2"`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_name", "component_templates.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_pattern", "component_templates.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_pattern", "component_templates.0.name", name+"@mappings"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_component_template.by_pattern", "component_templates.0.metadata", regexp.MustCompile(`"owner":"platform"`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_component_template.by_pattern", "component_templates.0.template.mappings", regexp.MustCompile(`"host":\{"type":"keyword"\}`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_component_template.by_pattern", "component_templates.1.name", name+"@settings"),
+				),
+			},
+		},
+	})
+}
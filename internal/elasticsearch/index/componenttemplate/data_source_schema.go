@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package componenttemplate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func getDataSourceSchema(_ context.Context) dschema.Schema {
+	attrs := map[string]dschema.Attribute{
+		"id": dschema.StringAttribute{
+			MarkdownDescription: "Internal identifier of the data source.",
+			Computed:            true,
+		},
+		attrName: dschema.StringAttribute{
+			MarkdownDescription: "Name of the component template to read. Exactly one of `name` or `name_pattern` must be set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot(attrName), path.MatchRoot("name_pattern")),
+			},
+		},
+		"name_pattern": dschema.StringAttribute{
+			MarkdownDescription: "Comma-separated list of component template names, which may contain `*` wildcards, to list in `component_templates`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"component_templates": dschema.ListNestedAttribute{
+			MarkdownDescription: "The component templates matching `name_pattern`, sorted by name. With `name`, the single component template read.",
+			Computed:            true,
+			NestedObject: dschema.NestedAttributeObject{
+				Attributes: dataSourceComponentTemplateAttributes(map[string]dschema.Attribute{
+					attrName: dschema.StringAttribute{
+						MarkdownDescription: "Name of the component template.",
+						Computed:            true,
+					},
+				}),
+			},
+		},
+	}
+	for name, attribute := range dataSourceComponentTemplateAttributes(nil) {
+		attrs[name] = attribute
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: "Reads component templates. Set `name` to read a single component template; the data source fails when it does not exist. " +
+			"Set `name_pattern` to list the component templates matching a pattern instead. See the " +
+			"[get component template API](https://www.elastic.co/guide/en/elasticsearch/reference/current/getting-component-templates.html) " +
+			"for more details.",
+		Attributes: attrs,
+	}
+}
+
+// dataSourceComponentTemplateAttributes returns the attributes describing a
+// component template. template is typed like the block of the resource so
+// that the resource flatten logic can populate it.
+func dataSourceComponentTemplateAttributes(attrs map[string]dschema.Attribute) map[string]dschema.Attribute {
+	result := map[string]dschema.Attribute{
+		"metadata": dschema.StringAttribute{
+			MarkdownDescription: "User metadata about the component template, as JSON.",
+			Computed:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"version": dschema.Int64Attribute{
+			MarkdownDescription: "Version number used to manage component templates externally.",
+			Computed:            true,
+		},
+		attrTemplate: dschema.ObjectAttribute{
+			MarkdownDescription: "The template applied by the component template: `alias`, `mappings`, `settings` and `data_stream_options`, with the same shape as the `template` block of the `elasticstack_elasticsearch_component_template` resource.",
+			Computed:            true,
+			AttributeTypes:      templateAttrTypes(),
+		},
+	}
+	for name, attribute := range attrs {
+		result[name] = attribute
+	}
+	return result
+}
+
+func getDataSourceComponentTemplateType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["component_templates"].GetType().(attr.TypeWithElementType).ElementType()
+}
@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package componenttemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComponentTemplatesToModels(t *testing.T) {
+	ctx := context.Background()
+
+	items, diags := componentTemplatesToModels(ctx, []models.ComponentTemplateResponse{
+		{
+			Name: "logs@settings",
+			ComponentTemplate: models.ComponentTemplate{
+				Version: new(int64(3)),
+				Template: &models.Template{
+					Settings: map[string]any{"index": map[string]any{"number_of_shards": "2"}},
+				},
+			},
+		},
+		{
+			Name: "logs@mappings",
+			ComponentTemplate: models.ComponentTemplate{
+				Meta: map[string]any{"owner": "platform"},
+			},
+		},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "logs@mappings", items[0].Name.ValueString())
+	assert.JSONEq(t, `{"owner":"platform"}`, items[0].Metadata.ValueString())
+	assert.True(t, items[0].Version.IsNull())
+	assert.True(t, items[0].Template.IsNull())
+
+	assert.Equal(t, "logs@settings", items[1].Name.ValueString())
+	assert.True(t, items[1].Metadata.IsNull())
+	assert.Equal(t, int64(3), items[1].Version.ValueInt64())
+	require.False(t, items[1].Template.IsNull())
+	assert.False(t, items[1].Template.Attributes()[attrSettings].IsNull())
+}
@@ -0,0 +1,47 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_component_template" "settings" {
+  name    = "${var.name}@settings"
+  version = 2
+
+  template {
+    settings = jsonencode({
+      number_of_shards = "2"
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_component_template" "mappings" {
+  name = "${var.name}@mappings"
+
+  metadata = jsonencode({
+    owner = "platform"
+  })
+
+  template {
+    mappings = jsonencode({
+      properties = {
+        host = { type = "keyword" }
+      }
+    })
+  }
+}
+
+data "elasticstack_elasticsearch_component_template" "by_name" {
+  name = elasticstack_elasticsearch_component_template.settings.name
+}
+
+data "elasticstack_elasticsearch_component_template" "by_pattern" {
+  name_pattern = "${var.name}@*"
+
+  depends_on = [
+    elasticstack_elasticsearch_component_template.settings,
+    elasticstack_elasticsearch_component_template.mappings,
+  ]
+}
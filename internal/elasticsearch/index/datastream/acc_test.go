@@ -22,11 +22,13 @@ import (
 	_ "embed"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -350,6 +352,144 @@ func TestAccResourceDataStreamFromSDK(t *testing.T) {
 	})
 }
 
+// TestAccResourceDataStreamRolloverOnTemplateChange verifies that a change to
+// the matching index template rolls the data stream over on the next apply.
+// The template is managed out-of-band so that each step converges in one apply.
+func TestAccResourceDataStreamRolloverOnTemplateChange(t *testing.T) {
+	dsName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceDataStreamAndTemplateDestroy(dsName),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("immediate"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(dsName)},
+				PreConfig:                func() { putDataStreamTemplate(t, dsName, "field_v1") },
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "rollover_on_template_change", "immediate"),
+					resource.TestCheckResourceAttrSet("elasticstack_elasticsearch_data_stream.test_ds", "template_fingerprint"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "generation", "1"),
+				),
+			},
+			{
+				// An unchanged template does not roll over.
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("immediate"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(dsName)},
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("immediate"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(dsName)},
+				PreConfig:                func() { putDataStreamTemplate(t, dsName, "field_v2") },
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "generation", "2"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "indices.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccResourceDataStreamRolloverOnTemplateChangeLazy verifies that lazy mode
+// marks the data stream for rollover on the next write instead of rolling over
+// during apply.
+func TestAccResourceDataStreamRolloverOnTemplateChangeLazy(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, esclient.MinVersionLazyRollover, versionutils.FlavorAny)
+
+	dsName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceDataStreamAndTemplateDestroy(dsName),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("lazy"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(dsName)},
+				PreConfig:                func() { putDataStreamTemplate(t, dsName, "field_v1") },
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "rollover_on_template_change", "lazy"),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "generation", "1"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("lazy"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(dsName)},
+				PreConfig:                func() { putDataStreamTemplate(t, dsName, "field_v2") },
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_data_stream.test_ds", "generation", "1"),
+					checkDataStreamRolloverOnWrite(dsName),
+				),
+			},
+		},
+	})
+}
+
+func putDataStreamTemplate(t *testing.T, name, field string) {
+	t.Helper()
+
+	client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+	require.NoError(t, err)
+
+	body := fmt.Sprintf(`{
+  "index_patterns": ["%s*"],
+  "data_stream": {},
+  "template": {
+    "mappings": {
+      "properties": {
+        "%s": { "type": "keyword" }
+      }
+    }
+  }
+}`, name, field)
+	_, err = client.GetESClient().Indices.PutIndexTemplate(name).Raw(strings.NewReader(body)).Do(context.Background())
+	require.NoError(t, err)
+}
+
+func checkDataStreamRolloverOnWrite(name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+
+		ds, diags := esclient.GetDataStream(context.Background(), client, name)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get data stream %q: %v", name, diags)
+		}
+		if ds == nil {
+			return fmt.Errorf("data stream %q not found", name)
+		}
+		if !ds.RolloverOnWrite {
+			return fmt.Errorf("expected data stream %q to be marked for rollover on write", name)
+		}
+		return nil
+	}
+}
+
+func checkResourceDataStreamAndTemplateDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if err := checkResourceDataStreamDestroy(s); err != nil {
+			return err
+		}
+
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		_, err = client.GetESClient().Indices.DeleteIndexTemplate(name).Do(context.Background())
+		if err != nil && !esclient.IsNotFoundElasticsearchError(err) {
+			return err
+		}
+		return nil
+	}
+}
+
 func checkResourceDataStreamDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
 	if err != nil {
@@ -18,10 +18,20 @@
 package datastream
 
 import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
 	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	rolloverModeImmediate = "immediate"
+	rolloverModeLazy      = "lazy"
+)
+
 // Data is the Plugin Framework model for the elasticstack_elasticsearch_data_stream resource.
 type Data struct {
 	entitycore.ResourceTimeoutsField
@@ -38,6 +48,9 @@ type Data struct {
 	Hidden         types.Bool   `tfsdk:"hidden"`
 	System         types.Bool   `tfsdk:"system"`
 	Replicated     types.Bool   `tfsdk:"replicated"`
+
+	RolloverOnTemplateChange types.String `tfsdk:"rollover_on_template_change"`
+	TemplateFingerprint      types.String `tfsdk:"template_fingerprint"`
 }
 
 // indexModel represents a backing index entry in the indices list.
@@ -48,3 +61,20 @@ type indexModel struct {
 
 func (d Data) GetID() types.String         { return d.ID }
 func (d Data) GetResourceID() types.String { return d.Name }
+
+var _ entitycore.WithVersionRequirements = Data{}
+
+// GetVersionRequirements satisfies [entitycore.WithVersionRequirements]. Lazy
+// rollover is only available from Elasticsearch 8.13.0.
+func (d Data) GetVersionRequirements(_ context.Context) ([]entitycore.VersionRequirement, diag.Diagnostics) {
+	if d.RolloverOnTemplateChange.ValueString() != rolloverModeLazy {
+		return nil, nil
+	}
+	return []entitycore.VersionRequirement{
+		entitycore.NewAttributeVersionRequirement(
+			path.Root("rollover_on_template_change"),
+			*elasticsearch.MinVersionLazyRollover,
+			"Lazy rollover requires Elasticsearch v8.13.0 or above.",
+		),
+	}, nil
+}
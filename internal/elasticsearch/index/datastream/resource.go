@@ -30,6 +30,7 @@ var (
 	_ resource.Resource                = newDataStreamResource()
 	_ resource.ResourceWithConfigure   = newDataStreamResource()
 	_ resource.ResourceWithImportState = newDataStreamResource()
+	_ resource.ResourceWithModifyPlan  = newDataStreamResource()
 )
 
 type dataStreamResource struct {
@@ -42,8 +43,8 @@ func newDataStreamResource() *dataStreamResource {
 			Schema: GetSchema,
 			Read:   readDataStream,
 			Delete: deleteDataStream,
-			Create: writeDataStream,
-			Update: writeDataStream,
+			Create: createDataStream,
+			Update: updateDataStream,
 		}),
 	}
 }
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func rolloverOnTemplateChangeEnabled(data Data) bool {
+	return typeutils.IsKnown(data.RolloverOnTemplateChange) && data.RolloverOnTemplateChange.ValueString() != ""
+}
+
+// templateChanged reports whether a rollover is due. A null prior fingerprint
+// means the template was never tracked (for example after import or when the
+// option was just enabled), so the current template is recorded without
+// rolling over.
+func templateChanged(prior, current types.String) bool {
+	if !typeutils.IsKnown(prior) || !typeutils.IsKnown(current) {
+		return false
+	}
+	return prior.ValueString() != current.ValueString()
+}
+
+// templateFingerprint hashes the mappings, settings and aliases that a new
+// backing index of the data stream would receive from its matching index
+// template and component templates.
+func templateFingerprint(ctx context.Context, client *clients.ElasticsearchScopedClient, name string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	simulated, simDiags := elasticsearch.SimulateIndexTemplateForIndex(ctx, client, name)
+	diags.Append(simDiags...)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	return fingerprintSimulatedTemplate(simulated.Template)
+}
+
+// fingerprintSimulatedTemplate relies on encoding/json sorting map keys, so
+// the hash only changes when the resolved template does.
+func fingerprintSimulatedTemplate(template models.SimulatedTemplate) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	templateBytes, err := json.Marshal(template)
+	if err != nil {
+		diags.AddError("Failed to marshal simulated index template", err.Error())
+		return types.StringNull(), diags
+	}
+
+	hash, err := typeutils.StringToHash(string(templateBytes))
+	if err != nil {
+		diags.AddError("Failed to hash simulated index template", err.Error())
+		return types.StringNull(), diags
+	}
+	return types.StringPointerValue(hash), diags
+}
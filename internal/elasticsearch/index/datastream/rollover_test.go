@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastream
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		prior   types.String
+		current types.String
+		want    bool
+	}{
+		{name: "same fingerprint", prior: types.StringValue("a"), current: types.StringValue("a"), want: false},
+		{name: "different fingerprint", prior: types.StringValue("a"), current: types.StringValue("b"), want: true},
+		{name: "untracked prior", prior: types.StringNull(), current: types.StringValue("b"), want: false},
+		{name: "unknown current", prior: types.StringValue("a"), current: types.StringUnknown(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, templateChanged(tt.prior, tt.current))
+		})
+	}
+}
+
+func TestFingerprintSimulatedTemplate(t *testing.T) {
+	template := func(field string) models.SimulatedTemplate {
+		return models.SimulatedTemplate{
+			Mappings: map[string]any{
+				"properties": map[string]any{
+					field:        map[string]any{"type": "keyword"},
+					"@timestamp": map[string]any{"type": "date"},
+				},
+			},
+			Settings: map[string]any{
+				"index": map[string]any{"number_of_shards": "1", "lifecycle": map[string]any{"name": "logs"}},
+			},
+		}
+	}
+
+	first, diags := fingerprintSimulatedTemplate(template("message"))
+	require.False(t, diags.HasError())
+	again, diags := fingerprintSimulatedTemplate(template("message"))
+	require.False(t, diags.HasError())
+	changed, diags := fingerprintSimulatedTemplate(template("host"))
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, first, again)
+	assert.NotEqual(t, first, changed)
+}
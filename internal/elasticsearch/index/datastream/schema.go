@@ -105,6 +105,23 @@ func GetSchema(_ context.Context) schema.Schema {
 				MarkdownDescription: "If `true`, the data stream is created and managed by cross-cluster replication and the local cluster can not write into this data stream or change its mappings.",
 				Computed:            true,
 			},
+			"rollover_on_template_change": schema.StringAttribute{
+				MarkdownDescription: "Rolls the data stream over when the index template matching it changes, so new backing indices pick up updated mappings, settings and pipelines. " +
+					"`immediate` rolls over during apply; `lazy` (Elasticsearch 8.13.0+) rolls over on the next write. " +
+					"Changes are detected at plan time, so a template updated in the same apply triggers the rollover on the following apply. " +
+					"Use the `elasticstack_elasticsearch_rollover` action to roll over as part of the template change instead.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rolloverModeImmediate, rolloverModeLazy),
+				},
+			},
+			"template_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Hash of the index template that currently applies to the data stream. Only tracked when `rollover_on_template_change` is set.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
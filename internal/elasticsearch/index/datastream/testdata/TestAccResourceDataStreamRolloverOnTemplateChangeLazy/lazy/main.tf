@@ -0,0 +1,13 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_data_stream" "test_ds" {
+  name = var.name
+
+  rollover_on_template_change = "lazy"
+}
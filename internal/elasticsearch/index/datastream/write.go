@@ -27,11 +27,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// writeDataStream PUTs the data stream and sets the composite ID on the model.
-// It serves both Create and Update; the API has no separate update path beyond
-// re-creation against the index template, so the envelope calls readDataStream
-// after this returns to populate computed fields.
-func writeDataStream(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.WriteRequest[Data]) (entitycore.WriteResult[Data], diag.Diagnostics) {
+// createDataStream PUTs the data stream and sets the composite ID on the model.
+// The envelope calls readDataStream after this returns to populate computed
+// fields.
+func createDataStream(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.WriteRequest[Data]) (entitycore.WriteResult[Data], diag.Diagnostics) {
 	var diags diag.Diagnostics
 	data := req.Plan
 	resourceID := req.WriteID
@@ -47,6 +46,56 @@ func writeDataStream(ctx context.Context, client *clients.ElasticsearchScopedCli
 		return entitycore.WriteResult[Data]{}, diags
 	}
 
+	data.TemplateFingerprint = types.StringNull()
+	if rolloverOnTemplateChangeEnabled(data) {
+		fingerprint, fpDiags := templateFingerprint(ctx, client, resourceID)
+		diags.Append(fpDiags...)
+		if diags.HasError() {
+			return entitycore.WriteResult[Data]{}, diags
+		}
+		data.TemplateFingerprint = fingerprint
+	}
+
+	data.ID = types.StringValue(id.String())
+	return entitycore.WriteResult[Data]{Model: data}, diags
+}
+
+// updateDataStream handles in-place changes. The data stream itself has no
+// mutable properties; the only work is rolling it over when the fingerprint of
+// its index template, computed now rather than at plan time, differs from the
+// one in state.
+func updateDataStream(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.WriteRequest[Data]) (entitycore.WriteResult[Data], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := req.Plan
+	resourceID := req.WriteID
+
+	id, idDiags := client.ID(ctx, resourceID)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return entitycore.WriteResult[Data]{}, diags
+	}
+
+	if !rolloverOnTemplateChangeEnabled(data) {
+		data.TemplateFingerprint = types.StringNull()
+	} else {
+		fingerprint, fpDiags := templateFingerprint(ctx, client, resourceID)
+		diags.Append(fpDiags...)
+		if diags.HasError() {
+			return entitycore.WriteResult[Data]{}, diags
+		}
+		data.TemplateFingerprint = fingerprint
+
+		if req.Prior != nil && templateChanged(req.Prior.TemplateFingerprint, data.TemplateFingerprint) {
+			_, rolloverDiags := elasticsearch.Rollover(ctx, client, resourceID, &elasticsearch.RolloverRequest{
+				Lazy: data.RolloverOnTemplateChange.ValueString() == rolloverModeLazy,
+			})
+			diags.Append(rolloverDiags...)
+			if diags.HasError() {
+				return entitycore.WriteResult[Data]{}, diags
+			}
+		}
+	}
+
 	data.ID = types.StringValue(id.String())
 	return entitycore.WriteResult[Data]{Model: data}, diags
 }
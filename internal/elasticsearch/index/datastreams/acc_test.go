@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastreams_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/datastreamlifecycle"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataStreamsDataSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	versionutils.SkipIfUnsupported(t, datastreamlifecycle.MinVersion, versionutils.FlavorAny)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					// Data streams are sorted by name.
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.name", name+"-one"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.template", name),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.timestamp_field", "@timestamp"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.generation", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.lifecycle.enabled", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.lifecycle.data_retention", "30d"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.indices.#", "1"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.indices.0.index_name"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.store_size_bytes"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_data_streams.all", "data_streams.0.maximum_timestamp"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.all", "data_streams.1.name", name+"-two"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.one", "data_streams.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.one", "data_streams.0.name", name+"-one"),
+					resource.TestCheckNoResourceAttr("data.elasticstack_elasticsearch_data_streams.one", "data_streams.0.store_size_bytes"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_data_streams.missing", "data_streams.#", "0"),
+				),
+			},
+		},
+	})
+}
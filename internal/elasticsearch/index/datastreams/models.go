@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastreams
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IncludeStats types.Bool   `tfsdk:"include_stats"`
+	DataStreams  types.List   `tfsdk:"data_streams"` // > dataStreamTfModel
+}
+
+type dataStreamTfModel struct {
+	Name             types.String         `tfsdk:"name"`
+	TimestampField   types.String         `tfsdk:"timestamp_field"`
+	Generation       types.Int64          `tfsdk:"generation"`
+	Status           types.String         `tfsdk:"status"`
+	Template         types.String         `tfsdk:"template"`
+	Metadata         jsontypes.Normalized `tfsdk:"metadata"`
+	ILMPolicy        types.String         `tfsdk:"ilm_policy"`
+	PreferILM        types.Bool           `tfsdk:"prefer_ilm"`
+	ManagedBy        types.String         `tfsdk:"managed_by"`
+	Lifecycle        types.Object         `tfsdk:"lifecycle"` // > lifecycleTfModel
+	Hidden           types.Bool           `tfsdk:"hidden"`
+	System           types.Bool           `tfsdk:"system"`
+	Replicated       types.Bool           `tfsdk:"replicated"`
+	Indices          types.List           `tfsdk:"indices"` // > indexTfModel
+	StoreSizeBytes   types.Int64          `tfsdk:"store_size_bytes"`
+	MaximumTimestamp types.Int64          `tfsdk:"maximum_timestamp"`
+}
+
+type lifecycleTfModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	DataRetention types.String `tfsdk:"data_retention"`
+}
+
+type indexTfModel struct {
+	IndexName types.String `tfsdk:"index_name"`
+	IndexUUID types.String `tfsdk:"index_uuid"`
+	ILMPolicy types.String `tfsdk:"ilm_policy"`
+	ManagedBy types.String `tfsdk:"managed_by"`
+}
+
+func (model *tfModel) populateFromAPI(ctx context.Context, dataStreams []estypes.DataStream, stats []estypes.DataStreamsStatsItem) (diags diag.Diagnostics) {
+	statsByName := make(map[string]estypes.DataStreamsStatsItem, len(stats))
+	for _, item := range stats {
+		statsByName[item.DataStream] = item
+	}
+
+	sorted := make([]estypes.DataStream, len(dataStreams))
+	copy(sorted, dataStreams)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	model.DataStreams = typeutils.SliceToListType(ctx, sorted, getDataStreamType(ctx), path.Root("data_streams"), &diags,
+		func(item estypes.DataStream, meta typeutils.ListMeta) dataStreamTfModel {
+			result := dataStreamTfModel{
+				Name:             types.StringValue(item.Name),
+				TimestampField:   types.StringValue(item.TimestampField.Name),
+				Generation:       types.Int64Value(int64(item.Generation)),
+				Status:           types.StringValue(item.Status.String()),
+				Template:         types.StringValue(item.Template),
+				Metadata:         metadataValue(item.Meta_, meta.Path.AtName("metadata"), meta.Diags),
+				ILMPolicy:        typeutils.NonEmptyStringishPointerValue(item.IlmPolicy),
+				PreferILM:        types.BoolValue(item.PreferIlm),
+				ManagedBy:        typeutils.NonEmptyStringishValue(item.NextGenerationManagedBy.String()),
+				Lifecycle:        lifecycleValue(ctx, item.Lifecycle, meta.Path.AtName("lifecycle"), meta.Diags),
+				Hidden:           types.BoolValue(item.Hidden),
+				System:           types.BoolValue(item.System != nil && *item.System),
+				Replicated:       types.BoolValue(item.Replicated != nil && *item.Replicated),
+				StoreSizeBytes:   types.Int64Null(),
+				MaximumTimestamp: types.Int64Null(),
+			}
+
+			indices := item.Indices
+			if indices == nil {
+				indices = []estypes.DataStreamIndex{}
+			}
+			result.Indices = typeutils.SliceToListType(ctx, indices, getIndexType(ctx), meta.Path.AtName("indices"), meta.Diags,
+				func(index estypes.DataStreamIndex, _ typeutils.ListMeta) indexTfModel {
+					managedBy := ""
+					if index.ManagedBy != nil {
+						managedBy = index.ManagedBy.String()
+					}
+					return indexTfModel{
+						IndexName: types.StringValue(index.IndexName),
+						IndexUUID: types.StringValue(index.IndexUuid),
+						ILMPolicy: typeutils.NonEmptyStringishPointerValue(index.IlmPolicy),
+						ManagedBy: typeutils.NonEmptyStringishValue(managedBy),
+					}
+				})
+
+			if s, ok := statsByName[item.Name]; ok {
+				result.StoreSizeBytes = types.Int64Value(s.StoreSizeBytes)
+				result.MaximumTimestamp = types.Int64Value(s.MaximumTimestamp)
+			}
+			return result
+		})
+	return diags
+}
+
+func getIndexType(ctx context.Context) attr.Type {
+	return getDataStreamAttributeTypes(ctx)["indices"].(attr.TypeWithElementType).ElementType()
+}
+
+func getLifecycleAttributeTypes(ctx context.Context) map[string]attr.Type {
+	return getDataStreamAttributeTypes(ctx)["lifecycle"].(attr.TypeWithAttributeTypes).AttributeTypes()
+}
+
+func lifecycleValue(ctx context.Context, lifecycle *estypes.DataStreamLifecycleWithRollover, p path.Path, diags *diag.Diagnostics) types.Object {
+	return typeutils.StructToObjectType(ctx, lifecycle, getLifecycleAttributeTypes(ctx), p, diags,
+		func(item estypes.DataStreamLifecycleWithRollover, _ typeutils.ObjectMeta) lifecycleTfModel {
+			retention, _ := item.DataRetention.(string)
+			return lifecycleTfModel{
+				// The lifecycle is enabled unless explicitly disabled.
+				Enabled:       types.BoolValue(item.Enabled == nil || *item.Enabled),
+				DataRetention: typeutils.NonEmptyStringishValue(retention),
+			}
+		})
+}
+
+func metadataValue(metadata estypes.Metadata, p path.Path, diags *diag.Diagnostics) jsontypes.Normalized {
+	if len(metadata) == 0 {
+		return jsontypes.NewNormalizedNull()
+	}
+	bytes, err := json.Marshal(metadata)
+	if err != nil {
+		diags.AddAttributeError(p, "Failed to encode the data stream metadata", err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(string(bytes))
+}
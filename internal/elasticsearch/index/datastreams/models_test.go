@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastreams
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/healthstatus"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/managedby"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	dataStreams := []estypes.DataStream{
+		{
+			Name:                    "metrics-system.cpu-default",
+			TimestampField:          estypes.DataStreamTimestampField{Name: "@timestamp"},
+			Generation:              1,
+			Status:                  healthstatus.Yellow,
+			Template:                "metrics-system.cpu",
+			NextGenerationManagedBy: managedby.Datastream,
+			Lifecycle:               &estypes.DataStreamLifecycleWithRollover{},
+			Indices: []estypes.DataStreamIndex{
+				{IndexName: ".ds-metrics-system.cpu-default-000001", IndexUuid: "uuid-2", ManagedBy: &managedby.Datastream},
+			},
+		},
+		{
+			Name:                    "logs-nginx.access-default",
+			TimestampField:          estypes.DataStreamTimestampField{Name: "@timestamp"},
+			Generation:              3,
+			Status:                  healthstatus.Green,
+			Template:                "logs-nginx.access",
+			Meta_:                   estypes.Metadata{"managed_by": json.RawMessage(`"fleet"`)},
+			IlmPolicy:               new("logs"),
+			PreferIlm:               true,
+			NextGenerationManagedBy: managedby.Ilm,
+			Lifecycle:               &estypes.DataStreamLifecycleWithRollover{DataRetention: "30d", Enabled: new(false)},
+			Replicated:              new(true),
+			Indices: []estypes.DataStreamIndex{
+				{IndexName: ".ds-logs-nginx.access-default-000002", IndexUuid: "uuid-1", IlmPolicy: new("logs"), ManagedBy: &managedby.Ilm},
+				{IndexName: ".ds-logs-nginx.access-default-000003", IndexUuid: "uuid-3", IlmPolicy: new("logs"), ManagedBy: &managedby.Ilm},
+			},
+		},
+		{
+			Name:           "unmanaged",
+			TimestampField: estypes.DataStreamTimestampField{Name: "@timestamp"},
+			Status:         healthstatus.Green,
+		},
+	}
+	stats := []estypes.DataStreamsStatsItem{
+		{DataStream: "logs-nginx.access-default", StoreSizeBytes: 2048, MaximumTimestamp: 1760000000000},
+	}
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, dataStreams, stats)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var result []dataStreamTfModel
+	require.False(t, model.DataStreams.ElementsAs(ctx, &result, false).HasError())
+	require.Len(t, result, 3)
+
+	logs := result[0]
+	assert.Equal(t, "logs-nginx.access-default", logs.Name.ValueString())
+	assert.Equal(t, "@timestamp", logs.TimestampField.ValueString())
+	assert.Equal(t, int64(3), logs.Generation.ValueInt64())
+	assert.Equal(t, "green", logs.Status.ValueString())
+	assert.Equal(t, "logs-nginx.access", logs.Template.ValueString())
+	assert.JSONEq(t, `{"managed_by":"fleet"}`, logs.Metadata.ValueString())
+	assert.Equal(t, "logs", logs.ILMPolicy.ValueString())
+	assert.True(t, logs.PreferILM.ValueBool())
+	assert.Equal(t, managedby.Ilm.String(), logs.ManagedBy.ValueString())
+	assert.True(t, logs.Replicated.ValueBool())
+	assert.False(t, logs.System.ValueBool())
+	assert.Equal(t, int64(2048), logs.StoreSizeBytes.ValueInt64())
+	assert.Equal(t, int64(1760000000000), logs.MaximumTimestamp.ValueInt64())
+
+	var lifecycle lifecycleTfModel
+	require.False(t, logs.Lifecycle.As(ctx, &lifecycle, basetypes.ObjectAsOptions{}).HasError())
+	assert.False(t, lifecycle.Enabled.ValueBool())
+	assert.Equal(t, "30d", lifecycle.DataRetention.ValueString())
+
+	var indices []indexTfModel
+	require.False(t, logs.Indices.ElementsAs(ctx, &indices, false).HasError())
+	require.Len(t, indices, 2)
+	assert.Equal(t, ".ds-logs-nginx.access-default-000002", indices[0].IndexName.ValueString())
+	assert.Equal(t, "uuid-1", indices[0].IndexUUID.ValueString())
+	assert.Equal(t, "logs", indices[0].ILMPolicy.ValueString())
+	assert.Equal(t, managedby.Ilm.String(), indices[0].ManagedBy.ValueString())
+
+	metrics := result[1]
+	assert.Equal(t, "metrics-system.cpu-default", metrics.Name.ValueString())
+	assert.True(t, metrics.ILMPolicy.IsNull())
+	assert.True(t, metrics.Metadata.IsNull())
+	assert.True(t, metrics.StoreSizeBytes.IsNull())
+	assert.True(t, metrics.MaximumTimestamp.IsNull())
+	require.False(t, metrics.Lifecycle.As(ctx, &lifecycle, basetypes.ObjectAsOptions{}).HasError())
+	assert.True(t, lifecycle.Enabled.ValueBool())
+	assert.True(t, lifecycle.DataRetention.IsNull())
+
+	unmanaged := result[2]
+	assert.True(t, unmanaged.Lifecycle.IsNull())
+	assert.True(t, unmanaged.ManagedBy.IsNull())
+	assert.Empty(t, unmanaged.Indices.Elements())
+}
+
+func TestPopulateFromAPI_noDataStreams(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, []estypes.DataStream{}, nil)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.False(t, model.DataStreams.IsNull())
+	assert.Empty(t, model.DataStreams.Elements())
+}
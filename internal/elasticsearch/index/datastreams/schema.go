@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package datastreams
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier of the data source.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Comma-separated list of data stream names. Supports wildcards (`*`). Defaults to every data stream.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"include_stats": schema.BoolAttribute{
+				Description: "Whether to read `store_size_bytes` and `maximum_timestamp` from the data stream stats API.",
+				Optional:    true,
+			},
+			"data_streams": schema.ListNestedAttribute{
+				Description: "The matching data streams, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the data stream.",
+							Computed:    true,
+						},
+						"timestamp_field": schema.StringAttribute{
+							Description: "Name of the timestamp field of the data stream, usually `@timestamp`.",
+							Computed:    true,
+						},
+						"generation": schema.Int64Attribute{
+							Description: "Current generation of the data stream, incremented by every rollover.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Health status of the data stream: `green`, `yellow` or `red`.",
+							Computed:    true,
+						},
+						"template": schema.StringAttribute{
+							Description: "Name of the index template used to create the backing indices.",
+							Computed:    true,
+						},
+						"metadata": schema.StringAttribute{
+							Description: "Custom metadata of the data stream, copied from the `_meta` of its index template, as JSON. Fleet integrations record their package here.",
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+						},
+						"ilm_policy": schema.StringAttribute{
+							Description: "Name of the index lifecycle management (ILM) policy in the index template.",
+							Computed:    true,
+						},
+						"prefer_ilm": schema.BoolAttribute{
+							Description: "Whether ILM takes precedence over the data stream lifecycle when both are configured.",
+							Computed:    true,
+						},
+						"managed_by": schema.StringAttribute{
+							Description: "What manages the next backing index: `Index Lifecycle Management`, `Data stream lifecycle` or `Unmanaged`.",
+							Computed:    true,
+						},
+						"lifecycle": schema.SingleNestedAttribute{
+							Description: "The data stream lifecycle (DSL) configuration. Null when the data stream has none.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Description: "Whether the data stream lifecycle is enabled.",
+									Computed:    true,
+								},
+								"data_retention": schema.StringAttribute{
+									Description: "Minimum time to keep data, such as `30d`. Null when data is kept forever.",
+									Computed:    true,
+								},
+							},
+						},
+						"hidden": schema.BoolAttribute{
+							Description: "Whether the data stream is hidden.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether the data stream is managed by an Elastic stack component.",
+							Computed:    true,
+						},
+						"replicated": schema.BoolAttribute{
+							Description: "Whether the data stream is replicated by cross-cluster replication and cannot be written to.",
+							Computed:    true,
+						},
+						"indices": schema.ListNestedAttribute{
+							Description: "Backing indices of the data stream, oldest first. The last one is the write index.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"index_name": schema.StringAttribute{
+										Description: "Name of the backing index.",
+										Computed:    true,
+									},
+									"index_uuid": schema.StringAttribute{
+										Description: "Universally unique identifier (UUID) of the backing index.",
+										Computed:    true,
+									},
+									"ilm_policy": schema.StringAttribute{
+										Description: "Name of the ILM policy of the backing index.",
+										Computed:    true,
+									},
+									"managed_by": schema.StringAttribute{
+										Description: "What manages the backing index: `Index Lifecycle Management`, `Data stream lifecycle` or `Unmanaged`.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"store_size_bytes": schema.Int64Attribute{
+							Description: "Total size, in bytes, of all shards of the backing indices. Only set when `include_stats` is `true`.",
+							Computed:    true,
+						},
+						"maximum_timestamp": schema.Int64Attribute{
+							Description: "Most recent `@timestamp` in the data stream, in milliseconds since the Unix epoch. Only set when `include_stats` is `true`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDataStreamType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["data_streams"].GetType().(attr.TypeWithElementType).ElementType()
+}
+
+func getDataStreamAttributeTypes(ctx context.Context) map[string]attr.Type {
+	return getDataStreamType(ctx).(attr.TypeWithAttributeTypes).AttributeTypes()
+}
@@ -0,0 +1,52 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name           = var.name
+  index_patterns = ["${var.name}-*"]
+
+  data_stream {}
+
+  template {
+    lifecycle {
+      data_retention = "30d"
+    }
+  }
+}
+
+resource "elasticstack_elasticsearch_data_stream" "one" {
+  name = "${var.name}-one"
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+resource "elasticstack_elasticsearch_data_stream" "two" {
+  name = "${var.name}-two"
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+data "elasticstack_elasticsearch_data_streams" "all" {
+  name          = "${var.name}-*"
+  include_stats = true
+
+  depends_on = [
+    elasticstack_elasticsearch_data_stream.one,
+    elasticstack_elasticsearch_data_stream.two,
+  ]
+}
+
+data "elasticstack_elasticsearch_data_streams" "one" {
+  name = elasticstack_elasticsearch_data_stream.one.name
+}
+
+data "elasticstack_elasticsearch_data_streams" "missing" {
+  name = "${var.name}-missing-*"
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
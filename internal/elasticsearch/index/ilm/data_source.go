@@ -0,0 +1,202 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID           types.String         `tfsdk:"id"`
+	Name         types.String         `tfsdk:"name"`
+	NamePattern  types.String         `tfsdk:"name_pattern"`
+	Metadata     jsontypes.Normalized `tfsdk:"metadata"`
+	ModifiedDate types.String         `tfsdk:"modified_date"`
+	Hot          types.Object         `tfsdk:"hot"`
+	Warm         types.Object         `tfsdk:"warm"`
+	Cold         types.Object         `tfsdk:"cold"`
+	Frozen       types.Object         `tfsdk:"frozen"`
+	Delete       types.Object         `tfsdk:"delete"`
+	Policies     types.List           `tfsdk:"policies"` // > dataSourcePolicyModel
+}
+
+type dataSourcePolicyModel struct {
+	Name         types.String         `tfsdk:"name"`
+	Metadata     jsontypes.Normalized `tfsdk:"metadata"`
+	ModifiedDate types.String         `tfsdk:"modified_date"`
+	Hot          types.Object         `tfsdk:"hot"`
+	Warm         types.Object         `tfsdk:"warm"`
+	Cold         types.Object         `tfsdk:"cold"`
+	Frozen       types.Object         `tfsdk:"frozen"`
+	Delete       types.Object         `tfsdk:"delete"`
+}
+
+func dataSourcePolicyFromModel(m tfModel) dataSourcePolicyModel {
+	return dataSourcePolicyModel{
+		Name:         m.Name,
+		Metadata:     m.Metadata,
+		ModifiedDate: m.ModifiedDate,
+		Hot:          m.Hot,
+		Warm:         m.Warm,
+		Cold:         m.Cold,
+		Frozen:       m.Frozen,
+		Delete:       m.Delete,
+	}
+}
+
+func NewDataSource() datasource.DataSource {
+	return entitycore.NewElasticsearchDataSource[dataSourceModel](
+		entitycore.ComponentElasticsearch,
+		"index_lifecycle",
+		getDataSourceSchema,
+		readDataSource,
+	)
+}
+
+func readDataSource(ctx context.Context, esClient *clients.ElasticsearchScopedClient, config dataSourceModel) (dataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		policies map[string]estypes.Lifecycle
+		idSuffix string
+	)
+	if typeutils.IsKnown(config.Name) {
+		name := config.Name.ValueString()
+		idSuffix = name
+		policy, getDiags := elasticsearch.GetIlm(ctx, esClient, name)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+		if policy == nil {
+			diags.AddAttributeError(path.Root("name"), "ILM policy not found", fmt.Sprintf("ILM policy %q does not exist.", name))
+			return config, diags
+		}
+		policies = map[string]estypes.Lifecycle{name: *policy}
+	} else {
+		pattern := config.NamePattern.ValueString()
+		idSuffix = "_ilm/policy/" + pattern
+		all, getDiags := elasticsearch.GetIlms(ctx, esClient)
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+		policies = filterPoliciesByPattern(all, pattern)
+	}
+
+	models, modelDiags := policiesToModels(ctx, policies)
+	diags.Append(modelDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	id, idDiags := esClient.ID(ctx, idSuffix)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	config.ID = types.StringValue(id.String())
+
+	config.Metadata = jsontypes.NewNormalizedNull()
+	config.ModifiedDate = types.StringNull()
+	config.Hot = phaseObjectNull(ilmPhaseHot)
+	config.Warm = phaseObjectNull(ilmPhaseWarm)
+	config.Cold = phaseObjectNull(ilmPhaseCold)
+	config.Frozen = phaseObjectNull(ilmPhaseFrozen)
+	config.Delete = phaseObjectNull(ilmPhaseDelete)
+	if typeutils.IsKnown(config.Name) && len(models) == 1 {
+		policy := models[0]
+		config.Metadata = policy.Metadata
+		config.ModifiedDate = policy.ModifiedDate
+		config.Hot = policy.Hot
+		config.Warm = policy.Warm
+		config.Cold = policy.Cold
+		config.Frozen = policy.Frozen
+		config.Delete = policy.Delete
+	}
+
+	config.Policies = typeutils.SliceToListType(ctx, models, getDataSourcePolicyType(ctx), path.Root("policies"), &diags,
+		func(item dataSourcePolicyModel, _ typeutils.ListMeta) dataSourcePolicyModel { return item })
+	return config, diags
+}
+
+// policiesToModels flattens the policies with the resource read logic, sorted
+// by name.
+func policiesToModels(ctx context.Context, policies map[string]estypes.Lifecycle) ([]dataSourcePolicyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]dataSourcePolicyModel, 0, len(names))
+	for _, name := range names {
+		policy := policies[name]
+		prior := tfModel{Metadata: jsontypes.NewNormalizedNull()}
+		model, modelDiags := readPolicyIntoModel(ctx, &policy, &prior, name)
+		diags.Append(modelDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		result = append(result, dataSourcePolicyFromModel(*model))
+	}
+	return result, diags
+}
+
+func filterPoliciesByPattern(policies map[string]estypes.Lifecycle, pattern string) map[string]estypes.Lifecycle {
+	re := namePatternRegexp(pattern)
+	result := make(map[string]estypes.Lifecycle)
+	for name, policy := range policies {
+		if re.MatchString(name) {
+			result[name] = policy
+		}
+	}
+	return result
+}
+
+// namePatternRegexp compiles a comma-separated list of names with `*`
+// wildcards, the pattern syntax of the Elasticsearch APIs.
+func namePatternRegexp(pattern string) *regexp.Regexp {
+	var alternatives []string
+	for part := range strings.SplitSeq(pattern, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		alternatives = append(alternatives, strings.ReplaceAll(regexp.QuoteMeta(part), `\*`, `.*`))
+	}
+	return regexp.MustCompile(`^(?:` + strings.Join(alternatives, "|") + `)$`)
+}
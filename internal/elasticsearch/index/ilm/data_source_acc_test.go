@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceILM(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "name", name+"-logs"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "metadata", regexp.MustCompile(`"owner":"platform"`)),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "hot.rollover.max_age", "7d"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "delete.min_age", "30d"),
+					resource.TestCheckNoResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "warm.min_age"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_index_lifecycle.by_name", "modified_date"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_name", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_pattern", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_pattern", "policies.0.name", name+"-logs"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_pattern", "policies.1.name", name+"-metrics"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_pattern", "policies.1.warm.min_age", "1d"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_lifecycle.by_pattern", "policies.1.warm.readonly.enabled", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+//go:embed descriptions/ilm_data_source.md
+var dataSourceMarkdownDescription string
+
+func getDataSourceSchema(_ context.Context) dschema.Schema {
+	attrs := map[string]dschema.Attribute{
+		"id": dschema.StringAttribute{
+			Description: "Internal identifier of the data source.",
+			Computed:    true,
+		},
+		"name": dschema.StringAttribute{
+			Description: "Name of the policy to read. Exactly one of `name` or `name_pattern` must be set.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("name_pattern")),
+			},
+		},
+		"name_pattern": dschema.StringAttribute{
+			Description: "Comma-separated list of policy names, which may contain `*` wildcards, to list in `policies`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"policies": dschema.ListNestedAttribute{
+			Description: "The policies matching `name_pattern`, sorted by name. With `name`, the single policy read.",
+			Computed:    true,
+			NestedObject: dschema.NestedAttributeObject{
+				Attributes: dataSourcePolicyAttributes(map[string]dschema.Attribute{
+					"name": dschema.StringAttribute{
+						Description: "Name of the policy.",
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}
+	for name, attribute := range dataSourcePolicyAttributes(nil) {
+		attrs[name] = attribute
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: dataSourceMarkdownDescription,
+		Attributes:          attrs,
+	}
+}
+
+// dataSourcePolicyAttributes returns the attributes describing a policy, with
+// the phases typed like the blocks of the resource so that the resource
+// flatten logic can populate them.
+func dataSourcePolicyAttributes(attrs map[string]dschema.Attribute) map[string]dschema.Attribute {
+	result := map[string]dschema.Attribute{
+		attrMetadata: dschema.StringAttribute{
+			Description: "User metadata about the policy, as JSON.",
+			Computed:    true,
+			CustomType:  jsontypes.NormalizedType{},
+		},
+		"modified_date": dschema.StringAttribute{
+			Description: "The DateTime of the last modification.",
+			Computed:    true,
+		},
+	}
+	for _, ph := range supportedIlmPhases {
+		result[ph] = dschema.ObjectAttribute{
+			Description:    "The " + ph + " phase of the policy, with the same attributes as the `" + ph + "` block of the `elasticstack_elasticsearch_index_lifecycle` resource. Null when the policy has no " + ph + " phase.",
+			Computed:       true,
+			AttributeTypes: phaseObjectType(ph).AttrTypes,
+		}
+	}
+	for name, attribute := range attrs {
+		result[name] = attribute
+	}
+	return result
+}
+
+func getDataSourcePolicyType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["policies"].GetType().(attr.TypeWithElementType).ElementType()
+}
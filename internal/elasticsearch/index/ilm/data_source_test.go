@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamePatternRegexp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "logs", name: "logs", want: true},
+		{pattern: "logs", name: "logs-default", want: false},
+		{pattern: "logs*", name: "logs-default", want: true},
+		{pattern: "*@lifecycle", name: "metrics@lifecycle", want: true},
+		{pattern: "logs-*,metrics", name: "metrics", want: true},
+		{pattern: "logs-*, metrics", name: "metrics", want: true},
+		{pattern: "logs.*", name: "logsx-default", want: false},
+		{pattern: "*", name: "anything", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, namePatternRegexp(tt.pattern).MatchString(tt.name))
+		})
+	}
+}
+
+func TestPoliciesToModels(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var logs, metrics estypes.Lifecycle
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"version": 1,
+		"modified_date": "2024-01-01T00:00:00.000Z",
+		"policy": {
+			"_meta": {"owner": "platform"},
+			"phases": {
+				"hot": {"min_age": "0ms", "actions": {"rollover": {"max_age": "7d"}, "set_priority": {"priority": 100}}},
+				"delete": {"min_age": "30d", "actions": {"delete": {}}}
+			}
+		}
+	}`), &logs))
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"version": 1,
+		"modified_date": "2024-01-01T00:00:00.000Z",
+		"policy": {"phases": {"warm": {"min_age": "1d", "actions": {"readonly": {}}}}}
+	}`), &metrics))
+
+	policies := filterPoliciesByPattern(map[string]estypes.Lifecycle{
+		"metrics": metrics,
+		"logs":    logs,
+		"traces":  logs,
+	}, "logs,metrics")
+
+	models, diags := policiesToModels(ctx, policies)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.Len(t, models, 2)
+
+	assert.Equal(t, "logs", models[0].Name.ValueString())
+	assert.JSONEq(t, `{"owner":"platform"}`, models[0].Metadata.ValueString())
+	require.False(t, models[0].Hot.IsNull())
+	assert.Equal(t, `"0ms"`, models[0].Hot.Attributes()[attrMinAge].String())
+	require.False(t, models[0].Delete.IsNull())
+	assert.True(t, models[0].Warm.IsNull())
+
+	assert.Equal(t, "metrics", models[1].Name.ValueString())
+	assert.True(t, models[1].Metadata.IsNull())
+	require.False(t, models[1].Warm.IsNull())
+	assert.True(t, models[1].Hot.IsNull())
+}
@@ -0,0 +1,48 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "logs" {
+  name = "${var.name}-logs"
+
+  metadata = jsonencode({
+    owner = "platform"
+  })
+
+  hot {
+    rollover {
+      max_age = "7d"
+    }
+  }
+
+  delete {
+    min_age = "30d"
+    delete {}
+  }
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "metrics" {
+  name = "${var.name}-metrics"
+
+  warm {
+    min_age = "1d"
+    readonly {}
+  }
+}
+
+data "elasticstack_elasticsearch_index_lifecycle" "by_name" {
+  name = elasticstack_elasticsearch_index_lifecycle.logs.name
+}
+
+data "elasticstack_elasticsearch_index_lifecycle" "by_pattern" {
+  name_pattern = "${var.name}-*"
+
+  depends_on = [
+    elasticstack_elasticsearch_index_lifecycle.logs,
+    elasticstack_elasticsearch_index_lifecycle.metrics,
+  ]
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func actionTerraformVersionChecks() []tfversion.TerraformVersionCheck {
+	return []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_14_0),
+	}
+}
+
+func TestAccActionIlmMoveToStep(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("move"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check: resource.ComposeTestCheckFunc(
+					checkIlmPhase(name+"-managed-000001", "warm"),
+					checkIlmManaged(name+"-unmanaged", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccActionIlmRemovePolicy(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("remove"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check:                    checkIlmManaged(name+"-managed-000001", false),
+			},
+		},
+	})
+}
+
+func TestAccActionIlmStopStart(t *testing.T) {
+	// Never leave ILM stopped for the other tests, even when a step fails.
+	t.Cleanup(func() {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			t.Logf("acceptance elasticsearch client: %v", err)
+			return
+		}
+		if diags := esclient.StartIlm(context.Background(), client); diags.HasError() {
+			t.Logf("start ILM: %v", diags)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("stop"),
+				Check:                    checkIlmOperationMode("STOPPED"),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("start"),
+				Check:                    checkIlmOperationMode("RUNNING"),
+			},
+		},
+	})
+}
+
+func checkIlmPhase(index, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		explained, diags := esclient.ExplainIlm(context.Background(), client, index, false, false)
+		if diags.HasError() {
+			return fmt.Errorf("explain lifecycle of %q: %v", index, diags)
+		}
+		if got := explained[index].Phase; got != want {
+			return fmt.Errorf("expected index %q in phase %q, got %q", index, want, got)
+		}
+		return nil
+	}
+}
+
+func checkIlmManaged(index string, want bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		explained, diags := esclient.ExplainIlm(context.Background(), client, index, false, false)
+		if diags.HasError() {
+			return fmt.Errorf("explain lifecycle of %q: %v", index, diags)
+		}
+		if got := explained[index].Managed; got != want {
+			return fmt.Errorf("expected index %q managed=%t, got %t", index, want, got)
+		}
+		return nil
+	}
+}
+
+func checkIlmOperationMode(want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		got, diags := esclient.GetIlmStatus(context.Background(), client)
+		if diags.HasError() {
+			return fmt.Errorf("get ILM status: %v", diags)
+		}
+		if got != want {
+			return fmt.Errorf("expected ILM operation mode %q, got %q", want, got)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const defaultMoveInvokeTimeout = 5 * time.Minute
+
+// MoveToStepModel holds the Terraform configuration for the ILM move to step
+// action.
+type MoveToStepModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+
+	CurrentStep types.Object `tfsdk:"current_step"` // > stepModel
+	NextStep    types.Object `tfsdk:"next_step"`    // > stepModel
+}
+
+type stepModel struct {
+	Phase  types.String `tfsdk:"phase"`
+	Action types.String `tfsdk:"action"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// NewMoveToStepAction returns the elasticstack_elasticsearch_ilm_move_to_step action.
+func NewMoveToStepAction() action.Action {
+	return entitycore.NewElasticsearchAction[MoveToStepModel]("ilm_move_to_step", entitycore.ElasticsearchActionOptions[MoveToStepModel]{
+		Schema:               getMoveToStepSchema,
+		Invoke:               invokeMoveToStep,
+		DefaultInvokeTimeout: defaultMoveInvokeTimeout,
+	})
+}
+
+func getMoveToStepSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Moves indices to a step of their lifecycle policy with `POST /_ilm/move/{index}`, one index at a time. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"The current step of each index is read with the explain lifecycle API first. Indices that are not managed, are managed by another `policy`, or are not in `current_step` are skipped.",
+		Attributes: withIndicesAttributes(map[string]actionschema.Attribute{
+			"current_step": actionschema.SingleNestedAttribute{
+				MarkdownDescription: "Only move indices that are in this step. When omitted, every index is moved from the step it is in. Indices in the `ERROR` step have the step name `ERROR`.",
+				Optional:            true,
+				Attributes: map[string]actionschema.Attribute{
+					"phase": actionschema.StringAttribute{
+						MarkdownDescription: "The phase the index is in.",
+						Required:            true,
+					},
+					"action": actionschema.StringAttribute{
+						MarkdownDescription: "The action the index is in.",
+						Required:            true,
+					},
+					"name": actionschema.StringAttribute{
+						MarkdownDescription: "The step the index is in.",
+						Required:            true,
+					},
+				},
+			},
+			"next_step": actionschema.SingleNestedAttribute{
+				MarkdownDescription: "The step to move the indices to. With only `phase`, the indices move to the first step of the phase.",
+				Required:            true,
+				Attributes: map[string]actionschema.Attribute{
+					"phase": actionschema.StringAttribute{
+						MarkdownDescription: "The phase to move to.",
+						Required:            true,
+					},
+					"action": actionschema.StringAttribute{
+						MarkdownDescription: "The action to move to. Requires `phase`.",
+						Optional:            true,
+					},
+					"name": actionschema.StringAttribute{
+						MarkdownDescription: "The step to move to. Requires `action`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRoot("next_step").AtName("action")),
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func invokeMoveToStep(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[MoveToStepModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model := req.Config
+
+	var next stepModel
+	diags.Append(model.NextStep.As(ctx, &next, basetypes.ObjectAsOptions{})...)
+	var current *stepModel
+	if typeutils.IsKnown(model.CurrentStep) {
+		current = &stepModel{}
+		diags.Append(model.CurrentStep.As(ctx, current, basetypes.ObjectAsOptions{})...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	indices, explainDiags := model.explain(ctx, client)
+	diags.Append(explainDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	nextStep := estypes.StepKey{
+		Phase:  next.Phase.ValueString(),
+		Action: next.Action.ValueStringPointer(),
+		Name:   next.Name.ValueStringPointer(),
+	}
+	diags.Append(runPerIndex(ctx, indices, indexOperation{
+		name: "move",
+		done: "Moved",
+		skip: func(index models.IlmExplainIndex) string {
+			if reason := model.skipReason(index); reason != "" {
+				return reason
+			}
+			return moveSkipReason(index, current)
+		},
+		apply: func(ctx context.Context, index models.IlmExplainIndex) diag.Diagnostics {
+			return esclient.MoveIlmToStep(ctx, client, index.Index, currentStepKey(index), nextStep)
+		},
+	}, req.SendProgress)...)
+	return diags
+}
+
+// moveSkipReason checks that index is in the expected step, if any.
+func moveSkipReason(index models.IlmExplainIndex, expected *stepModel) string {
+	if index.Phase == "" || index.Action == "" || index.Step == "" {
+		return "the current step is not known yet"
+	}
+	if expected == nil {
+		return ""
+	}
+	if index.Phase != expected.Phase.ValueString() || index.Action != expected.Action.ValueString() || index.Step != expected.Name.ValueString() {
+		return fmt.Sprintf("in step %s, not %s", stepString(index.Phase, index.Action, index.Step),
+			stepString(expected.Phase.ValueString(), expected.Action.ValueString(), expected.Name.ValueString()))
+	}
+	return ""
+}
+
+// currentStepKey is the step Elasticsearch expects the index to be in.
+func currentStepKey(index models.IlmExplainIndex) estypes.StepKey {
+	return estypes.StepKey{
+		Phase:  index.Phase,
+		Action: &index.Action,
+		Name:   &index.Step,
+	}
+}
+
+func stepString(phase, action, name string) string {
+	return fmt.Sprintf("%s/%s/%s", phase, action, name)
+}
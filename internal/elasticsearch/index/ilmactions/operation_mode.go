@@ -0,0 +1,202 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/asyncutils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultOperationModeInvokeTimeout = 10 * time.Minute
+	operationModePollInterval         = 5 * time.Second
+
+	operationModeRunning = "RUNNING"
+	operationModeStopped = "STOPPED"
+)
+
+// StartModel holds the Terraform configuration for the ILM start action.
+type StartModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+}
+
+// StopModel holds the Terraform configuration for the ILM stop action.
+type StopModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+
+	WaitForCompletion types.Bool `tfsdk:"wait_for_completion"`
+}
+
+// NewStartAction returns the elasticstack_elasticsearch_ilm_start action.
+func NewStartAction() action.Action {
+	return entitycore.NewElasticsearchAction[StartModel]("ilm_start", entitycore.ElasticsearchActionOptions[StartModel]{
+		Schema:               getStartSchema,
+		Invoke:               invokeStart,
+		DefaultInvokeTimeout: defaultOperationModeInvokeTimeout,
+	})
+}
+
+// NewStopAction returns the elasticstack_elasticsearch_ilm_stop action.
+func NewStopAction() action.Action {
+	return entitycore.NewElasticsearchAction[StopModel]("ilm_stop", entitycore.ElasticsearchActionOptions[StopModel]{
+		Schema:               getStopSchema,
+		Invoke:               invokeStop,
+		DefaultInvokeTimeout: defaultOperationModeInvokeTimeout,
+	})
+}
+
+func getStartSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Starts index lifecycle management with `POST /_ilm/start`, after it was stopped. **Requires Terraform 1.14+** (provider-defined actions).",
+	}
+}
+
+func getStopSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Stops index lifecycle management for all indices with `POST /_ilm/stop`, for example during cluster maintenance. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"ILM is `STOPPING` until the operations in progress complete, and `STOPPED` afterwards.",
+		Attributes: map[string]actionschema.Attribute{
+			"wait_for_completion": actionschema.BoolAttribute{
+				MarkdownDescription: "When `true`, polls `GET /_ilm/status` until ILM is `STOPPED` or the invoke timeout elapses. Defaults to `true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func invokeStart(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[StartModel]) diag.Diagnostics {
+	return changeOperationMode(ctx, client, operationModeRunning, false, esclient.StartIlm, req.SendProgress)
+}
+
+func invokeStop(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[StopModel]) diag.Diagnostics {
+	wait := !typeutils.IsKnown(req.Config.WaitForCompletion) || req.Config.WaitForCompletion.ValueBool()
+	return changeOperationMode(ctx, client, operationModeStopped, wait, esclient.StopIlm, req.SendProgress)
+}
+
+// changeOperationMode requests ILM to move to target, unless it is already
+// there, and optionally waits until the mode is reached.
+func changeOperationMode(
+	ctx context.Context,
+	client *clients.ElasticsearchScopedClient,
+	target string,
+	wait bool,
+	change func(context.Context, *clients.ElasticsearchScopedClient) diag.Diagnostics,
+	sendProgress func(action.InvokeProgressEvent),
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := func(message string) {
+		if sendProgress != nil {
+			sendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+
+	mode, statusDiags := esclient.GetIlmStatus(ctx, client)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if mode == target {
+		report(fmt.Sprintf("ILM is already %s", target))
+		return diags
+	}
+
+	diags.Append(change(ctx, client)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if wait {
+		get := func(ctx context.Context) (string, diag.Diagnostics) { return esclient.GetIlmStatus(ctx, client) }
+		diags.Append(waitForOperationMode(ctx, target, get, operationModePollInterval)...)
+		if diags.HasError() {
+			return diags
+		}
+		report(fmt.Sprintf("ILM is %s", target))
+		return diags
+	}
+
+	mode, statusDiags = esclient.GetIlmStatus(ctx, client)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	report(fmt.Sprintf("ILM is %s", mode))
+	return diags
+}
+
+// operationModeGetter fetches the ILM operation mode for polling.
+type operationModeGetter func(ctx context.Context) (string, diag.Diagnostics)
+
+// waitForOperationMode polls the ILM status with
+// [asyncutils.WaitForStateTransition] until the operation mode is target.
+func waitForOperationMode(ctx context.Context, target string, get operationModeGetter, pollInterval time.Duration) diag.Diagnostics {
+	var (
+		lastMode string
+		getDiags diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		mode, diags := get(ctx)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errOperationModeGetFailed
+		}
+		lastMode = mode
+		return mode == target, nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "ilm_operation_mode", target, stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	var diags diag.Diagnostics
+	switch {
+	case err == nil:
+		return diags
+	case errors.Is(err, errOperationModeGetFailed):
+		return getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		diags.AddError(
+			fmt.Sprintf("ILM did not become %s within timeout", target),
+			fmt.Sprintf("The last observed operation mode is %q. ILM waits for the operations in progress to complete; increase the invoke timeout to wait longer.", lastMode),
+		)
+		return diags
+	default:
+		diags.AddError("ILM status wait failed", err.Error())
+		return diags
+	}
+}
+
+// errOperationModeGetFailed is a sentinel returned by the state checker to
+// bail out of the shared poll loop while preserving the original diagnostics.
+var errOperationModeGetFailed = errors.New("ilm status get failed")
@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForOperationMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reaches the target mode", func(t *testing.T) {
+		t.Parallel()
+		modes := []string{"STOPPING", "STOPPING", "STOPPED"}
+		get := func(context.Context) (string, diag.Diagnostics) {
+			mode := modes[0]
+			if len(modes) > 1 {
+				modes = modes[1:]
+			}
+			return mode, nil
+		}
+
+		diags := waitForOperationMode(context.Background(), operationModeStopped, get, time.Millisecond)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		t.Parallel()
+		get := func(context.Context) (string, diag.Diagnostics) {
+			return "", diag.Diagnostics{diag.NewErrorDiagnostic("boom", "security_exception")}
+		}
+
+		diags := waitForOperationMode(context.Background(), operationModeStopped, get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "boom", diags[0].Summary())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		get := func(context.Context) (string, diag.Diagnostics) { return "STOPPING", nil }
+
+		diags := waitForOperationMode(ctx, operationModeStopped, get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "ILM did not become STOPPED within timeout", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), `"STOPPING"`)
+	})
+}
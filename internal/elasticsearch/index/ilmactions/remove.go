@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const defaultRemoveInvokeTimeout = 5 * time.Minute
+
+// RemovePolicyModel holds the Terraform configuration for the ILM remove
+// policy action.
+type RemovePolicyModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+}
+
+// NewRemovePolicyAction returns the elasticstack_elasticsearch_ilm_remove_policy action.
+func NewRemovePolicyAction() action.Action {
+	return entitycore.NewElasticsearchAction[RemovePolicyModel]("ilm_remove_policy", entitycore.ElasticsearchActionOptions[RemovePolicyModel]{
+		Schema:               getRemovePolicySchema,
+		Invoke:               invokeRemovePolicy,
+		DefaultInvokeTimeout: defaultRemoveInvokeTimeout,
+	})
+}
+
+func getRemovePolicySchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Removes the lifecycle policy from indices with `POST /{index}/_ilm/remove`, one index at a time, so that ILM stops managing them. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"Indices that are not managed, or are managed by another `policy`, are skipped. " +
+			"An index template that sets `index.lifecycle.name` still applies the policy to new indices.",
+		Attributes: withIndicesAttributes(nil),
+	}
+}
+
+func invokeRemovePolicy(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[RemovePolicyModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model := req.Config
+
+	indices, explainDiags := model.explain(ctx, client)
+	diags.Append(explainDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(runPerIndex(ctx, indices, indexOperation{
+		name: "remove the policy from",
+		done: "Removed the policy from",
+		skip: model.skipReason,
+		apply: func(ctx context.Context, index models.IlmExplainIndex) diag.Diagnostics {
+			failed, removeDiags := esclient.RemoveIlmPolicy(ctx, client, index.Index)
+			if removeDiags.HasError() {
+				return removeDiags
+			}
+			if slices.Contains(failed, index.Index) {
+				removeDiags.AddError("Policy not removed", "Elasticsearch reported the index as failed.")
+			}
+			return removeDiags
+		},
+	}, req.SendProgress)...)
+	return diags
+}
@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultRetryInvokeTimeout = 5 * time.Minute
+	errorStep                 = "ERROR"
+)
+
+// RetryModel holds the Terraform configuration for the ILM retry action.
+type RetryModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+
+	FailedStep types.String `tfsdk:"failed_step"`
+}
+
+// NewRetryAction returns the elasticstack_elasticsearch_ilm_retry action.
+func NewRetryAction() action.Action {
+	return entitycore.NewElasticsearchAction[RetryModel]("ilm_retry", entitycore.ElasticsearchActionOptions[RetryModel]{
+		Schema:               getRetrySchema,
+		Invoke:               invokeRetry,
+		DefaultInvokeTimeout: defaultRetryInvokeTimeout,
+	})
+}
+
+func getRetrySchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Retries the failed lifecycle step of indices with `POST /{index}/_ilm/retry`, one index at a time. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"Only indices in the `ERROR` step are retried; other matching indices are skipped.",
+		Attributes: withIndicesAttributes(map[string]actionschema.Attribute{
+			"failed_step": actionschema.StringAttribute{
+				MarkdownDescription: "Only retry indices whose failed step has this name, such as `check-rollover-ready`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func invokeRetry(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[RetryModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model := req.Config
+
+	indices, explainDiags := model.explain(ctx, client)
+	diags.Append(explainDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(runPerIndex(ctx, indices, indexOperation{
+		name: "retry",
+		done: "Retried",
+		skip: func(index models.IlmExplainIndex) string {
+			if reason := model.skipReason(index); reason != "" {
+				return reason
+			}
+			return retrySkipReason(index, model.FailedStep)
+		},
+		apply: func(ctx context.Context, index models.IlmExplainIndex) diag.Diagnostics {
+			return esclient.RetryIlm(ctx, client, index.Index)
+		},
+	}, req.SendProgress)...)
+	return diags
+}
+
+func retrySkipReason(index models.IlmExplainIndex, failedStep types.String) string {
+	if index.Step != errorStep {
+		return fmt.Sprintf("not in the %s step, but in %s", errorStep, stepString(index.Phase, index.Action, index.Step))
+	}
+	if typeutils.IsKnown(failedStep) && index.FailedStep != failedStep.ValueString() {
+		return fmt.Sprintf("failed in step %q, not %q", index.FailedStep, failedStep.ValueString())
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// indicesFields are the attributes shared by the per-index ILM actions: the
+// indices to act on, and the policy they are expected to be managed by.
+type indicesFields struct {
+	Indices types.List   `tfsdk:"indices"`
+	Policy  types.String `tfsdk:"policy"`
+}
+
+// withIndicesAttributes returns attrs extended with the shared indices
+// attributes.
+func withIndicesAttributes(attrs map[string]actionschema.Attribute) map[string]actionschema.Attribute {
+	result := map[string]actionschema.Attribute{
+		"indices": actionschema.ListAttribute{
+			MarkdownDescription: "Names or wildcard patterns of the indices to act on. Data streams and aliases resolve to their backing indices.",
+			ElementType:         types.StringType,
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+		"policy": actionschema.StringAttribute{
+			MarkdownDescription: "Only act on indices managed by this lifecycle policy. Matching indices managed by another policy are skipped.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+	}
+	maps.Copy(result, attrs)
+	return result
+}
+
+// explain returns the lifecycle state of every index matching the configured
+// patterns, sorted by index name.
+func (f indicesFields) explain(ctx context.Context, client *clients.ElasticsearchScopedClient) ([]models.IlmExplainIndex, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patterns := typeutils.ListTypeToSliceString(ctx, f.Indices, path.Root("indices"), &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	explained, explainDiags := esclient.ExplainIlm(ctx, client, strings.Join(patterns, ","), false, false)
+	diags.Append(explainDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return sortedIndices(explained), diags
+}
+
+func sortedIndices(explained map[string]models.IlmExplainIndex) []models.IlmExplainIndex {
+	indices := make([]models.IlmExplainIndex, 0, len(explained))
+	for name, index := range explained {
+		if index.Index == "" {
+			index.Index = name
+		}
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i].Index < indices[j].Index })
+	return indices
+}
+
+// skipReason returns why index is not eligible for any per-index action, or
+// an empty string.
+func (f indicesFields) skipReason(index models.IlmExplainIndex) string {
+	if !index.Managed {
+		return "not managed by a lifecycle policy"
+	}
+	if typeutils.IsKnown(f.Policy) && index.Policy != f.Policy.ValueString() {
+		return fmt.Sprintf("managed by policy %q, not %q", index.Policy, f.Policy.ValueString())
+	}
+	return ""
+}
+
+// indexOperation is an ILM operation applied to one index at a time, after
+// checking the index's current lifecycle state.
+type indexOperation struct {
+	// name is the operation in error messages, such as "retry".
+	name string
+	// done is the operation in progress messages, such as "Retried".
+	done string
+	// skip returns why the index is not eligible, or an empty string.
+	skip  func(index models.IlmExplainIndex) string
+	apply func(ctx context.Context, index models.IlmExplainIndex) diag.Diagnostics
+}
+
+// runPerIndex applies op to each index in turn and reports the outcome for
+// every index as a progress event. A failure on one index does not stop the
+// others; each failure is returned as an error diagnostic.
+func runPerIndex(ctx context.Context, indices []models.IlmExplainIndex, op indexOperation, sendProgress func(action.InvokeProgressEvent)) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := func(message string) {
+		if sendProgress != nil {
+			sendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+
+	var applied, skipped, failed int
+	for _, index := range indices {
+		if reason := op.skip(index); reason != "" {
+			skipped++
+			report(fmt.Sprintf("Skipped %s: %s", index.Index, reason))
+			continue
+		}
+
+		if applyDiags := op.apply(ctx, index); applyDiags.HasError() {
+			failed++
+			err := diagutil.FwDiagsAsError(applyDiags)
+			report(fmt.Sprintf("Failed to %s %s: %s", op.name, index.Index, err))
+			diags.AddError(fmt.Sprintf("Failed to %s %s", op.name, index.Index), err.Error())
+			continue
+		}
+		applied++
+		report(fmt.Sprintf("%s %s", op.done, index.Index))
+	}
+
+	report(fmt.Sprintf("%s %d of %d indices, %d skipped, %d failed", op.done, applied, len(indices), skipped, failed))
+	if applied == 0 && failed == 0 {
+		diags.AddWarning(
+			fmt.Sprintf("No index to %s", op.name),
+			fmt.Sprintf("%d indices matched, none of them is eligible.", len(indices)),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedIndices(t *testing.T) {
+	t.Parallel()
+
+	indices := sortedIndices(map[string]models.IlmExplainIndex{
+		"logs-2": {Index: "logs-2"},
+		"logs-1": {},
+	})
+	require.Len(t, indices, 2)
+	assert.Equal(t, "logs-1", indices[0].Index)
+	assert.Equal(t, "logs-2", indices[1].Index)
+}
+
+func TestSkipReason(t *testing.T) {
+	t.Parallel()
+
+	anyPolicy := indicesFields{Policy: types.StringNull()}
+	logsPolicy := indicesFields{Policy: types.StringValue("logs")}
+
+	assert.Equal(t, "not managed by a lifecycle policy", anyPolicy.skipReason(models.IlmExplainIndex{Managed: false}))
+	assert.Empty(t, anyPolicy.skipReason(models.IlmExplainIndex{Managed: true, Policy: "metrics"}))
+	assert.Empty(t, logsPolicy.skipReason(models.IlmExplainIndex{Managed: true, Policy: "logs"}))
+	assert.Equal(t, `managed by policy "metrics", not "logs"`, logsPolicy.skipReason(models.IlmExplainIndex{Managed: true, Policy: "metrics"}))
+}
+
+func TestMoveSkipReason(t *testing.T) {
+	t.Parallel()
+
+	index := models.IlmExplainIndex{Managed: true, Phase: "hot", Action: "rollover", Step: "ERROR"}
+	expected := &stepModel{
+		Phase:  types.StringValue("hot"),
+		Action: types.StringValue("rollover"),
+		Name:   types.StringValue("ERROR"),
+	}
+	other := &stepModel{
+		Phase:  types.StringValue("hot"),
+		Action: types.StringValue("rollover"),
+		Name:   types.StringValue("check-rollover-ready"),
+	}
+
+	assert.Empty(t, moveSkipReason(index, nil))
+	assert.Empty(t, moveSkipReason(index, expected))
+	assert.Equal(t, "in step hot/rollover/ERROR, not hot/rollover/check-rollover-ready", moveSkipReason(index, other))
+	assert.Equal(t, "the current step is not known yet", moveSkipReason(models.IlmExplainIndex{Managed: true}, nil))
+}
+
+func TestRetrySkipReason(t *testing.T) {
+	t.Parallel()
+
+	failed := models.IlmExplainIndex{Managed: true, Phase: "hot", Action: "rollover", Step: "ERROR", FailedStep: "check-rollover-ready"}
+	running := models.IlmExplainIndex{Managed: true, Phase: "hot", Action: "rollover", Step: "check-rollover-ready"}
+
+	assert.Empty(t, retrySkipReason(failed, types.StringNull()))
+	assert.Empty(t, retrySkipReason(failed, types.StringValue("check-rollover-ready")))
+	assert.Equal(t, `failed in step "check-rollover-ready", not "attempt-rollover"`, retrySkipReason(failed, types.StringValue("attempt-rollover")))
+	assert.Equal(t, "not in the ERROR step, but in hot/rollover/check-rollover-ready", retrySkipReason(running, types.StringNull()))
+}
+
+func TestRunPerIndex(t *testing.T) {
+	t.Parallel()
+
+	indices := []models.IlmExplainIndex{
+		{Index: "logs-1", Managed: true},
+		{Index: "logs-2", Managed: false},
+		{Index: "logs-3", Managed: true},
+	}
+	var applied []string
+	op := indexOperation{
+		name: "retry",
+		done: "Retried",
+		skip: func(index models.IlmExplainIndex) string {
+			if !index.Managed {
+				return "not managed"
+			}
+			return ""
+		},
+		apply: func(_ context.Context, index models.IlmExplainIndex) diag.Diagnostics {
+			applied = append(applied, index.Index)
+			if index.Index == "logs-3" {
+				return diag.Diagnostics{diag.NewErrorDiagnostic("boom", "illegal_argument_exception")}
+			}
+			return nil
+		},
+	}
+	var messages []string
+	sendProgress := func(event action.InvokeProgressEvent) { messages = append(messages, event.Message) }
+
+	diags := runPerIndex(context.Background(), indices, op, sendProgress)
+
+	assert.Equal(t, []string{"logs-1", "logs-3"}, applied)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Failed to retry logs-3", diags[0].Summary())
+	assert.Equal(t, "boom: illegal_argument_exception", diags[0].Detail())
+	assert.Equal(t, []string{
+		"Retried logs-1",
+		"Skipped logs-2: not managed",
+		"Failed to retry logs-3: boom: illegal_argument_exception",
+		"Retried 1 of 3 indices, 1 skipped, 1 failed",
+	}, messages)
+}
+
+func TestRunPerIndex_noEligibleIndex(t *testing.T) {
+	t.Parallel()
+
+	op := indexOperation{
+		name:  "retry",
+		done:  "Retried",
+		skip:  func(models.IlmExplainIndex) string { return "not in the ERROR step" },
+		apply: func(context.Context, models.IlmExplainIndex) diag.Diagnostics { return nil },
+	}
+
+	diags := runPerIndex(context.Background(), []models.IlmExplainIndex{{Index: "logs-1"}}, op, nil)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, "No index to retry", diags[0].Summary())
+}
@@ -0,0 +1,68 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "test" {
+  name = var.name
+
+  hot {
+    set_priority {
+      priority = 100
+    }
+  }
+
+  warm {
+    min_age = "30d"
+
+    set_priority {
+      priority = 50
+    }
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name           = var.name
+  index_patterns = ["${var.name}-managed-*"]
+
+  template {
+    settings = jsonencode({
+      "index.lifecycle.name" = elasticstack_elasticsearch_index_lifecycle.test.name
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "managed" {
+  name                = "${var.name}-managed-000001"
+  deletion_protection = false
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+resource "elasticstack_elasticsearch_index" "unmanaged" {
+  name                = "${var.name}-unmanaged"
+  deletion_protection = false
+}
+
+action "elasticstack_elasticsearch_ilm_remove_policy" "remove" {
+  config {
+    indices = ["${var.name}-*"]
+  }
+}
+
+resource "terraform_data" "trigger_remove" {
+  depends_on = [
+    elasticstack_elasticsearch_index.managed,
+    elasticstack_elasticsearch_index.unmanaged,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_ilm_remove_policy.remove]
+    }
+  }
+}
@@ -0,0 +1,14 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+action "elasticstack_elasticsearch_ilm_start" "start" {}
+
+resource "terraform_data" "trigger_start" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_ilm_start.start]
+    }
+  }
+}
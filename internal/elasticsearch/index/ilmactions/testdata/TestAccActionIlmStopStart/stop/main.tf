@@ -0,0 +1,18 @@
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+action "elasticstack_elasticsearch_ilm_stop" "stop" {
+  config {
+    wait_for_completion = true
+  }
+}
+
+resource "terraform_data" "trigger_stop" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_ilm_stop.stop]
+    }
+  }
+}
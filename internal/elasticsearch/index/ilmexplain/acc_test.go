@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmexplain_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIlmExplainDataSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					// Indices are sorted by name, so the managed index comes first.
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.#", "2"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.0.index", name+"-managed-000001"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.0.managed", "true"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.0.policy", name),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_ilm_explain.all", "indices.0.phase"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_elasticsearch_ilm_explain.all", "indices.0.step"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.1.index", name+"-unmanaged"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.1.managed", "false"),
+					resource.TestCheckNoResourceAttr("data.elasticstack_elasticsearch_ilm_explain.all", "indices.1.policy"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.managed", "indices.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.managed", "indices.0.index", name+"-managed-000001"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_ilm_explain.errors", "indices.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmexplain
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID          types.String `tfsdk:"id"`
+	Index       types.String `tfsdk:"index"`
+	OnlyErrors  types.Bool   `tfsdk:"only_errors"`
+	OnlyManaged types.Bool   `tfsdk:"only_managed"`
+	Indices     types.List   `tfsdk:"indices"` // > indexTfModel
+}
+
+type indexTfModel struct {
+	Index                types.String         `tfsdk:"index"`
+	Managed              types.Bool           `tfsdk:"managed"`
+	Policy               types.String         `tfsdk:"policy"`
+	Phase                types.String         `tfsdk:"phase"`
+	Action               types.String         `tfsdk:"action"`
+	Step                 types.String         `tfsdk:"step"`
+	FailedStep           types.String         `tfsdk:"failed_step"`
+	StepInfo             jsontypes.Normalized `tfsdk:"step_info"`
+	Age                  types.String         `tfsdk:"age"`
+	IsAutoRetryableError types.Bool           `tfsdk:"is_auto_retryable_error"`
+	FailedStepRetryCount types.Int64          `tfsdk:"failed_step_retry_count"`
+}
+
+func (model *tfModel) populateFromAPI(ctx context.Context, explained map[string]models.IlmExplainIndex) (diags diag.Diagnostics) {
+	names := make([]string, 0, len(explained))
+	for name := range explained {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	indices := make([]models.IlmExplainIndex, 0, len(names))
+	for _, name := range names {
+		index := explained[name]
+		if index.Index == "" {
+			index.Index = name
+		}
+		indices = append(indices, index)
+	}
+
+	model.Indices = typeutils.SliceToListType(ctx, indices, getIndexType(ctx), path.Root("indices"), &diags,
+		func(item models.IlmExplainIndex, meta typeutils.ListMeta) indexTfModel {
+			return indexTfModel{
+				Index:                types.StringValue(item.Index),
+				Managed:              types.BoolValue(item.Managed),
+				Policy:               typeutils.NonEmptyStringishValue(item.Policy),
+				Phase:                typeutils.NonEmptyStringishValue(item.Phase),
+				Action:               typeutils.NonEmptyStringishValue(item.Action),
+				Step:                 typeutils.NonEmptyStringishValue(item.Step),
+				FailedStep:           typeutils.NonEmptyStringishValue(item.FailedStep),
+				StepInfo:             stepInfoValue(item.StepInfo, meta.Path.AtName("step_info"), meta.Diags),
+				Age:                  typeutils.NonEmptyStringishValue(item.Age),
+				IsAutoRetryableError: types.BoolPointerValue(item.IsAutoRetryableError),
+				FailedStepRetryCount: types.Int64PointerValue(item.FailedStepRetryCount),
+			}
+		})
+	return diags
+}
+
+func stepInfoValue(stepInfo map[string]any, p path.Path, diags *diag.Diagnostics) jsontypes.Normalized {
+	if len(stepInfo) == 0 {
+		return jsontypes.NewNormalizedNull()
+	}
+	bytes, err := json.Marshal(stepInfo)
+	if err != nil {
+		diags.AddAttributeError(p, "Failed to encode the step info", err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(string(bytes))
+}
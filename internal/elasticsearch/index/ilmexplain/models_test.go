@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmexplain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, map[string]models.IlmExplainIndex{
+		"logs-2": {Index: "logs-2", Managed: false},
+		"logs-1": {
+			Index:                "logs-1",
+			Managed:              true,
+			Policy:               "logs",
+			Phase:                "hot",
+			Action:               "rollover",
+			Step:                 "ERROR",
+			FailedStep:           "check-rollover-ready",
+			StepInfo:             map[string]any{"type": "illegal_argument_exception", "reason": "rollover alias is missing"},
+			Age:                  "1.2d",
+			IsAutoRetryableError: new(true),
+			FailedStepRetryCount: new(int64(3)),
+		},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var indices []indexTfModel
+	require.False(t, model.Indices.ElementsAs(ctx, &indices, false).HasError())
+	require.Len(t, indices, 2)
+
+	failed := indices[0]
+	assert.Equal(t, "logs-1", failed.Index.ValueString())
+	assert.True(t, failed.Managed.ValueBool())
+	assert.Equal(t, "logs", failed.Policy.ValueString())
+	assert.Equal(t, "hot", failed.Phase.ValueString())
+	assert.Equal(t, "rollover", failed.Action.ValueString())
+	assert.Equal(t, "ERROR", failed.Step.ValueString())
+	assert.Equal(t, "check-rollover-ready", failed.FailedStep.ValueString())
+	assert.JSONEq(t, `{"type":"illegal_argument_exception","reason":"rollover alias is missing"}`, failed.StepInfo.ValueString())
+	assert.Equal(t, "1.2d", failed.Age.ValueString())
+	assert.True(t, failed.IsAutoRetryableError.ValueBool())
+	assert.Equal(t, int64(3), failed.FailedStepRetryCount.ValueInt64())
+
+	unmanaged := indices[1]
+	assert.Equal(t, "logs-2", unmanaged.Index.ValueString())
+	assert.False(t, unmanaged.Managed.ValueBool())
+	assert.True(t, unmanaged.Policy.IsNull())
+	assert.True(t, unmanaged.Step.IsNull())
+	assert.True(t, unmanaged.StepInfo.IsNull())
+	assert.True(t, unmanaged.FailedStepRetryCount.IsNull())
+}
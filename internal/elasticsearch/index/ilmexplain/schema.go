@@ -0,0 +1,112 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilmexplain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier of the data source.",
+				Computed:    true,
+			},
+			"index": schema.StringAttribute{
+				Description: "Comma-separated list of indices, data streams or aliases to explain. Supports wildcards (`*`).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"only_errors": schema.BoolAttribute{
+				Description: "Only return managed indices whose lifecycle step is `ERROR`.",
+				Optional:    true,
+			},
+			"only_managed": schema.BoolAttribute{
+				Description: "Only return indices managed by a lifecycle policy.",
+				Optional:    true,
+			},
+			"indices": schema.ListNestedAttribute{
+				Description: "The lifecycle state of each matching index, sorted by index name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.StringAttribute{
+							Description: "Name of the index.",
+							Computed:    true,
+						},
+						"managed": schema.BoolAttribute{
+							Description: "Whether the index is managed by a lifecycle policy. The other attributes are null for unmanaged indices.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "Name of the lifecycle policy managing the index.",
+							Computed:    true,
+						},
+						"phase": schema.StringAttribute{
+							Description: "Current lifecycle phase, such as `hot` or `warm`.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Current lifecycle action, such as `rollover`.",
+							Computed:    true,
+						},
+						"step": schema.StringAttribute{
+							Description: "Current lifecycle step. `ERROR` when the index cannot progress.",
+							Computed:    true,
+						},
+						"failed_step": schema.StringAttribute{
+							Description: "The step that failed, when `step` is `ERROR`.",
+							Computed:    true,
+						},
+						"step_info": schema.StringAttribute{
+							Description: "Details about the current step, such as the cause of a failure, as JSON.",
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+						},
+						"age": schema.StringAttribute{
+							Description: "Time since the index was created or rolled over, which is used to decide when it enters the next phase.",
+							Computed:    true,
+						},
+						"is_auto_retryable_error": schema.BoolAttribute{
+							Description: "Whether ILM retries the failed step automatically.",
+							Computed:    true,
+						},
+						"failed_step_retry_count": schema.Int64Attribute{
+							Description: "Number of automatic retries of the failed step.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getIndexType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["indices"].GetType().(attr.TypeWithElementType).ElementType()
+}
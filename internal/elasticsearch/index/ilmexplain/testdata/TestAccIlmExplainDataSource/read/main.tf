@@ -0,0 +1,68 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_lifecycle" "test" {
+  name = var.name
+
+  delete {
+    min_age = "30d"
+    delete {}
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "test" {
+  name           = var.name
+  index_patterns = ["${var.name}-managed-*"]
+
+  template {
+    settings = jsonencode({
+      "index.lifecycle.name" = elasticstack_elasticsearch_index_lifecycle.test.name
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "managed" {
+  name                = "${var.name}-managed-000001"
+  deletion_protection = false
+
+  depends_on = [elasticstack_elasticsearch_index_template.test]
+}
+
+resource "elasticstack_elasticsearch_index" "unmanaged" {
+  name                = "${var.name}-unmanaged"
+  deletion_protection = false
+}
+
+data "elasticstack_elasticsearch_ilm_explain" "all" {
+  index = "${var.name}-*"
+
+  depends_on = [
+    elasticstack_elasticsearch_index.managed,
+    elasticstack_elasticsearch_index.unmanaged,
+  ]
+}
+
+data "elasticstack_elasticsearch_ilm_explain" "managed" {
+  index        = "${var.name}-*"
+  only_managed = true
+
+  depends_on = [
+    elasticstack_elasticsearch_index.managed,
+    elasticstack_elasticsearch_index.unmanaged,
+  ]
+}
+
+data "elasticstack_elasticsearch_ilm_explain" "errors" {
+  index       = "${var.name}-*"
+  only_errors = true
+
+  depends_on = [
+    elasticstack_elasticsearch_index.managed,
+    elasticstack_elasticsearch_index.unmanaged,
+  ]
+}
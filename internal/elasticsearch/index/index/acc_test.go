@@ -29,6 +29,7 @@ import (
 	"testing"
 
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/refresh"
 	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
@@ -1286,3 +1287,109 @@ func TestAccResourceIndexSortNestedMigration(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceIndexReindexMigration(t *testing.T) {
+	indexName := strings.ToLower(sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum))
+	aliasName := indexName + "-alias"
+	migratedName := indexName + "-v2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("migrate"),
+				ConfigVariables: config.Variables{
+					"index_name": config.StringVariable(indexName),
+					"shards":     config.IntegerVariable(1),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "concrete_name", indexName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "migration.alias", aliasName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "migration.delete_old_index", "true"),
+				),
+			},
+			{
+				// Changing number_of_shards migrates the documents into <name>-v2
+				// instead of replacing the index.
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("migrate"),
+				ConfigVariables: config.Variables{
+					"index_name": config.StringVariable(indexName),
+					"shards":     config.IntegerVariable(2),
+				},
+				PreConfig: func() { indexTestDocuments(t, aliasName, 5) },
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("elasticstack_elasticsearch_index.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "name", indexName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "concrete_name", migratedName),
+					resource.TestCheckResourceAttr("elasticstack_elasticsearch_index.test", "number_of_shards", "2"),
+					func(_ *terraform.State) error {
+						assertIndexPrimaryShards(t, migratedName, "2")
+						assertIndexAliasesExactly(t, migratedName, []string{aliasName})
+						if err := checkIndexDocumentCount(aliasName, 5); err != nil {
+							return err
+						}
+						// The write block added during the migration stays on
+						// the old index only.
+						indexTestDocuments(t, aliasName, 1)
+						return checkIndexDocumentCount(aliasName, 6)
+					},
+					checkIndexDeleted(indexName),
+				),
+			},
+		},
+	})
+}
+
+func indexTestDocuments(t *testing.T, target string, count int) {
+	t.Helper()
+	client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+	if err != nil {
+		t.Fatalf("acceptance elasticsearch client: %v", err)
+	}
+	typedClient := client.GetESClient()
+	for i := range count {
+		_, err := typedClient.Index(target).Document(map[string]any{"title": fmt.Sprintf("doc %d", i)}).Refresh(refresh.True).Do(context.Background())
+		if err != nil {
+			t.Fatalf("index document into %q: %v", target, err)
+		}
+	}
+}
+
+func checkIndexDocumentCount(target string, want int64) error {
+	client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+	if err != nil {
+		return err
+	}
+	got, diags := esclient.CountDocuments(context.Background(), client, target)
+	if diags.HasError() {
+		return fmt.Errorf("count documents in %q: %v", target, diags)
+	}
+	if got != want {
+		return fmt.Errorf("expected %d documents in %q, got %d", want, target, got)
+	}
+	return nil
+}
+
+func checkIndexDeleted(indexName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		exists, err := client.GetESClient().Indices.Exists(indexName).Do(context.Background())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("expected index %q to be deleted after migration", indexName)
+		}
+		return nil
+	}
+}
@@ -51,3 +51,6 @@ var resourceDescription string
 
 //go:embed descriptions/use_existing.md
 var useExistingDescription string
+
+//go:embed descriptions/migration.md
+var migrationDescription string
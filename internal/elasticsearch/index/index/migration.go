@@ -0,0 +1,492 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/asyncutils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/aliasutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	reindexTaskPollInterval = 5 * time.Second
+	settingBlocksWrite      = "index.blocks.write"
+)
+
+var migrationSlicesRe = regexp.MustCompile(`^(auto|[1-9][0-9]*)$`)
+
+type migrationModel struct {
+	Alias          types.String `tfsdk:"alias"`
+	Slices         types.String `tfsdk:"slices"`
+	Pipeline       types.String `tfsdk:"pipeline"`
+	Script         types.Object `tfsdk:"script"`
+	DeleteOldIndex types.Bool   `tfsdk:"delete_old_index"`
+}
+
+type migrationScriptModel struct {
+	Source types.String         `tfsdk:"source"`
+	Lang   types.String         `tfsdk:"lang"`
+	Params jsontypes.Normalized `tfsdk:"params"`
+}
+
+func migrationEnabled(model tfModel) bool {
+	return typeutils.IsKnown(model.Migration)
+}
+
+// nextMigrationIndexName returns the versioned name of the index a migration
+// creates: <name>-v2 for the first migration, then <name>-v3 and so on.
+func nextMigrationIndexName(name, current string) string {
+	re := regexp.MustCompile(`^` + regexp.QuoteMeta(name) + `-v([0-9]+)$`)
+	if m := re.FindStringSubmatch(current); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			return fmt.Sprintf("%s-v%d", name, n+1)
+		}
+	}
+	return fmt.Sprintf("%s-v2", name)
+}
+
+// migrationRequested reports whether an update plan was turned into a
+// migration by modifyPlanForMigration, which plans a new concrete name.
+func migrationRequested(plan, state tfModel) bool {
+	return migrationEnabled(plan) &&
+		typeutils.IsKnown(plan.ConcreteName) &&
+		plan.ConcreteName.ValueString() != state.ConcreteName.ValueString()
+}
+
+// modifyPlanForMigration turns a replacement into an in-place migration when
+// the migration block is configured. Analysis settings cannot be updated in
+// place either, so changes to them also plan a migration.
+func (r *Resource) modifyPlanForMigration(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state tfModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !migrationEnabled(plan) || resp.RequiresReplace.Contains(path.Root(attrName)) {
+		return
+	}
+	if elasticsearch.DateMathIndexNameRe.MatchString(plan.Name.ValueString()) {
+		return
+	}
+
+	analysisDiffers, diags := analysisChanged(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(resp.RequiresReplace) == 0 && !analysisDiffers {
+		return
+	}
+
+	newName := nextMigrationIndexName(plan.Name.ValueString(), state.ConcreteName.ValueString())
+	resp.RequiresReplace = nil
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("concrete_name"), types.StringValue(newName))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.AddWarning(
+		"Index will be migrated",
+		fmt.Sprintf("The planned changes cannot be applied to index %q in place. Apply reindexes it into the new index %q and moves its aliases there.", state.ConcreteName.ValueString(), newName),
+	)
+}
+
+func analysisChanged(ctx context.Context, plan, state tfModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	stateFields := analysisNormalizedFields(state)
+	for name, planValue := range analysisNormalizedFields(plan) {
+		stateValue := stateFields[name]
+		if planValue.IsNull() || stateValue.IsNull() {
+			if planValue.IsNull() != stateValue.IsNull() {
+				return true, diags
+			}
+			continue
+		}
+		if !typeutils.IsKnown(planValue) {
+			return true, diags
+		}
+		equal, eqDiags := planValue.StringSemanticEquals(ctx, stateValue)
+		diags.Append(eqDiags...)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !equal {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// migrateIndex copies the index in state into the new concrete index planned by
+// modifyPlanForMigration and returns the model of the new index. The old index
+// is write-blocked during the copy and otherwise only touched once the copy is
+// verified.
+func (r *Resource) migrateIndex(ctx context.Context, client *clients.ElasticsearchScopedClient, plan, state tfModel, oldName string) (tfModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newName := plan.ConcreteName.ValueString()
+
+	timeout, timeoutDiags := plan.Timeouts.Update(ctx, entitycore.DefaultResourceUpdateTimeout)
+	diags.Append(timeoutDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var migration migrationModel
+	diags.Append(plan.Migration.As(ctx, &migration, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+
+	reindexReq, reqDiags := reindexRequestFromMigration(ctx, migration, oldName, newName)
+	diags.Append(reqDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+
+	apiModel, modelDiags := plan.toAPIModel(ctx)
+	diags.Append(modelDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	plannedAliases := apiModel.Aliases
+	apiModel.Name = newName
+	apiModel.Aliases = nil
+
+	isServerless, isDiags := client.IsServerless(ctx)
+	diags.Append(isDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	params := plan.toPutIndexParams(isServerless)
+
+	oldIndex, getDiags := elasticsearch.GetIndex(ctx, client, oldName)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	if oldIndex == nil {
+		diags.AddError("Index not found", fmt.Sprintf("index %q was not found and cannot be migrated", oldName))
+		return tfModel{}, diags
+	}
+
+	sourceSettings, settingsDiags := elasticsearch.GetIndexFlatSettings(ctx, client, oldName)
+	diags.Append(settingsDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	alreadyBlocked := fmt.Sprint(sourceSettings[settingBlocksWrite]) == "true"
+
+	if _, putDiags := elasticsearch.PutIndex(ctx, client, &apiModel, &params); putDiags.HasError() {
+		diags.Append(putDiags...)
+		return tfModel{}, diags
+	}
+
+	// Writes to the old index are blocked before its documents are counted,
+	// so nothing written during the copy is lost when the aliases move. Until
+	// the aliases are swapped, a failed migration is rolled back by releasing
+	// the block and deleting the new index.
+	rollback := func() diag.Diagnostics {
+		var rollbackDiags diag.Diagnostics
+		rollbackCtx := context.WithoutCancel(ctx)
+		if !alreadyBlocked {
+			rollbackDiags.Append(elasticsearch.UpdateIndexSettings(rollbackCtx, client, oldName, map[string]any{settingBlocksWrite: nil})...)
+		}
+		rollbackDiags.Append(elasticsearch.DeleteIndex(rollbackCtx, client, newName)...)
+		return rollbackDiags
+	}
+
+	if !alreadyBlocked {
+		if _, blockDiags := elasticsearch.AddIndexBlock(ctx, client, oldName, "write"); blockDiags.HasError() {
+			diags.Append(blockDiags...)
+			diags.Append(rollback()...)
+			return tfModel{}, diags
+		}
+	}
+
+	copyDiags := copyIndexDocuments(ctx, client, reindexReq, func(ctx context.Context, taskID string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+		return elasticsearch.GetReindexTask(ctx, client, taskID)
+	})
+	if copyDiags.HasError() {
+		diags.Append(copyDiags...)
+		diags.Append(rollback()...)
+		return tfModel{}, diags
+	}
+
+	actions, aliasDiags := migrationAliasActions(oldName, newName, migration.Alias.ValueString(), oldIndex.Aliases, plannedAliases)
+	diags.Append(aliasDiags...)
+	if diags.HasError() {
+		diags.Append(rollback()...)
+		return tfModel{}, diags
+	}
+	if swapDiags := elasticsearch.UpdateAliasesAtomic(ctx, client, actions); swapDiags.HasError() {
+		diags.Append(swapDiags...)
+		diags.Append(rollback()...)
+		return tfModel{}, diags
+	}
+
+	if migration.DeleteOldIndex.IsNull() || migration.DeleteOldIndex.ValueBool() {
+		if deleteDiags := elasticsearch.DeleteIndex(ctx, client, oldName); deleteDiags.HasError() {
+			diags.AddWarning(
+				"Previous index not deleted",
+				fmt.Sprintf("Index %q was migrated to %q, but deleting %q failed; delete it manually: %v", oldName, newName, oldName, diagutil.FwDiagsAsError(deleteDiags)),
+			)
+		}
+	}
+
+	id, idDiags := client.ID(ctx, newName)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	plan.ID = types.StringValue(id.String())
+
+	finalModel, found, readDiags := readIndex(ctx, client, newName, plan, false)
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return tfModel{}, diags
+	}
+	if !found {
+		diags.AddError("Index not found after migration", fmt.Sprintf("index %q was not found after migration", newName))
+		return tfModel{}, diags
+	}
+	return finalModel, diags
+}
+
+func reindexRequestFromMigration(ctx context.Context, migration migrationModel, source, dest string) (elasticsearch.ReindexRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := elasticsearch.ReindexRequest{
+		Source:   source,
+		Dest:     dest,
+		Pipeline: migration.Pipeline.ValueString(),
+		Slices:   migration.Slices.ValueString(),
+	}
+
+	if !typeutils.IsKnown(migration.Script) {
+		return req, diags
+	}
+
+	var script migrationScriptModel
+	diags.Append(migration.Script.As(ctx, &script, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return req, diags
+	}
+
+	req.Script = map[string]any{"source": script.Source.ValueString()}
+	if typeutils.IsKnown(script.Lang) {
+		req.Script["lang"] = script.Lang.ValueString()
+	}
+	if typeutils.IsKnown(script.Params) {
+		var params map[string]any
+		diags.Append(script.Params.Unmarshal(&params)...)
+		if diags.HasError() {
+			return req, diags
+		}
+		req.Script["params"] = params
+	}
+	return req, diags
+}
+
+// reindexTaskGetter fetches the status of a reindex task for polling.
+type reindexTaskGetter func(ctx context.Context, taskID string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics)
+
+// copyIndexDocuments reindexes req.Source into req.Dest, waits for the task and
+// verifies that every document that was not skipped by the script arrived.
+func copyIndexDocuments(ctx context.Context, client *clients.ElasticsearchScopedClient, req elasticsearch.ReindexRequest, get reindexTaskGetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceCount, countDiags := elasticsearch.CountDocuments(ctx, client, req.Source)
+	diags.Append(countDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	taskID, startDiags := elasticsearch.StartReindex(ctx, client, req)
+	diags.Append(startDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	status, waitDiags := waitForReindexTask(ctx, taskID, get, reindexTaskPollInterval)
+	diags.Append(waitDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	destCount, countDiags := elasticsearch.CountDocuments(ctx, client, req.Dest)
+	diags.Append(countDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(verifyMigratedDocumentCount(req.Source, req.Dest, sourceCount, destCount, status.Noops)...)
+	return diags
+}
+
+// waitForReindexTask polls the task with [asyncutils.WaitForStateTransition]
+// until it completes and turns task failures into diagnostics.
+func waitForReindexTask(ctx context.Context, taskID string, get reindexTaskGetter, pollInterval time.Duration) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+	var (
+		lastStatus *elasticsearch.ReindexTaskStatus
+		getDiags   diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		status, diags := get(ctx, taskID)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errReindexTaskGetFailed
+		}
+		lastStatus = status
+		return status.Completed, nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "reindex_task", taskID, stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	var diags diag.Diagnostics
+	switch {
+	case errors.Is(err, errReindexTaskGetFailed):
+		return nil, getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		diags.AddError(
+			"Reindex did not complete within timeout",
+			fmt.Sprintf("Reindex task %q is still running. It keeps running in Elasticsearch; cancel it with POST /_tasks/%s/_cancel before retrying, or raise the update timeout.", taskID, taskID),
+		)
+		return nil, diags
+	case err != nil:
+		diags.AddError("Reindex wait failed", err.Error())
+		return nil, diags
+	}
+
+	if len(lastStatus.Failures) > 0 {
+		diags.AddError(
+			"Reindex failed",
+			fmt.Sprintf("Reindex task %q completed with failures:\n%s", taskID, strings.Join(lastStatus.Failures, "\n")),
+		)
+		return nil, diags
+	}
+	return lastStatus, diags
+}
+
+// errReindexTaskGetFailed is a sentinel returned by the state checker to bail
+// out of the shared poll loop while preserving the original diagnostics.
+var errReindexTaskGetFailed = errors.New("reindex task get failed")
+
+func verifyMigratedDocumentCount(source, dest string, sourceCount, destCount, noops int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if expected := sourceCount - noops; destCount != expected {
+		diags.AddError(
+			"Document count mismatch after reindex",
+			fmt.Sprintf("Index %q holds %d documents, but %d were expected from %d documents in %q (%d skipped by the script). Check the reindex task for rejected documents.",
+				dest, destCount, expected, sourceCount, source, noops),
+		)
+	}
+	return diags
+}
+
+// migrationAliasActions moves every alias of the old index, and the migration
+// alias, to the new index in a single atomic request. Aliases declared on the
+// resource take their planned definition; others keep their current one.
+func migrationAliasActions(oldName, newName, migrationAlias string, current map[string]estypes.Alias, planned map[string]models.IndexAlias) ([]elasticsearch.AliasAction, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definitions := map[string]models.IndexAlias{migrationAlias: {Name: migrationAlias}}
+	var actions []elasticsearch.AliasAction
+	for name, alias := range current {
+		actions = append(actions, elasticsearch.AliasAction{Type: "remove", Index: oldName, Alias: name})
+
+		definition, aliasDiags := indexAliasFromAPI(name, alias)
+		diags.Append(aliasDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		definitions[name] = definition
+	}
+	for name, alias := range planned {
+		alias.Name = name
+		definitions[name] = alias
+	}
+
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.Slice(actions, func(i, j int) bool { return actions[i].Alias < actions[j].Alias })
+
+	for _, name := range names {
+		alias := definitions[name]
+		actions = append(actions, elasticsearch.AliasAction{
+			Type:          "add",
+			Index:         newName,
+			Alias:         name,
+			IsWriteIndex:  alias.IsWriteIndex,
+			Filter:        alias.Filter,
+			IndexRouting:  alias.IndexRouting,
+			IsHidden:      alias.IsHidden,
+			Routing:       alias.Routing,
+			SearchRouting: alias.SearchRouting,
+		})
+	}
+	return actions, diags
+}
+
+func indexAliasFromAPI(name string, alias estypes.Alias) (models.IndexAlias, diag.Diagnostics) {
+	result := models.IndexAlias{
+		Name:          name,
+		IndexRouting:  typeutils.Deref(alias.IndexRouting),
+		IsHidden:      typeutils.Deref(alias.IsHidden),
+		IsWriteIndex:  typeutils.Deref(alias.IsWriteIndex),
+		Routing:       typeutils.Deref(alias.Routing),
+		SearchRouting: typeutils.Deref(alias.SearchRouting),
+	}
+	if alias.Filter == nil {
+		return result, nil
+	}
+	filter, diags := aliasutil.NormalizeAliasFilterAnyToMap(alias.Filter)
+	result.Filter = filter
+	return result, diags
+}
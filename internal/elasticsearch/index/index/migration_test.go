@@ -0,0 +1,204 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextMigrationIndexName(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		want    string
+	}{
+		{name: "products", current: "products", want: "products-v2"},
+		{name: "products", current: "products-v2", want: "products-v3"},
+		{name: "products", current: "products-v10", want: "products-v11"},
+		{name: "products", current: "products-vx", want: "products-v2"},
+		{name: "a.b", current: "axb-v2", want: "a.b-v2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.current, func(t *testing.T) {
+			require.Equal(t, tt.want, nextMigrationIndexName(tt.name, tt.current))
+		})
+	}
+}
+
+func TestMigrationRequested(t *testing.T) {
+	migration := types.ObjectValueMust(
+		map[string]attr.Type{"alias": types.StringType},
+		map[string]attr.Value{"alias": types.StringValue("products")},
+	)
+	state := tfModel{ConcreteName: types.StringValue("products")}
+
+	require.True(t, migrationRequested(tfModel{Migration: migration, ConcreteName: types.StringValue("products-v2")}, state))
+	require.False(t, migrationRequested(tfModel{Migration: migration, ConcreteName: types.StringValue("products")}, state))
+	require.False(t, migrationRequested(tfModel{Migration: types.ObjectNull(map[string]attr.Type{"alias": types.StringType}), ConcreteName: types.StringValue("products-v2")}, state))
+}
+
+func TestAnalysisChanged(t *testing.T) {
+	ctx := context.Background()
+	analyzer := func(s string) jsontypes.Normalized { return jsontypes.NewNormalizedValue(s) }
+	base := tfModel{
+		AnalysisAnalyzer:   analyzer(`{"a":{"type":"custom","tokenizer":"standard"}}`),
+		AnalysisTokenizer:  jsontypes.NewNormalizedNull(),
+		AnalysisCharFilter: jsontypes.NewNormalizedNull(),
+		AnalysisFilter:     jsontypes.NewNormalizedNull(),
+		AnalysisNormalizer: jsontypes.NewNormalizedNull(),
+	}
+
+	reordered := base
+	reordered.AnalysisAnalyzer = analyzer(`{"a":{"tokenizer":"standard","type":"custom"}}`)
+	changed, diags := analysisChanged(ctx, reordered, base)
+	require.False(t, diags.HasError())
+	require.False(t, changed)
+
+	added := base
+	added.AnalysisFilter = analyzer(`{"f":{"type":"lowercase"}}`)
+	changed, diags = analysisChanged(ctx, added, base)
+	require.False(t, diags.HasError())
+	require.True(t, changed)
+
+	modified := base
+	modified.AnalysisAnalyzer = analyzer(`{"a":{"type":"custom","tokenizer":"whitespace"}}`)
+	changed, diags = analysisChanged(ctx, modified, base)
+	require.False(t, diags.HasError())
+	require.True(t, changed)
+}
+
+func TestVerifyMigratedDocumentCount(t *testing.T) {
+	require.False(t, verifyMigratedDocumentCount("old", "new", 10, 10, 0).HasError())
+	require.False(t, verifyMigratedDocumentCount("old", "new", 10, 8, 2).HasError())
+	require.True(t, verifyMigratedDocumentCount("old", "new", 10, 9, 0).HasError())
+	require.True(t, verifyMigratedDocumentCount("old", "new", 10, 10, 2).HasError())
+}
+
+func TestMigrationAliasActions(t *testing.T) {
+	current := map[string]estypes.Alias{
+		"products":      {IsWriteIndex: new(true)},
+		"products-read": {Routing: new("1")},
+	}
+	planned := map[string]models.IndexAlias{
+		"managed": {Name: "managed", IsHidden: true},
+	}
+
+	actions, diags := migrationAliasActions("products-v1", "products-v2", "products", current, planned)
+	require.False(t, diags.HasError())
+	require.Equal(t, []elasticsearch.AliasAction{
+		{Type: "remove", Index: "products-v1", Alias: "products"},
+		{Type: "remove", Index: "products-v1", Alias: "products-read"},
+		{Type: "add", Index: "products-v2", Alias: "managed", IsHidden: true},
+		{Type: "add", Index: "products-v2", Alias: "products", IsWriteIndex: true},
+		{Type: "add", Index: "products-v2", Alias: "products-read", Routing: "1"},
+	}, actions)
+}
+
+func TestMigrationAliasActions_aliasNotOnOldIndex(t *testing.T) {
+	actions, diags := migrationAliasActions("products-v1", "products-v2", "products", nil, nil)
+	require.False(t, diags.HasError())
+	require.Equal(t, []elasticsearch.AliasAction{
+		{Type: "add", Index: "products-v2", Alias: "products"},
+	}, actions)
+}
+
+func TestWaitForReindexTask(t *testing.T) {
+	t.Run("completes", func(t *testing.T) {
+		calls := 0
+		get := func(_ context.Context, _ string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+			calls++
+			return &elasticsearch.ReindexTaskStatus{Completed: calls > 1, Total: 3, Created: 3}, nil
+		}
+		status, diags := waitForReindexTask(context.Background(), "node:1", get, time.Millisecond)
+		require.False(t, diags.HasError())
+		require.Equal(t, int64(3), status.Created)
+	})
+
+	t.Run("failures", func(t *testing.T) {
+		get := func(_ context.Context, _ string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+			return &elasticsearch.ReindexTaskStatus{Completed: true, Failures: []string{"mapper_parsing_exception"}}, nil
+		}
+		_, diags := waitForReindexTask(context.Background(), "node:1", get, time.Millisecond)
+		require.True(t, diags.HasError())
+		require.Contains(t, diags[0].Detail(), "mapper_parsing_exception")
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		get := func(_ context.Context, _ string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("boom", "task not found")}
+		}
+		_, diags := waitForReindexTask(context.Background(), "node:1", get, time.Millisecond)
+		require.True(t, diags.HasError())
+		require.Equal(t, "boom", diags[0].Summary())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		get := func(_ context.Context, _ string) (*elasticsearch.ReindexTaskStatus, diag.Diagnostics) {
+			return &elasticsearch.ReindexTaskStatus{}, nil
+		}
+		_, diags := waitForReindexTask(ctx, "node:1", get, time.Millisecond)
+		require.True(t, diags.HasError())
+		require.Equal(t, "Reindex did not complete within timeout", diags[0].Summary())
+	})
+}
+
+func TestReindexRequestFromMigration(t *testing.T) {
+	ctx := context.Background()
+	scriptTypes := map[string]attr.Type{
+		"source": types.StringType,
+		"lang":   types.StringType,
+		"params": jsontypes.NormalizedType{},
+	}
+	migration := migrationModel{
+		Alias:    types.StringValue("products"),
+		Slices:   types.StringValue("auto"),
+		Pipeline: types.StringValue("enrich"),
+		Script: types.ObjectValueMust(scriptTypes, map[string]attr.Value{
+			"source": types.StringValue("ctx._source.v = params.v"),
+			"lang":   types.StringNull(),
+			"params": jsontypes.NewNormalizedValue(`{"v":2}`),
+		}),
+		DeleteOldIndex: types.BoolValue(true),
+	}
+
+	req, diags := reindexRequestFromMigration(ctx, migration, "products", "products-v2")
+	require.False(t, diags.HasError())
+	require.Equal(t, elasticsearch.ReindexRequest{
+		Source:   "products",
+		Dest:     "products-v2",
+		Pipeline: "enrich",
+		Slices:   "auto",
+		Script: map[string]any{
+			"source": "ctx._source.v = params.v",
+			"params": map[string]any{"v": float64(2)},
+		},
+	}, req)
+}
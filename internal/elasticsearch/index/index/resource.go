@@ -119,6 +119,14 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 }
 
 func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.modifyPlanForImportHydration(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.modifyPlanForMigration(ctx, req, resp)
+}
+
+func (r *Resource) modifyPlanForImportHydration(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	hydrateAllBytes, diags := req.Private.GetKey(ctx, importHydrationPrivateStateKey)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
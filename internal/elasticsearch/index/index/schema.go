@@ -565,6 +565,58 @@ func getSchema(_ context.Context) schema.Schema {
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"migration": schema.SingleNestedAttribute{
+				Description: migrationDescription,
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"alias": schema.StringAttribute{
+						Description: "Alias that fronts the index. It is moved atomically to the new index once the reindex has completed and document counts match.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"slices": schema.StringAttribute{
+						Description: "Number of slices to parallelize the reindex into, or `auto`. Defaults to `1`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(migrationSlicesRe, "must be `auto` or a positive integer"),
+						},
+					},
+					"pipeline": schema.StringAttribute{
+						Description: "Ingest pipeline to run documents through while reindexing.",
+						Optional:    true,
+					},
+					"script": schema.SingleNestedAttribute{
+						Description: "Script to transform documents while reindexing. Documents for which the script sets `ctx.op` to `noop` are not copied.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"source": schema.StringAttribute{
+								Description: "Script source.",
+								Required:    true,
+							},
+							"lang": schema.StringAttribute{
+								Description: "Script language. Defaults to `painless`.",
+								Optional:    true,
+							},
+							"params": schema.StringAttribute{
+								Description: "JSON object of parameters passed to the script.",
+								Optional:    true,
+								CustomType:  jsontypes.NormalizedType{},
+								Validators: []validator.String{
+									validators.StringIsJSONObject{},
+								},
+							},
+						},
+					},
+					"delete_old_index": schema.BoolAttribute{
+						Description: "Whether to delete the previous index after a successful migration. Defaults to `true`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
 			"wait_for_active_shards": schema.StringAttribute{
 				Description: waitForActiveShardsDescription,
 				Optional:    true,
@@ -0,0 +1,36 @@
+variable "index_name" {
+  type = string
+}
+
+variable "shards" {
+  type = number
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name             = var.index_name
+  number_of_shards = var.shards
+
+  mappings = jsonencode({
+    properties = {
+      title = { type = "text" }
+    }
+  })
+
+  migration = {
+    alias = "${var.index_name}-alias"
+  }
+
+  deletion_protection = false
+}
+
+resource "elasticstack_elasticsearch_index_alias" "test" {
+  name = "${var.index_name}-alias"
+
+  write_index = {
+    name = elasticstack_elasticsearch_index.test.concrete_name
+  }
+}
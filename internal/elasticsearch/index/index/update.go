@@ -58,6 +58,16 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 	concreteName := stateID.ResourceID
 
+	if migrationRequested(planModel, stateModel) {
+		finalModel, migrateDiags := r.migrateIndex(ctx, client, planModel, stateModel, concreteName)
+		resp.Diagnostics.Append(migrateDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, finalModel)...)
+		return
+	}
+
 	// Carry the id and concrete_name forward from state into the plan model so the
 	// post-update read uses the same concrete identity.
 	planModel.ID = stateModel.ID
@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func actionTerraformVersionChecks() []tfversion.TerraformVersionCheck {
+	return []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_14_0),
+	}
+}
+
+func TestAccActionIndexBlock(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("block"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check:                    checkIndexSetting(name, "index.blocks.write", "true"),
+			},
+		},
+	})
+}
+
+func TestAccActionIndexCloseOpen(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("close"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check:                    checkIndexStatus(name, "close"),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("open"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check:                    checkIndexStatus(name, "open"),
+			},
+		},
+	})
+}
+
+func TestAccActionIndexResize(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	// The targets are created by the actions, outside of the Terraform state.
+	t.Cleanup(func() {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			t.Logf("failed to create client: %v", err)
+			return
+		}
+		for _, suffix := range []string{"-shrunk", "-split", "-cloned"} {
+			if diags := esclient.DeleteIndex(context.Background(), client, name+suffix); diags.HasError() {
+				t.Logf("failed to delete index %q: %v", name+suffix, diags)
+			}
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:               func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: actionTerraformVersionChecks(),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("resize"),
+				ConfigVariables:          config.Variables{"name": config.StringVariable(name)},
+				Check: resource.ComposeTestCheckFunc(
+					checkIndexSetting(name, "index.blocks.write", "true"),
+					checkIndexSetting(name+"-shrunk", "index.number_of_shards", "1"),
+					checkIndexSetting(name+"-split", "index.number_of_shards", "4"),
+					checkIndexSetting(name+"-cloned", "index.number_of_shards", "2"),
+					checkIndexSetting(name+"-cloned", "index.blocks.write", "<nil>"),
+				),
+			},
+		},
+	})
+}
+
+// checkIndexSetting checks the flat setting key of index. A missing setting
+// is compared as "<nil>".
+func checkIndexSetting(index, key, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		settings, diags := esclient.GetIndexFlatSettings(context.Background(), client, index)
+		if diags.HasError() {
+			return fmt.Errorf("get settings of %q: %v", index, diags)
+		}
+		if settings == nil {
+			return fmt.Errorf("index %q not found", index)
+		}
+		if got := fmt.Sprint(settings[key]); got != want {
+			return fmt.Errorf("expected index %q setting %s=%s, got %s", index, key, want, got)
+		}
+		return nil
+	}
+}
+
+func checkIndexStatus(index, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		records, err := client.GetESClient().Cat.Indices().Index(index).Do(context.Background())
+		if err != nil {
+			return fmt.Errorf("cat index %q: %w", index, err)
+		}
+		if len(records) != 1 || records[0].Status == nil {
+			return fmt.Errorf("index %q not found", index)
+		}
+		if got := *records[0].Status; got != want {
+			return fmt.Errorf("expected index %q status %q, got %q", index, want, got)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultIndexInvokeTimeout = 5 * time.Minute
+
+var blocks = []string{"metadata", "read", "read_only", "write"}
+
+// BlockModel holds the Terraform configuration for the index block action.
+type BlockModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+
+	Block types.String `tfsdk:"block"`
+}
+
+// NewBlockAction returns the elasticstack_elasticsearch_index_block action.
+func NewBlockAction() action.Action {
+	return entitycore.NewElasticsearchAction[BlockModel]("index_block", entitycore.ElasticsearchActionOptions[BlockModel]{
+		Schema:               getBlockSchema,
+		Invoke:               invokeBlock,
+		DefaultInvokeTimeout: defaultIndexInvokeTimeout,
+	})
+}
+
+func getBlockSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Adds a block to indices with `PUT /{index}/_block/{block}`, for example to stop writes before a migration. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"The block stays in place until the matching `index.blocks.*` setting is reset. See the [add index block API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-add-block).",
+		Attributes: withIndicesAttributes(map[string]actionschema.Attribute{
+			"block": actionschema.StringAttribute{
+				MarkdownDescription: "Block to add: `metadata`, `read`, `read_only` or `write`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(blocks...),
+				},
+			},
+		}),
+	}
+}
+
+func invokeBlock(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[BlockModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := progressReporter(req.SendProgress)
+	block := req.Config.Block.ValueString()
+
+	target, targetDiags := req.Config.target(ctx)
+	diags.Append(targetDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	res, blockDiags := esclient.AddIndexBlock(ctx, client, target, block)
+	diags.Append(blockDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	statuses := res.Indices
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	for _, status := range statuses {
+		if !status.Blocked {
+			diags.AddError(fmt.Sprintf("Failed to add %s block to %s", block, status.Name), "Elasticsearch did not confirm the block.")
+			continue
+		}
+		report(fmt.Sprintf("Added %s block to %s", block, status.Name))
+	}
+	if len(statuses) == 0 {
+		diags.AddWarning("No index to block", fmt.Sprintf("No index matches %q.", target))
+	}
+	return diags
+}
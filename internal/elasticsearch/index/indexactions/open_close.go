@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OpenModel holds the Terraform configuration for the index open action.
+type OpenModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+
+	WaitForActiveShards types.String `tfsdk:"wait_for_active_shards"`
+}
+
+// CloseModel holds the Terraform configuration for the index close action.
+type CloseModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	indicesFields
+
+	WaitForActiveShards types.String `tfsdk:"wait_for_active_shards"`
+}
+
+// NewOpenAction returns the elasticstack_elasticsearch_index_open action.
+func NewOpenAction() action.Action {
+	return entitycore.NewElasticsearchAction[OpenModel]("index_open", entitycore.ElasticsearchActionOptions[OpenModel]{
+		Schema:               getOpenSchema,
+		Invoke:               invokeOpen,
+		DefaultInvokeTimeout: defaultIndexInvokeTimeout,
+	})
+}
+
+// NewCloseAction returns the elasticstack_elasticsearch_index_close action.
+func NewCloseAction() action.Action {
+	return entitycore.NewElasticsearchAction[CloseModel]("index_close", entitycore.ElasticsearchActionOptions[CloseModel]{
+		Schema:               getCloseSchema,
+		Invoke:               invokeClose,
+		DefaultInvokeTimeout: defaultIndexInvokeTimeout,
+	})
+}
+
+func waitForActiveShardsAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		MarkdownDescription: "Number of shard copies that must be active before the action completes: a number, or `all`. Defaults to the `index.write.wait_for_active_shards` setting of each index.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+func getOpenSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Opens closed indices with `POST /{index}/_open`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"See the [open index API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-open).",
+		Attributes: withIndicesAttributes(map[string]actionschema.Attribute{
+			"wait_for_active_shards": waitForActiveShardsAttribute(),
+		}),
+	}
+}
+
+func getCloseSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Closes indices with `POST /{index}/_close`, for example to stop old indices from using cluster resources while keeping their data. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"Closed indices cannot be read or written until they are opened again. See the [close index API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-close).",
+		Attributes: withIndicesAttributes(map[string]actionschema.Attribute{
+			"wait_for_active_shards": waitForActiveShardsAttribute(),
+		}),
+	}
+}
+
+func invokeOpen(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[OpenModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := progressReporter(req.SendProgress)
+
+	target, targetDiags := req.Config.target(ctx)
+	diags.Append(targetDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	res, openDiags := esclient.OpenIndices(ctx, client, target, typeutils.OptionalString(req.Config.WaitForActiveShards))
+	diags.Append(openDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	report(fmt.Sprintf("Opened %s", target))
+	if !res.ShardsAcknowledged {
+		diags.AddWarning(
+			"Shards not started before timeout",
+			fmt.Sprintf("%s is open, but the required shard copies did not start before the timeout. They keep starting in the background.", target),
+		)
+	}
+	return diags
+}
+
+func invokeClose(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[CloseModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := progressReporter(req.SendProgress)
+
+	target, targetDiags := req.Config.target(ctx)
+	diags.Append(targetDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	res, closeDiags := esclient.CloseIndices(ctx, client, target, typeutils.OptionalString(req.Config.WaitForActiveShards))
+	diags.Append(closeDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(res.Indices))
+	for name := range res.Indices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !res.Indices[name].Closed {
+			diags.AddError(fmt.Sprintf("Failed to close %s", name), "At least one shard of the index could not be closed.")
+			continue
+		}
+		report(fmt.Sprintf("Closed %s", name))
+	}
+	if len(names) == 0 {
+		diags.AddWarning("No index to close", fmt.Sprintf("No open index matches %q.", target))
+	}
+	return diags
+}
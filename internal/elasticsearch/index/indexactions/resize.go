@@ -0,0 +1,520 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/cluster/health"
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/healthstatus"
+	"github.com/elastic/terraform-provider-elasticstack/internal/asyncutils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultResizeInvokeTimeout = 30 * time.Minute
+	healthPollInterval         = 5 * time.Second
+
+	settingBlocksWrite    = "index.blocks.write"
+	settingBlocksReadOnly = "index.blocks.read_only"
+	settingRequireName    = "index.routing.allocation.require._name"
+	settingNumberOfShards = "index.number_of_shards"
+)
+
+// resizeFields are the attributes shared by the shrink, split and clone
+// actions.
+type resizeFields struct {
+	Source            types.String         `tfsdk:"source"`
+	Target            types.String         `tfsdk:"target"`
+	Settings          jsontypes.Normalized `tfsdk:"settings"`
+	Aliases           jsontypes.Normalized `tfsdk:"aliases"`
+	AddWriteBlock     types.Bool           `tfsdk:"add_write_block"`
+	WaitForCompletion types.Bool           `tfsdk:"wait_for_completion"`
+}
+
+// ShrinkModel holds the Terraform configuration for the index shrink action.
+type ShrinkModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	resizeFields
+
+	NumberOfShards types.Int64  `tfsdk:"number_of_shards"`
+	ShrinkNode     types.String `tfsdk:"shrink_node"`
+}
+
+// SplitModel holds the Terraform configuration for the index split action.
+type SplitModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	resizeFields
+
+	NumberOfShards types.Int64 `tfsdk:"number_of_shards"`
+}
+
+// CloneModel holds the Terraform configuration for the index clone action.
+type CloneModel struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+	resizeFields
+}
+
+// NewShrinkAction returns the elasticstack_elasticsearch_index_shrink action.
+func NewShrinkAction() action.Action {
+	return entitycore.NewElasticsearchAction[ShrinkModel]("index_shrink", entitycore.ElasticsearchActionOptions[ShrinkModel]{
+		Schema:               getShrinkSchema,
+		Invoke:               invokeShrink,
+		DefaultInvokeTimeout: defaultResizeInvokeTimeout,
+	})
+}
+
+// NewSplitAction returns the elasticstack_elasticsearch_index_split action.
+func NewSplitAction() action.Action {
+	return entitycore.NewElasticsearchAction[SplitModel]("index_split", entitycore.ElasticsearchActionOptions[SplitModel]{
+		Schema:               getSplitSchema,
+		Invoke:               invokeSplit,
+		DefaultInvokeTimeout: defaultResizeInvokeTimeout,
+	})
+}
+
+// NewCloneAction returns the elasticstack_elasticsearch_index_clone action.
+func NewCloneAction() action.Action {
+	return entitycore.NewElasticsearchAction[CloneModel]("index_clone", entitycore.ElasticsearchActionOptions[CloneModel]{
+		Schema:               getCloneSchema,
+		Invoke:               invokeClone,
+		DefaultInvokeTimeout: defaultResizeInvokeTimeout,
+	})
+}
+
+// withResizeAttributes returns attrs extended with the shared resize
+// attributes.
+func withResizeAttributes(attrs map[string]actionschema.Attribute) map[string]actionschema.Attribute {
+	result := map[string]actionschema.Attribute{
+		"source": actionschema.StringAttribute{
+			MarkdownDescription: "Name of the index to resize.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"target": actionschema.StringAttribute{
+			MarkdownDescription: "Name of the index to create. It must not exist.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"settings": actionschema.StringAttribute{
+			MarkdownDescription: "JSON-encoded settings of the target index. The target inherits the other settings of the source. The write block and, with `shrink_node`, the shard allocation requirement of the source are reset on the target unless set here.",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"aliases": actionschema.StringAttribute{
+			MarkdownDescription: "JSON-encoded aliases of the target index, keyed by alias name.",
+			Optional:            true,
+			CustomType:          jsontypes.NormalizedType{},
+		},
+		"add_write_block": actionschema.BoolAttribute{
+			MarkdownDescription: "When `true`, adds a write block to the source index if it is not read-only yet, as required by the resize APIs. When `false`, the action fails on a writable source. The block stays on the source afterwards. Defaults to `true`.",
+			Optional:            true,
+		},
+		"wait_for_completion": actionschema.BoolAttribute{
+			MarkdownDescription: "When `true`, polls the cluster health until the target index is `green` or the invoke timeout elapses. Defaults to `true`.",
+			Optional:            true,
+		},
+	}
+	maps.Copy(result, attrs)
+	return result
+}
+
+func getShrinkSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Shrinks an index into a new index with fewer primary shards with `POST /{index}/_shrink/{target}`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"The source must be read-only and a copy of every shard must reside on the same node; set `shrink_node` to relocate the shards first. " +
+			"See the [shrink index API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-shrink).",
+		Attributes: withResizeAttributes(map[string]actionschema.Attribute{
+			"number_of_shards": actionschema.Int64Attribute{
+				MarkdownDescription: "Number of primary shards of the target index. It must be a factor of the number of shards of the source. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"shrink_node": actionschema.StringAttribute{
+				MarkdownDescription: "Name of the node to relocate a copy of every shard of the source to before shrinking, through the `index.routing.allocation.require._name` setting. The action polls the shards of the source until each has a started copy on this node.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func getSplitSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Splits an index into a new index with more primary shards with `POST /{index}/_split/{target}`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"The source must be read-only. See the [split index API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-split).",
+		Attributes: withResizeAttributes(map[string]actionschema.Attribute{
+			"number_of_shards": actionschema.Int64Attribute{
+				MarkdownDescription: "Number of primary shards of the target index. It must be a multiple of the number of shards of the source.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(2),
+				},
+			},
+		}),
+	}
+}
+
+func getCloneSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Clones an index into a new index with the same number of primary shards with `POST /{index}/_clone/{target}`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"The source must be read-only. See the [clone index API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-clone).",
+		Attributes: withResizeAttributes(nil),
+	}
+}
+
+func invokeShrink(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[ShrinkModel]) diag.Diagnostics {
+	return runResize(ctx, client, req.Config.resizeFields, resizeOperation{
+		operation:      esclient.ResizeShrink,
+		done:           "Shrank",
+		numberOfShards: req.Config.NumberOfShards,
+		shrinkNode:     req.Config.ShrinkNode,
+	}, req.SendProgress)
+}
+
+func invokeSplit(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[SplitModel]) diag.Diagnostics {
+	return runResize(ctx, client, req.Config.resizeFields, resizeOperation{
+		operation:      esclient.ResizeSplit,
+		done:           "Split",
+		numberOfShards: req.Config.NumberOfShards,
+		shrinkNode:     types.StringNull(),
+	}, req.SendProgress)
+}
+
+func invokeClone(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[CloneModel]) diag.Diagnostics {
+	return runResize(ctx, client, req.Config.resizeFields, resizeOperation{
+		operation:      esclient.ResizeClone,
+		done:           "Cloned",
+		numberOfShards: types.Int64Null(),
+		shrinkNode:     types.StringNull(),
+	}, req.SendProgress)
+}
+
+// resizeOperation holds what differs between the shrink, split and clone
+// actions.
+type resizeOperation struct {
+	operation esclient.ResizeOperation
+	// done is the operation in progress messages, such as "Shrank".
+	done           string
+	numberOfShards types.Int64
+	shrinkNode     types.String
+}
+
+// runResize prepares the source index, resizes it into the target index and
+// optionally waits for the target to become green.
+func runResize(ctx context.Context, client *clients.ElasticsearchScopedClient, f resizeFields, op resizeOperation, sendProgress func(action.InvokeProgressEvent)) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := progressReporter(sendProgress)
+	source := f.Source.ValueString()
+	target := f.Target.ValueString()
+
+	settings, settingsDiags := esclient.GetIndexFlatSettings(ctx, client, source)
+	diags.Append(settingsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if settings == nil {
+		diags.AddError("Source index not found", fmt.Sprintf("Index %q does not exist.", source))
+		return diags
+	}
+
+	if !isReadOnly(settings) {
+		if typeutils.IsKnown(f.AddWriteBlock) && !f.AddWriteBlock.ValueBool() {
+			diags.AddError(
+				"Source index is not read-only",
+				fmt.Sprintf("Index %q must have a write block before it can be resized. Set add_write_block to true, or add the block with the elasticstack_elasticsearch_index_block action.", source),
+			)
+			return diags
+		}
+		_, blockDiags := esclient.AddIndexBlock(ctx, client, source, "write")
+		diags.Append(blockDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		report(fmt.Sprintf("Added write block to %s", source))
+	}
+
+	relocated := typeutils.IsKnown(op.shrinkNode)
+	if relocated {
+		node := op.shrinkNode.ValueString()
+		diags.Append(esclient.UpdateIndexSettings(ctx, client, source, map[string]any{settingRequireName: node})...)
+		if diags.HasError() {
+			return diags
+		}
+		report(fmt.Sprintf("Relocating the shards of %s to node %s", source, node))
+
+		diags.Append(waitForShardsOnNode(ctx, source, node, indexShardsGetter(client, source), healthPollInterval)...)
+		if diags.HasError() {
+			return diags
+		}
+		report(fmt.Sprintf("Relocated the shards of %s", source))
+	}
+
+	body, bodyDiags := resizeRequest(f, op.numberOfShards, relocated)
+	diags.Append(bodyDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(esclient.ResizeIndex(ctx, client, op.operation, source, target, body)...)
+	if diags.HasError() {
+		return diags
+	}
+	report(fmt.Sprintf("%s %s into %s", op.done, source, target))
+
+	if !typeutils.IsKnown(f.WaitForCompletion) || f.WaitForCompletion.ValueBool() {
+		diags.Append(waitForHealth(ctx, target, "green", isGreen, indexHealthGetter(client, target), healthPollInterval)...)
+		if diags.HasError() {
+			return diags
+		}
+		report(fmt.Sprintf("%s is green", target))
+	}
+	return diags
+}
+
+// isReadOnly reports whether the flat settings of an index block writes.
+func isReadOnly(settings map[string]any) bool {
+	for _, key := range []string{settingBlocksWrite, settingBlocksReadOnly} {
+		if fmt.Sprint(settings[key]) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// resizeRequest builds the resize body. The target inherits the settings of
+// the source, so the write block and the allocation requirement used to
+// prepare it are reset unless configured explicitly.
+func resizeRequest(f resizeFields, numberOfShards types.Int64, relocated bool) (*esclient.ResizeIndexRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := map[string]any{}
+
+	if typeutils.IsKnown(f.Settings) && f.Settings.ValueString() != "" {
+		var configured map[string]any
+		diags.Append(f.Settings.Unmarshal(&configured)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		settings = typeutils.FlattenMap(configured)
+	}
+
+	if typeutils.IsKnown(numberOfShards) {
+		settings[settingNumberOfShards] = numberOfShards.ValueInt64()
+	}
+	if _, ok := settings[settingBlocksWrite]; !ok {
+		settings[settingBlocksWrite] = nil
+	}
+	if _, ok := settings[settingRequireName]; relocated && !ok {
+		settings[settingRequireName] = nil
+	}
+
+	body := &esclient.ResizeIndexRequest{Settings: settings}
+	if typeutils.IsKnown(f.Aliases) && f.Aliases.ValueString() != "" {
+		body.Aliases = json.RawMessage(f.Aliases.ValueString())
+	}
+	return body, diags
+}
+
+// healthGetter fetches the health of an index for polling.
+type healthGetter func(ctx context.Context) (*health.Response, diag.Diagnostics)
+
+func indexHealthGetter(client *clients.ElasticsearchScopedClient, index string) healthGetter {
+	return func(ctx context.Context) (*health.Response, diag.Diagnostics) {
+		return esclient.GetIndexHealth(ctx, client, index)
+	}
+}
+
+func isGreen(res *health.Response) bool {
+	return res.Status == healthstatus.Green
+}
+
+// waitForHealth polls the health of index with
+// [asyncutils.WaitForStateTransition] until done returns true. state names
+// the awaited condition in diagnostics.
+func waitForHealth(ctx context.Context, index, state string, done func(*health.Response) bool, get healthGetter, pollInterval time.Duration) diag.Diagnostics {
+	var (
+		last     *health.Response
+		getDiags diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		res, diags := get(ctx)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errHealthGetFailed
+		}
+		last = res
+		return done(res), nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "index_health", state, stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	var diags diag.Diagnostics
+	switch {
+	case err == nil:
+		return diags
+	case errors.Is(err, errHealthGetFailed):
+		return getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		detail := "The index health was never observed."
+		if last != nil {
+			detail = fmt.Sprintf("The last observed health is %q, with %d relocating, %d initializing and %d unassigned shards.",
+				last.Status.String(), last.RelocatingShards, last.InitializingShards, last.UnassignedShards)
+		}
+		diags.AddError(
+			fmt.Sprintf("Index %s was not %s within timeout", index, state),
+			detail+" Increase the invoke timeout to wait longer.",
+		)
+		return diags
+	default:
+		diags.AddError("Index health wait failed", err.Error())
+		return diags
+	}
+}
+
+// errHealthGetFailed is a sentinel returned by the state checker to bail out
+// of the shared poll loop while preserving the original diagnostics.
+var errHealthGetFailed = errors.New("index health get failed")
+
+// shardsGetter fetches the shard copies of an index for polling.
+type shardsGetter func(ctx context.Context) ([]estypes.ShardsRecord, diag.Diagnostics)
+
+func indexShardsGetter(client *clients.ElasticsearchScopedClient, index string) shardsGetter {
+	return func(ctx context.Context) ([]estypes.ShardsRecord, diag.Diagnostics) {
+		return esclient.GetIndexShards(ctx, client, index)
+	}
+}
+
+// shardsMissingFromNode returns the shards, sorted by number, that have no
+// STARTED copy on node. The shrink API needs a copy of every shard there.
+func shardsMissingFromNode(records []estypes.ShardsRecord, node string) []string {
+	onNode := map[string]bool{}
+	for _, record := range records {
+		shard := derefString(record.Shard)
+		onNode[shard] = onNode[shard] || (derefString(record.State) == "STARTED" && derefString(record.Node) == node)
+	}
+
+	var missing []string
+	for shard, ok := range onNode {
+		if !ok {
+			missing = append(missing, shard)
+		}
+	}
+	slices.SortFunc(missing, func(a, b string) int {
+		ai, aErr := strconv.Atoi(a)
+		bi, bErr := strconv.Atoi(b)
+		if aErr != nil || bErr != nil {
+			return strings.Compare(a, b)
+		}
+		return ai - bi
+	})
+	return missing
+}
+
+// waitForShardsOnNode polls the shard copies of index with
+// [asyncutils.WaitForStateTransition] until every shard has a STARTED copy on
+// node.
+func waitForShardsOnNode(ctx context.Context, index, node string, get shardsGetter, pollInterval time.Duration) diag.Diagnostics {
+	var (
+		missing  []string
+		observed bool
+		getDiags diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		records, diags := get(ctx)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errShardsGetFailed
+		}
+		observed = len(records) > 0
+		missing = shardsMissingFromNode(records, node)
+		return observed && len(missing) == 0, nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "index_shards", "relocated", stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	var diags diag.Diagnostics
+	switch {
+	case err == nil:
+		return diags
+	case errors.Is(err, errShardsGetFailed):
+		return getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		detail := "The shards of the index were never observed."
+		if observed {
+			detail = fmt.Sprintf("Shards without a started copy on node %s: %s.", node, strings.Join(missing, ", "))
+		}
+		diags.AddError(
+			fmt.Sprintf("Index %s was not relocated within timeout", index),
+			detail+" Check that the node exists and has enough disk space, or increase the invoke timeout to wait longer.",
+		)
+		return diags
+	default:
+		diags.AddError("Index shard wait failed", err.Error())
+		return diags
+	}
+}
+
+// errShardsGetFailed is a sentinel returned by the state checker to bail out
+// of the shared poll loop while preserving the original diagnostics.
+var errShardsGetFailed = errors.New("index shards get failed")
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
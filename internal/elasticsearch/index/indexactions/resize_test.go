@@ -0,0 +1,212 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/cluster/health"
+	estypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/healthstatus"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReadOnly(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isReadOnly(map[string]any{}))
+	assert.False(t, isReadOnly(map[string]any{settingBlocksWrite: "false"}))
+	assert.True(t, isReadOnly(map[string]any{settingBlocksWrite: "true"}))
+	assert.True(t, isReadOnly(map[string]any{settingBlocksReadOnly: "true"}))
+}
+
+func TestResizeRequest(t *testing.T) {
+	t.Parallel()
+
+	fields := func(settings, aliases string) resizeFields {
+		f := resizeFields{Settings: jsontypes.NewNormalizedNull(), Aliases: jsontypes.NewNormalizedNull()}
+		if settings != "" {
+			f.Settings = jsontypes.NewNormalizedValue(settings)
+		}
+		if aliases != "" {
+			f.Aliases = jsontypes.NewNormalizedValue(aliases)
+		}
+		return f
+	}
+
+	t.Run("resets the write block", func(t *testing.T) {
+		t.Parallel()
+		body, diags := resizeRequest(fields("", ""), types.Int64Null(), false)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+		assert.Equal(t, map[string]any{settingBlocksWrite: nil}, body.Settings)
+		assert.Nil(t, body.Aliases)
+	})
+
+	t.Run("resets the allocation requirement after relocation", func(t *testing.T) {
+		t.Parallel()
+		body, diags := resizeRequest(fields("", ""), types.Int64Value(1), true)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+		assert.Equal(t, map[string]any{
+			settingBlocksWrite:    nil,
+			settingRequireName:    nil,
+			settingNumberOfShards: int64(1),
+		}, body.Settings)
+	})
+
+	t.Run("keeps configured settings", func(t *testing.T) {
+		t.Parallel()
+		body, diags := resizeRequest(
+			fields(`{"index": {"number_of_replicas": 0, "blocks": {"write": true}}}`, `{"logs": {"is_write_index": false}}`),
+			types.Int64Value(2),
+			false,
+		)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+		assert.Equal(t, map[string]any{
+			"index.number_of_replicas": float64(0),
+			settingBlocksWrite:         true,
+			settingNumberOfShards:      int64(2),
+		}, body.Settings)
+		assert.JSONEq(t, `{"logs": {"is_write_index": false}}`, string(body.Aliases))
+	})
+}
+
+func TestWaitForHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("becomes green", func(t *testing.T) {
+		t.Parallel()
+		statuses := []healthstatus.HealthStatus{healthstatus.Red, healthstatus.Yellow, healthstatus.Green}
+		get := func(context.Context) (*health.Response, diag.Diagnostics) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return &health.Response{Status: status}, nil
+		}
+
+		diags := waitForHealth(context.Background(), "a", "green", isGreen, get, time.Millisecond)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		t.Parallel()
+		get := func(context.Context) (*health.Response, diag.Diagnostics) {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("boom", "security_exception")}
+		}
+
+		diags := waitForHealth(context.Background(), "a", "green", isGreen, get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "boom", diags[0].Summary())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		get := func(context.Context) (*health.Response, diag.Diagnostics) {
+			return &health.Response{Status: healthstatus.Yellow, RelocatingShards: 1}, nil
+		}
+
+		diags := waitForHealth(ctx, "a", "green", isGreen, get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Index a was not green within timeout", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), `"yellow", with 1 relocating`)
+	})
+}
+
+func shardRecord(shard, prirep, state, node string) estypes.ShardsRecord {
+	return estypes.ShardsRecord{Shard: &shard, Prirep: &prirep, State: &state, Node: &node}
+}
+
+func TestShardsMissingFromNode(t *testing.T) {
+	t.Parallel()
+
+	records := []estypes.ShardsRecord{
+		shardRecord("0", "p", "STARTED", "node-1"),
+		shardRecord("0", "r", "STARTED", "node-2"),
+		shardRecord("1", "p", "STARTED", "node-2"),
+		shardRecord("1", "r", "INITIALIZING", "node-1"),
+		shardRecord("10", "p", "RELOCATING", "node-2"),
+		shardRecord("2", "r", "STARTED", "node-1"),
+	}
+
+	assert.Equal(t, []string{"1", "10"}, shardsMissingFromNode(records, "node-1"))
+	assert.Equal(t, []string{"2"}, shardsMissingFromNode(records, "node-2"))
+	assert.Empty(t, shardsMissingFromNode(records[:1], "node-1"))
+}
+
+func TestWaitForShardsOnNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("waits for every shard to start on the node", func(t *testing.T) {
+		t.Parallel()
+		polls := [][]estypes.ShardsRecord{
+			nil,
+			{shardRecord("0", "p", "STARTED", "node-2"), shardRecord("1", "p", "STARTED", "node-1")},
+			{shardRecord("0", "p", "RELOCATING", "node-2"), shardRecord("1", "p", "STARTED", "node-1")},
+			{shardRecord("0", "p", "STARTED", "node-1"), shardRecord("1", "p", "STARTED", "node-1")},
+		}
+		calls := 0
+		get := func(context.Context) ([]estypes.ShardsRecord, diag.Diagnostics) {
+			records := polls[min(calls, len(polls)-1)]
+			calls++
+			return records, nil
+		}
+
+		diags := waitForShardsOnNode(context.Background(), "a", "node-1", get, time.Millisecond)
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Equal(t, len(polls), calls)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		t.Parallel()
+		get := func(context.Context) ([]estypes.ShardsRecord, diag.Diagnostics) {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic("boom", "security_exception")}
+		}
+
+		diags := waitForShardsOnNode(context.Background(), "a", "node-1", get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "boom", diags[0].Summary())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		get := func(context.Context) ([]estypes.ShardsRecord, diag.Diagnostics) {
+			return []estypes.ShardsRecord{
+				shardRecord("0", "p", "STARTED", "node-1"),
+				shardRecord("1", "p", "STARTED", "node-2"),
+			}, nil
+		}
+
+		diags := waitForShardsOnNode(ctx, "a", "node-1", get, time.Millisecond)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Index a was not relocated within timeout", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "Shards without a started copy on node node-1: 1.")
+	})
+}
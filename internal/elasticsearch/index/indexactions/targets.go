@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexactions
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// indicesFields is the target shared by the block, open and close actions.
+type indicesFields struct {
+	Indices types.List `tfsdk:"indices"`
+}
+
+// withIndicesAttributes returns attrs extended with the shared indices
+// attribute.
+func withIndicesAttributes(attrs map[string]actionschema.Attribute) map[string]actionschema.Attribute {
+	result := map[string]actionschema.Attribute{
+		"indices": actionschema.ListAttribute{
+			MarkdownDescription: "Names or wildcard patterns of the indices to act on.",
+			ElementType:         types.StringType,
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		},
+	}
+	maps.Copy(result, attrs)
+	return result
+}
+
+// target returns the configured indices as a comma-separated API path
+// parameter.
+func (f indicesFields) target(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patterns := typeutils.ListTypeToSliceString(ctx, f.Indices, path.Root("indices"), &diags)
+	if diags.HasError() {
+		return "", diags
+	}
+	return strings.Join(patterns, ","), diags
+}
+
+// progressReporter sends message as a progress event when the invoke request
+// accepts them.
+func progressReporter(sendProgress func(action.InvokeProgressEvent)) func(string) {
+	return func(message string) {
+		if sendProgress != nil {
+			sendProgress(action.InvokeProgressEvent{Message: message})
+		}
+	}
+}
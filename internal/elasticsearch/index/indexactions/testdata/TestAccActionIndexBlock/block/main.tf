@@ -0,0 +1,32 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = var.name
+  deletion_protection = false
+}
+
+action "elasticstack_elasticsearch_index_block" "write" {
+  config {
+    indices = [elasticstack_elasticsearch_index.test.name]
+    block   = "write"
+  }
+}
+
+resource "terraform_data" "trigger_block" {
+  depends_on = [
+    elasticstack_elasticsearch_index.test,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_index_block.write]
+    }
+  }
+}
@@ -0,0 +1,32 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = var.name
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+action "elasticstack_elasticsearch_index_close" "close" {
+  config {
+    indices = [elasticstack_elasticsearch_index.test.name]
+  }
+}
+
+resource "terraform_data" "trigger_close" {
+  depends_on = [
+    elasticstack_elasticsearch_index.test,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_index_close.close]
+    }
+  }
+}
@@ -0,0 +1,39 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "test" {
+  name                = var.name
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+resource "terraform_data" "trigger_close" {
+  depends_on = [
+    elasticstack_elasticsearch_index.test,
+  ]
+}
+
+action "elasticstack_elasticsearch_index_open" "open" {
+  config {
+    indices                = [elasticstack_elasticsearch_index.test.name]
+    wait_for_active_shards = "all"
+  }
+}
+
+resource "terraform_data" "trigger_open" {
+  depends_on = [
+    elasticstack_elasticsearch_index.test,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_index_open.open]
+    }
+  }
+}
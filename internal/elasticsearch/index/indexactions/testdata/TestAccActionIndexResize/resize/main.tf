@@ -0,0 +1,66 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name                = var.name
+  number_of_shards    = 2
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+action "elasticstack_elasticsearch_index_shrink" "shrink" {
+  config {
+    source           = elasticstack_elasticsearch_index.source.name
+    target           = "${var.name}-shrunk"
+    number_of_shards = 1
+    settings = jsonencode({
+      "index.number_of_replicas" = 0
+    })
+  }
+}
+
+action "elasticstack_elasticsearch_index_split" "split" {
+  config {
+    source           = elasticstack_elasticsearch_index.source.name
+    target           = "${var.name}-split"
+    number_of_shards = 4
+    settings = jsonencode({
+      "index.number_of_replicas" = 0
+    })
+  }
+}
+
+action "elasticstack_elasticsearch_index_clone" "clone" {
+  config {
+    source = elasticstack_elasticsearch_index.source.name
+    target = "${var.name}-cloned"
+    settings = jsonencode({
+      "index.number_of_replicas" = 0
+    })
+    aliases = jsonencode({
+      "${var.name}-alias" = {}
+    })
+  }
+}
+
+resource "terraform_data" "trigger_resize" {
+  depends_on = [
+    elasticstack_elasticsearch_index.source,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [
+        action.elasticstack_elasticsearch_index_shrink.shrink,
+        action.elasticstack_elasticsearch_index_split.split,
+        action.elasticstack_elasticsearch_index_clone.clone,
+      ]
+    }
+  }
+}
@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/rollover"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	esclient "github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const defaultInvokeTimeout = 5 * time.Minute
+
+// NewAction returns a constructor for the rollover action. The Configure,
+// Metadata, Schema, and Invoke prelude are owned by the [entitycore] action
+// envelope; this package supplies only the schema body and the invoke callback.
+func NewAction() action.Action {
+	return entitycore.NewElasticsearchAction[Model]("rollover", entitycore.ElasticsearchActionOptions[Model]{
+		Schema:               GetSchema,
+		Invoke:               invokeRollover,
+		DefaultInvokeTimeout: defaultInvokeTimeout,
+	})
+}
+
+var _ entitycore.WithVersionRequirements = Model{}
+
+// GetVersionRequirements satisfies [entitycore.WithVersionRequirements].
+func (m Model) GetVersionRequirements(_ context.Context) ([]entitycore.VersionRequirement, fwdiag.Diagnostics) {
+	if !m.Lazy.ValueBool() {
+		return nil, nil
+	}
+	return []entitycore.VersionRequirement{
+		entitycore.NewAttributeVersionRequirement(path.Root("lazy"), *esclient.MinVersionLazyRollover, "Lazy rollover requires Elasticsearch v8.13.0 or above."),
+	}, nil
+}
+
+// invokeRollover is the entity-specific work for elasticstack_elasticsearch_rollover.
+// The envelope has already decoded req.Config, resolved client, and applied
+// the invoke timeout to ctx.
+func invokeRollover(ctx context.Context, client *clients.ElasticsearchScopedClient, req entitycore.ActionRequest[Model]) fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+	model := req.Config
+
+	res, rolloverDiags := esclient.Rollover(ctx, client, model.Target.ValueString(), rolloverRequestFromModel(model))
+	diags.Append(rolloverDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if req.SendProgress != nil {
+		req.SendProgress(action.InvokeProgressEvent{Message: rolloverProgressMessage(model.Target.ValueString(), res)})
+	}
+	return diags
+}
+
+func rolloverRequestFromModel(model Model) *esclient.RolloverRequest {
+	return &esclient.RolloverRequest{
+		MaxAge:              model.MaxAge.ValueStringPointer(),
+		MaxDocs:             model.MaxDocs.ValueInt64Pointer(),
+		MaxPrimaryShardSize: model.MaxPrimaryShardSize.ValueStringPointer(),
+		Lazy:                model.Lazy.ValueBool(),
+		DryRun:              model.DryRun.ValueBool(),
+	}
+}
+
+func rolloverProgressMessage(target string, res *rollover.Response) string {
+	switch {
+	case res.DryRun && conditionsMet(res.Conditions):
+		return fmt.Sprintf("Dry run: %s would roll over from %s to %s", target, res.OldIndex, res.NewIndex)
+	case res.DryRun:
+		return fmt.Sprintf("Dry run: %s would not roll over, no condition is met", target)
+	case res.RolledOver:
+		return fmt.Sprintf("Rolled over %s from %s to %s", target, res.OldIndex, res.NewIndex)
+	case conditionsMet(res.Conditions):
+		return fmt.Sprintf("%s will roll over on the next write", target)
+	default:
+		return fmt.Sprintf("%s was not rolled over, no condition is met", target)
+	}
+}
+
+// conditionsMet mirrors the rollover API: without conditions the rollover is
+// unconditional, otherwise any single met condition is enough.
+func conditionsMet(conditions map[string]bool) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+	for _, met := range conditions {
+		if met {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollover
+
+import (
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/rollover"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloverRequestFromModel(t *testing.T) {
+	req := rolloverRequestFromModel(Model{
+		Target:              types.StringValue("logs-app-default"),
+		MaxAge:              types.StringValue("7d"),
+		MaxDocs:             types.Int64Value(1000),
+		MaxPrimaryShardSize: types.StringNull(),
+		Lazy:                types.BoolNull(),
+		DryRun:              types.BoolValue(true),
+	})
+
+	assert.Equal(t, "7d", *req.MaxAge)
+	assert.Equal(t, int64(1000), *req.MaxDocs)
+	assert.Nil(t, req.MaxPrimaryShardSize)
+	assert.False(t, req.Lazy)
+	assert.True(t, req.DryRun)
+}
+
+func TestRolloverProgressMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		res  rollover.Response
+		want string
+	}{
+		{
+			name: "rolled over",
+			res:  rollover.Response{RolledOver: true, OldIndex: "a-1", NewIndex: "a-2"},
+			want: "Rolled over a from a-1 to a-2",
+		},
+		{
+			name: "conditions not met",
+			res:  rollover.Response{Conditions: map[string]bool{"[max_docs: 10]": false}},
+			want: "a was not rolled over, no condition is met",
+		},
+		{
+			name: "lazy",
+			res:  rollover.Response{},
+			want: "a will roll over on the next write",
+		},
+		{
+			name: "dry run met",
+			res:  rollover.Response{DryRun: true, Conditions: map[string]bool{"[max_docs: 10]": true}, OldIndex: "a-1", NewIndex: "a-2"},
+			want: "Dry run: a would roll over from a-1 to a-2",
+		},
+		{
+			name: "dry run not met",
+			res:  rollover.Response{DryRun: true, Conditions: map[string]bool{"[max_docs: 10]": false}},
+			want: "Dry run: a would not roll over, no condition is met",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rolloverProgressMessage("a", &tt.res))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollover
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model holds the Terraform configuration for the rollover action. The
+// elasticsearch_connection and timeouts blocks are provided by the embedded
+// envelope fields and injected into the schema by [entitycore.NewElasticsearchAction].
+type Model struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ActionTimeoutsField
+
+	Target              types.String `tfsdk:"target"`
+	MaxAge              types.String `tfsdk:"max_age"`
+	MaxDocs             types.Int64  `tfsdk:"max_docs"`
+	MaxPrimaryShardSize types.String `tfsdk:"max_primary_shard_size"`
+	Lazy                types.Bool   `tfsdk:"lazy"`
+	DryRun              types.Bool   `tfsdk:"dry_run"`
+}
@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rollover
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const schemaMarkdownDescription = `Rolls a data stream or index alias over to a new write index. **Requires Terraform 1.14+** (provider-defined actions).
+
+Invokes ` + "`POST /{target}/_rollover`" + `. When conditions are set the rollover only happens if at least one of them is met. See the [rollover API documentation](https://www.elastic.co/docs/api/doc/elasticsearch/operation/operation-indices-rollover).`
+
+// GetSchema returns the action schema for rollover. The
+// elasticsearch_connection and timeouts blocks are added by
+// [entitycore.NewElasticsearchAction] and MUST NOT be declared here.
+func GetSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: schemaMarkdownDescription,
+		Attributes: map[string]actionschema.Attribute{
+			"target": actionschema.StringAttribute{
+				MarkdownDescription: "Name of the data stream or index alias to roll over.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_age": actionschema.StringAttribute{
+				MarkdownDescription: "Rolls over when the write index reaches this age since creation, for example `7d`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_docs": actionschema.Int64Attribute{
+				MarkdownDescription: "Rolls over when the write index holds at least this many documents.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_primary_shard_size": actionschema.StringAttribute{
+				MarkdownDescription: "Rolls over when the largest primary shard of the write index reaches this size, for example `50gb`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"lazy": actionschema.BoolAttribute{
+				MarkdownDescription: "When `true`, marks the data stream to roll over on the next write instead of immediately. Only supported for data streams, requires Elasticsearch 8.13.0+ and cannot be combined with conditions.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(
+						path.MatchRoot("max_age"),
+						path.MatchRoot("max_docs"),
+						path.MatchRoot("max_primary_shard_size"),
+						path.MatchRoot("dry_run"),
+					),
+				},
+			},
+			"dry_run": actionschema.BoolAttribute{
+				MarkdownDescription: "When `true`, checks the conditions without rolling over.",
+				Optional:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_index_template" "template" {
+  name = var.name
+
+  index_patterns = ["${var.name}*"]
+
+  data_stream {}
+}
+
+resource "elasticstack_elasticsearch_data_stream" "ds" {
+  name = var.name
+
+  depends_on = [
+    elasticstack_elasticsearch_index_template.template
+  ]
+}
+
+action "elasticstack_elasticsearch_rollover" "rollover" {
+  config {
+    target = elasticstack_elasticsearch_data_stream.ds.name
+  }
+}
+
+resource "terraform_data" "trigger_rollover" {
+  depends_on = [
+    elasticstack_elasticsearch_data_stream.ds,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_rollover.rollover]
+    }
+  }
+}
@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package templatesimulate_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexTemplateSimulateDataSource(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					// The component template settings and mappings are merged into
+					// the index template.
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "settings", regexp.MustCompile(`"number_of_shards":"2"`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "mappings", regexp.MustCompile(`"host":\{"type":"keyword"\}`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "mappings", regexp.MustCompile(`"message":\{"type":"text"\}`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "aliases", regexp.MustCompile(name+"-alias")),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "overlapping.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_index", "overlapping.0.name", name+"-low"),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.by_name", "settings", regexp.MustCompile(`"number_of_shards":"2"`)),
+					// The inline definition overrides the number of shards of the
+					// component template.
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.inline", "settings", regexp.MustCompile(`"number_of_shards":"3"`)),
+					resource.TestMatchResourceAttr("data.elasticstack_elasticsearch_index_template_simulate.inline", "mappings", regexp.MustCompile(`"host":\{"type":"keyword"\}`)),
+				),
+			},
+		},
+	})
+}
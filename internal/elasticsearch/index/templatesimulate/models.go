@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package templatesimulate
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfModel struct {
+	entitycore.ElasticsearchConnectionField
+	ID           types.String         `tfsdk:"id"`
+	IndexName    types.String         `tfsdk:"index_name"`
+	TemplateName types.String         `tfsdk:"template_name"`
+	Template     jsontypes.Normalized `tfsdk:"template"`
+	Settings     jsontypes.Normalized `tfsdk:"settings"`
+	Mappings     jsontypes.Normalized `tfsdk:"mappings"`
+	Aliases      jsontypes.Normalized `tfsdk:"aliases"`
+	Overlapping  types.List           `tfsdk:"overlapping"` // > overlappingTfModel
+}
+
+type overlappingTfModel struct {
+	Name          types.String `tfsdk:"name"`
+	IndexPatterns types.List   `tfsdk:"index_patterns"` // > types.String
+}
+
+func (model *tfModel) populateFromAPI(ctx context.Context, simulated models.SimulatedIndexTemplate) (diags diag.Diagnostics) {
+	model.Settings = jsonObjectValue(simulated.Template.Settings, path.Root("settings"), &diags)
+	model.Mappings = jsonObjectValue(simulated.Template.Mappings, path.Root("mappings"), &diags)
+	model.Aliases = jsonObjectValue(simulated.Template.Aliases, path.Root("aliases"), &diags)
+
+	overlapping := simulated.Overlapping
+	if overlapping == nil {
+		overlapping = []models.OverlappingIndexTemplate{}
+	}
+	model.Overlapping = typeutils.SliceToListType(ctx, overlapping, getOverlappingType(ctx), path.Root("overlapping"), &diags,
+		func(item models.OverlappingIndexTemplate, meta typeutils.ListMeta) overlappingTfModel {
+			return overlappingTfModel{
+				Name:          types.StringValue(item.Name),
+				IndexPatterns: typeutils.SliceToListTypeString(ctx, item.IndexPatterns, meta.Path.AtName("index_patterns"), meta.Diags),
+			}
+		})
+	return diags
+}
+
+// jsonObjectValue encodes a resolved template section, which is an empty JSON
+// object when no template sets it.
+func jsonObjectValue(value map[string]any, p path.Path, diags *diag.Diagnostics) jsontypes.Normalized {
+	if value == nil {
+		value = map[string]any{}
+	}
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		diags.AddAttributeError(p, "Failed to encode the simulated template", err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(string(bytes))
+}
@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package templatesimulate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var model tfModel
+	diags := model.populateFromAPI(ctx, models.SimulatedIndexTemplate{
+		Template: models.SimulatedTemplate{
+			Settings: map[string]any{"index": map[string]any{"number_of_shards": "2"}},
+			Mappings: map[string]any{"properties": map[string]any{"host": map[string]any{"type": "keyword"}}},
+		},
+		Overlapping: []models.OverlappingIndexTemplate{{Name: "logs-legacy", IndexPatterns: []string{"logs-*"}}},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.JSONEq(t, `{"index":{"number_of_shards":"2"}}`, model.Settings.ValueString())
+	assert.JSONEq(t, `{"properties":{"host":{"type":"keyword"}}}`, model.Mappings.ValueString())
+	assert.JSONEq(t, `{}`, model.Aliases.ValueString())
+
+	var overlapping []overlappingTfModel
+	require.False(t, model.Overlapping.ElementsAs(ctx, &overlapping, false).HasError())
+	require.Len(t, overlapping, 1)
+	assert.Equal(t, "logs-legacy", overlapping[0].Name.ValueString())
+	assert.Equal(t, 1, len(overlapping[0].IndexPatterns.Elements()))
+}
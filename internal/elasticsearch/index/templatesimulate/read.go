@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package templatesimulate
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, esClient *clients.ElasticsearchScopedClient, config tfModel) (tfModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		simulated *models.SimulatedIndexTemplate
+		simDiags  diag.Diagnostics
+		idSuffix  string
+	)
+	if typeutils.IsKnown(config.IndexName) {
+		idSuffix = config.IndexName.ValueString()
+		simulated, simDiags = elasticsearch.SimulateIndexTemplateForIndex(ctx, esClient, config.IndexName.ValueString())
+	} else {
+		var body []byte
+		if typeutils.IsKnown(config.Template) {
+			body = []byte(config.Template.ValueString())
+		}
+		idSuffix = "_simulate/" + config.TemplateName.ValueString()
+		simulated, simDiags = elasticsearch.SimulateIndexTemplate(ctx, esClient, config.TemplateName.ValueString(), body)
+	}
+	diags.Append(simDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	id, idDiags := esClient.ID(ctx, idSuffix)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	config.ID = types.StringValue(id.String())
+
+	diags.Append(config.populateFromAPI(ctx, *simulated)...)
+	return config, diags
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package templatesimulate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Internal identifier of the data source.",
+				Computed:    true,
+			},
+			"index_name": schema.StringAttribute{
+				Description: "Name of an index to resolve the matching index template for. The index does not need to exist.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("template_name"), path.MatchRoot("template")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("template_name"), path.MatchRoot("template")),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				Description: "Name of the index template to simulate. When `template` is also set, `template` is simulated as if it replaced this template.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"template": schema.StringAttribute{
+				Description: "Index template definition to simulate, as a JSON object with the same shape as the body of the create index template API.",
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"settings": schema.StringAttribute{
+				Description: "The resolved index settings, as JSON.",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"mappings": schema.StringAttribute{
+				Description: "The resolved mappings, as JSON.",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"aliases": schema.StringAttribute{
+				Description: "The resolved aliases, as JSON.",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"overlapping": schema.ListNestedAttribute{
+				Description: "Templates that also match, but are superseded by the simulated template because of their lower priority.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the overlapping template.",
+							Computed:    true,
+						},
+						"index_patterns": schema.ListAttribute{
+							Description: "Index patterns of the overlapping template.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getOverlappingType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["overlapping"].GetType().(attr.TypeWithElementType).ElementType()
+}
@@ -0,0 +1,70 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_component_template" "base" {
+  name = "${var.name}-base"
+
+  template {
+    settings = jsonencode({
+      number_of_shards = 2
+    })
+    mappings = jsonencode({
+      properties = {
+        host = { type = "keyword" }
+      }
+    })
+  }
+}
+
+resource "elasticstack_elasticsearch_index_template" "low" {
+  name           = "${var.name}-low"
+  index_patterns = ["${var.name}-*"]
+  priority       = 10
+}
+
+resource "elasticstack_elasticsearch_index_template" "high" {
+  name           = "${var.name}-high"
+  index_patterns = ["${var.name}-logs-*"]
+  priority       = 100
+  composed_of    = [elasticstack_elasticsearch_component_template.base.name]
+
+  template {
+    alias {
+      name = "${var.name}-alias"
+    }
+    mappings = jsonencode({
+      properties = {
+        message = { type = "text" }
+      }
+    })
+  }
+
+  depends_on = [elasticstack_elasticsearch_index_template.low]
+}
+
+data "elasticstack_elasticsearch_index_template_simulate" "by_index" {
+  index_name = "${var.name}-logs-000001"
+
+  depends_on = [elasticstack_elasticsearch_index_template.high]
+}
+
+data "elasticstack_elasticsearch_index_template_simulate" "by_name" {
+  template_name = elasticstack_elasticsearch_index_template.high.name
+}
+
+data "elasticstack_elasticsearch_index_template_simulate" "inline" {
+  template = jsonencode({
+    index_patterns = ["${var.name}-inline-*"]
+    composed_of    = [elasticstack_elasticsearch_component_template.base.name]
+    template = {
+      settings = {
+        number_of_shards = 3
+      }
+    }
+  })
+}
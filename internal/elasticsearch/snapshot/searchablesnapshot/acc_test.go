@@ -0,0 +1,96 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const resourceName = "elasticstack_elasticsearch_searchable_snapshot_mount.test"
+
+func TestAccResourceSearchableSnapshotMount(t *testing.T) {
+	name := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+	vars := config.Variables{"name": config.StringVariable(name)}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		// The snapshot is taken with the snapshot create action.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: checkMountDestroy(name + "-mounted"),
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("bootstrap"),
+				ConfigVariables:          vars,
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("mount"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repository", name+"-repo"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot", name+"-snap"),
+					resource.TestCheckResourceAttr(resourceName, "index", name+"-idx"),
+					resource.TestCheckResourceAttr(resourceName, "renamed_index", name+"-mounted"),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-mounted"),
+					resource.TestCheckResourceAttr(resourceName, "storage", "full_copy"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("mount"),
+				ConfigVariables:          vars,
+				ResourceName:             resourceName,
+				ImportState:              true,
+				ImportStateId:            name + "-mounted",
+				ImportStateVerify:        true,
+				ImportStateVerifyIgnore:  []string{"index_settings"},
+			},
+		},
+	})
+}
+
+func checkMountDestroy(index string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+		if err != nil {
+			return err
+		}
+		exists, err := client.GetESClient().Indices.Exists(index).Do(context.Background())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("mounted index %q still exists", index)
+		}
+		return nil
+	}
+}
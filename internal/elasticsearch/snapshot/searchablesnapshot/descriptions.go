@@ -0,0 +1,35 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot
+
+import _ "embed"
+
+//go:embed descriptions/schema.md
+var schemaMarkdownDescription string
+
+const (
+	descID                  = "Internal identifier of the resource in the format `<cluster_uuid>/<mounted index name>`."
+	descRepository          = "Name of the snapshot repository."
+	descSnapshot            = "Name of the snapshot containing the index to mount."
+	descIndex               = "Name of the index in the snapshot to mount."
+	descRenamedIndex        = "Name of the mounted index. Defaults to `index`."
+	descName                = "Name of the mounted index."
+	descStorage             = "Storage option of the mounted index: `full_copy` keeps a full copy of the snapshot data on the cluster, `shared_cache` only caches the parts that are searched, for the frozen tier. Defaults to `full_copy`."
+	descIndexSettings       = "JSON-encoded settings to add to the mounted index, overriding the settings stored in the snapshot. Not read back from the cluster."
+	descIgnoreIndexSettings = "Names of settings stored in the snapshot to remove from the mounted index. Not read back from the cluster."
+)
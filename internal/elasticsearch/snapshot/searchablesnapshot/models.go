@@ -0,0 +1,123 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/searchablesnapshots/mount"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	storageFullCopy    = "full_copy"
+	storageSharedCache = "shared_cache"
+
+	settingRepositoryName = "index.store.snapshot.repository_name"
+	settingSnapshotName   = "index.store.snapshot.snapshot_name"
+	settingIndexName      = "index.store.snapshot.index_name"
+	settingPartial        = "index.store.snapshot.partial"
+)
+
+// Model is the Terraform state model for
+// elasticstack_elasticsearch_searchable_snapshot_mount.
+type Model struct {
+	entitycore.ElasticsearchConnectionField
+	entitycore.ResourceTimeoutsField
+	ID                  types.String         `tfsdk:"id"`
+	Repository          types.String         `tfsdk:"repository"`
+	Snapshot            types.String         `tfsdk:"snapshot"`
+	Index               types.String         `tfsdk:"index"`
+	RenamedIndex        types.String         `tfsdk:"renamed_index"`
+	Name                types.String         `tfsdk:"name"`
+	Storage             types.String         `tfsdk:"storage"`
+	IndexSettings       jsontypes.Normalized `tfsdk:"index_settings"`
+	IgnoreIndexSettings types.List           `tfsdk:"ignore_index_settings"`
+}
+
+func (m Model) GetID() types.String { return m.ID }
+
+// GetResourceID returns the name of the mounted index, which is renamed_index
+// when set and index otherwise.
+func (m Model) GetResourceID() types.String {
+	if typeutils.IsKnown(m.RenamedIndex) {
+		return m.RenamedIndex
+	}
+	return m.Index
+}
+
+var _ entitycore.ElasticsearchResourceModel = Model{}
+
+func (m Model) toMountRequest(ctx context.Context) (*mount.Request, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := &mount.Request{
+		Index:        m.Index.ValueString(),
+		RenamedIndex: typeutils.OptionalString(m.RenamedIndex),
+	}
+
+	if typeutils.IsKnown(m.IndexSettings) && m.IndexSettings.ValueString() != "" {
+		diags.Append(m.IndexSettings.Unmarshal(&req.IndexSettings)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if typeutils.IsKnown(m.IgnoreIndexSettings) {
+		req.IgnoreIndexSettings = typeutils.ListTypeToSliceString(ctx, m.IgnoreIndexSettings, path.Root("ignore_index_settings"), &diags)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	return req, diags
+}
+
+// populateFromSettings sets the snapshot backing the mounted index name from
+// its flat index settings. It returns false when the index is not a
+// searchable snapshot.
+func (m *Model) populateFromSettings(name string, settings map[string]any) bool {
+	snapshot, ok := settings[settingSnapshotName].(string)
+	if !ok {
+		return false
+	}
+
+	m.Name = types.StringValue(name)
+	m.Repository = types.StringValue(fmt.Sprint(settings[settingRepositoryName]))
+	m.Snapshot = types.StringValue(snapshot)
+	m.Index = types.StringValue(fmt.Sprint(settings[settingIndexName]))
+
+	// Keep a configured renamed_index, even when it equals index, and only
+	// derive it on import.
+	if !typeutils.IsKnown(m.RenamedIndex) {
+		m.RenamedIndex = types.StringNull()
+		if name != m.Index.ValueString() {
+			m.RenamedIndex = types.StringValue(name)
+		}
+	}
+
+	m.Storage = types.StringValue(storageFullCopy)
+	if fmt.Sprint(settings[settingPartial]) == "true" {
+		m.Storage = types.StringValue(storageSharedCache)
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResourceID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "logs", Model{Index: types.StringValue("logs"), RenamedIndex: types.StringNull()}.GetResourceID().ValueString())
+	assert.Equal(t, "logs-mounted", Model{Index: types.StringValue("logs"), RenamedIndex: types.StringValue("logs-mounted")}.GetResourceID().ValueString())
+	assert.Empty(t, Model{Index: types.StringNull(), RenamedIndex: types.StringNull()}.GetResourceID().ValueString())
+}
+
+func TestPopulateFromSettings(t *testing.T) {
+	t.Parallel()
+
+	mounted := map[string]any{
+		settingRepositoryName: "archive",
+		settingSnapshotName:   "snap-1",
+		settingIndexName:      "logs",
+	}
+
+	t.Run("derives renamed_index on import", func(t *testing.T) {
+		t.Parallel()
+		m := Model{RenamedIndex: types.StringNull()}
+		require.True(t, m.populateFromSettings("logs-mounted", mounted))
+
+		assert.Equal(t, "archive", m.Repository.ValueString())
+		assert.Equal(t, "snap-1", m.Snapshot.ValueString())
+		assert.Equal(t, "logs", m.Index.ValueString())
+		assert.Equal(t, "logs-mounted", m.RenamedIndex.ValueString())
+		assert.Equal(t, "logs-mounted", m.Name.ValueString())
+		assert.Equal(t, storageFullCopy, m.Storage.ValueString())
+	})
+
+	t.Run("leaves renamed_index null for the same name", func(t *testing.T) {
+		t.Parallel()
+		m := Model{RenamedIndex: types.StringNull()}
+		require.True(t, m.populateFromSettings("logs", mounted))
+
+		assert.True(t, m.RenamedIndex.IsNull())
+	})
+
+	t.Run("keeps a configured renamed_index", func(t *testing.T) {
+		t.Parallel()
+		m := Model{RenamedIndex: types.StringValue("logs")}
+		require.True(t, m.populateFromSettings("logs", mounted))
+
+		assert.Equal(t, "logs", m.RenamedIndex.ValueString())
+	})
+
+	t.Run("shared cache", func(t *testing.T) {
+		t.Parallel()
+		settings := map[string]any{settingPartial: "true"}
+		for k, v := range mounted {
+			settings[k] = v
+		}
+		m := Model{RenamedIndex: types.StringNull()}
+		require.True(t, m.populateFromSettings("logs", settings))
+
+		assert.Equal(t, storageSharedCache, m.Storage.ValueString())
+	})
+
+	t.Run("regular index", func(t *testing.T) {
+		t.Parallel()
+		m := Model{}
+		assert.False(t, m.populateFromSettings("logs", map[string]any{"index.number_of_shards": "1"}))
+	})
+}
+
+func TestToMountRequest(t *testing.T) {
+	t.Parallel()
+
+	m := Model{
+		Index:               types.StringValue("logs"),
+		RenamedIndex:        types.StringValue("logs-mounted"),
+		IndexSettings:       jsontypes.NewNormalizedValue(`{"index.number_of_replicas": 0}`),
+		IgnoreIndexSettings: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("index.refresh_interval")}),
+	}
+
+	req, diags := m.toMountRequest(context.Background())
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "logs", req.Index)
+	assert.Equal(t, "logs-mounted", *req.RenamedIndex)
+	assert.Equal(t, map[string]json.RawMessage{"index.number_of_replicas": json.RawMessage("0")}, req.IndexSettings)
+	assert.Equal(t, []string{"index.refresh_interval"}, req.IgnoreIndexSettings)
+}
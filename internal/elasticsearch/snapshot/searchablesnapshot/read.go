@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/elasticsearch"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func readMount(
+	ctx context.Context,
+	client *clients.ElasticsearchScopedClient,
+	resourceID string,
+	state Model,
+) (Model, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings, getDiags := elasticsearch.GetIndexFlatSettings(ctx, client, resourceID)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return state, false, diags
+	}
+
+	if settings == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Mounted index %q not found, removing from state", resourceID))
+		return state, false, diags
+	}
+
+	if !state.populateFromSettings(resourceID, settings) {
+		diags.AddError(
+			"Index is not a searchable snapshot",
+			fmt.Sprintf("Index %q exists but is not mounted from a snapshot.", resourceID),
+		)
+		return state, false, diags
+	}
+
+	id, idDiags := client.ID(ctx, resourceID)
+	diags.Append(idDiags...)
+	if diags.HasError() {
+		return state, false, diags
+	}
+	state.ID = types.StringValue(id.String())
+
+	return state, true, diags
+}
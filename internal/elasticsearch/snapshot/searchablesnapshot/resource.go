@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package searchablesnapshot
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                = newMountResource()
+	_ resource.ResourceWithConfigure   = newMountResource()
+	_ resource.ResourceWithImportState = newMountResource()
+)
+
+type mountResource struct {
+	*entitycore.ElasticsearchResource[Model]
+}
+
+func newMountResource() *mountResource {
+	return &mountResource{
+		ElasticsearchResource: entitycore.NewElasticsearchResource[Model]("searchable_snapshot_mount", entitycore.ElasticsearchResourceOptions[Model]{
+			Schema: getSchema,
+			Read:   readMount,
+			Delete: deleteMount,
+			Create: createMount,
+			Update: entitycore.UpdateNotSupportedWriteCallback[Model](),
+		}),
+	}
+}
+
+// NewMountResource returns the searchable snapshot mount resource for provider registration.
+func NewMountResource() resource.Resource {
+	return newMountResource()
+}
+
+// ImportState accepts either `<cluster_uuid>/<index name>` or the plain name
+// of the mounted index; Read resolves both.
+func (r *mountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
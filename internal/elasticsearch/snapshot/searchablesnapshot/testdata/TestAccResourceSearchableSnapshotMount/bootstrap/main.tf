@@ -0,0 +1,45 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+}
+
+resource "elasticstack_elasticsearch_snapshot_repository" "repo" {
+  name = "${var.name}-repo"
+
+  fs {
+    location = "/tmp/snapshots"
+  }
+}
+
+resource "elasticstack_elasticsearch_index" "source" {
+  name                = "${var.name}-idx"
+  number_of_replicas  = 0
+  deletion_protection = false
+}
+
+action "elasticstack_elasticsearch_snapshot_create" "bootstrap" {
+  config {
+    repository           = elasticstack_elasticsearch_snapshot_repository.repo.name
+    snapshot             = "${var.name}-snap"
+    indices              = [elasticstack_elasticsearch_index.source.name]
+    include_global_state = false
+    wait_for_completion  = true
+  }
+}
+
+resource "terraform_data" "trigger_create" {
+  depends_on = [
+    elasticstack_elasticsearch_index.source,
+    elasticstack_elasticsearch_snapshot_repository.repo,
+  ]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_elasticsearch_snapshot_create.bootstrap]
+    }
+  }
+}
@@ -107,8 +107,11 @@ type ElasticsearchActionOptions[T ElasticsearchActionModel] struct {
 // KibanaActionOptions configures [NewKibanaAction].
 // Schema and Invoke must be non-nil or the constructor panics.
 // DefaultInvokeTimeout is used when the configuration omits `timeouts.invoke`;
-// zero falls back to [DefaultActionInvokeTimeout].
+// zero falls back to [DefaultActionInvokeTimeout]. Component selects the type
+// name namespace (for example [ComponentFleet]); empty falls back to
+// [ComponentKibana].
 type KibanaActionOptions[T KibanaActionModel] struct {
+	Component            Component
 	Schema               func(context.Context) actionschema.Schema
 	Invoke               ActionInvokeFunc[T, *clients.KibanaScopedClient]
 	DefaultInvokeTimeout time.Duration
@@ -329,8 +332,12 @@ func NewKibanaAction[T KibanaActionModel](
 	if opts.Invoke == nil {
 		panic("entitycore: KibanaActionOptions.Invoke must not be nil")
 	}
+	component := opts.Component
+	if component == "" {
+		component = ComponentKibana
+	}
 	return &genericAction[T, *clients.KibanaScopedClient]{
-		ActionBase:     NewActionBase(ComponentKibana, name),
+		ActionBase:     NewActionBase(component, name),
 		schemaFactory:  opts.Schema,
 		invokeFunc:     opts.Invoke,
 		defaultTimeout: opts.DefaultInvokeTimeout,
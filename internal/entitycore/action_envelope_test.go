@@ -207,6 +207,27 @@ func TestKibanaAction_SchemaInjectsBlocks(t *testing.T) {
 	require.Contains(t, schema.Attributes, "value", "concrete attributes must be preserved")
 }
 
+func TestKibanaAction_MetadataUsesComponent(t *testing.T) {
+	t.Parallel()
+	invoke := func(_ context.Context, _ *clients.KibanaScopedClient, _ ActionRequest[testKibanaActionModel]) diag.Diagnostics {
+		return nil
+	}
+
+	for component, expected := range map[Component]string{
+		"":             "elasticstack_kibana_test_entity",
+		ComponentFleet: "elasticstack_fleet_test_entity",
+	} {
+		a := NewKibanaAction[testKibanaActionModel]("test_entity", KibanaActionOptions[testKibanaActionModel]{
+			Component: component,
+			Schema:    testActionSchema,
+			Invoke:    invoke,
+		})
+		var resp action.MetadataResponse
+		a.Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "elasticstack"}, &resp)
+		require.Equal(t, expected, resp.TypeName)
+	}
+}
+
 func TestActionBase_MetadataUsesProviderTypeName(t *testing.T) {
 	t.Parallel()
 	base := NewActionBase(ComponentElasticsearch, "snapshot_create")
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultReassignInvokeTimeout = 20 * time.Minute
+
+// ReassignModel holds the Terraform configuration for the agents reassign action.
+type ReassignModel struct {
+	entitycore.KibanaConnectionField
+	entitycore.ActionTimeoutsField
+	selectorFields
+
+	PolicyID types.String `tfsdk:"policy_id"`
+}
+
+// NewReassignAction returns the elasticstack_fleet_agents_reassign action.
+func NewReassignAction() action.Action {
+	return entitycore.NewKibanaAction[ReassignModel]("agents_reassign", entitycore.KibanaActionOptions[ReassignModel]{
+		Component:            entitycore.ComponentFleet,
+		Schema:               getReassignSchema,
+		Invoke:               invokeReassign,
+		DefaultInvokeTimeout: defaultReassignInvokeTimeout,
+	})
+}
+
+func getReassignSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Moves Elastic Agents to another agent policy with `POST /api/fleet/agents/bulk_reassign`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"When `wait_for_completion` is `true`, polls `GET /api/fleet/agents/action_status` until the reassignment completes or the invoke timeout elapses.",
+		Attributes: withSelectorAttributes(map[string]actionschema.Attribute{
+			"policy_id": actionschema.StringAttribute{
+				MarkdownDescription: "The ID of the agent policy to assign the agents to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func invokeReassign(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.ActionRequest[ReassignModel]) diag.Diagnostics {
+	model := req.Config
+	return runAgentAction(ctx, client, model.selectorFields, func(ctx context.Context, c *fleet.Client, spaceID string, selector fleet.AgentSelector) (string, diag.Diagnostics) {
+		return fleet.BulkReassignAgents(ctx, c, spaceID, fleet.BulkReassignAgentsRequest{
+			Agents:          selector,
+			PolicyID:        model.PolicyID.ValueString(),
+			IncludeInactive: model.selectorFields.includeInactive(),
+			BatchSize:       model.selectorFields.batchSize(),
+		})
+	})
+}
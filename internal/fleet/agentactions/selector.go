@@ -0,0 +1,148 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"maps"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// selectorFields are the attributes shared by every agent bulk action: which
+// agents to act on, and whether to wait for Fleet to complete the action.
+type selectorFields struct {
+	SpaceID           types.String `tfsdk:"space_id"`
+	AgentIDs          types.Set    `tfsdk:"agent_ids"`
+	Kuery             types.String `tfsdk:"kuery"`
+	IncludeInactive   types.Bool   `tfsdk:"include_inactive"`
+	BatchSize         types.Int64  `tfsdk:"batch_size"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+// withSelectorAttributes returns attrs extended with the shared selector
+// attributes.
+func withSelectorAttributes(attrs map[string]actionschema.Attribute) map[string]actionschema.Attribute {
+	result := map[string]actionschema.Attribute{
+		"space_id": actionschema.StringAttribute{
+			MarkdownDescription: "The Kibana space the agents are enrolled in. Defaults to the default space.",
+			Optional:            true,
+		},
+		"agent_ids": actionschema.SetAttribute{
+			MarkdownDescription: "The IDs of the agents to act on. Exactly one of `agent_ids` or `kuery` must be set.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ExactlyOneOf(path.MatchRoot("agent_ids"), path.MatchRoot("kuery")),
+			},
+		},
+		"kuery": actionschema.StringAttribute{
+			MarkdownDescription: "A KQL query selecting the agents to act on, for example `policy_id:\"my-policy\"`. Exactly one of `agent_ids` or `kuery` must be set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"include_inactive": actionschema.BoolAttribute{
+			MarkdownDescription: "Also act on inactive agents matched by `kuery`. Defaults to `false`.",
+			Optional:            true,
+		},
+		"batch_size": actionschema.Int64Attribute{
+			MarkdownDescription: "The number of agents Fleet processes per batch. Defaults to the Fleet default.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"wait_for_completion": actionschema.BoolAttribute{
+			MarkdownDescription: "When `true`, polls the Fleet action status until the action completes, fails, expires or is cancelled. Defaults to `true`.",
+			Optional:            true,
+		},
+	}
+	maps.Copy(result, attrs)
+	return result
+}
+
+func (f selectorFields) spaceID() string {
+	if typeutils.IsKnown(f.SpaceID) && f.SpaceID.ValueString() != "" {
+		return f.SpaceID.ValueString()
+	}
+	return clients.DefaultSpaceID
+}
+
+func (f selectorFields) selector(ctx context.Context) (fleet.AgentSelector, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if typeutils.IsKnown(f.AgentIDs) {
+		ids := typeutils.SetTypeAs[string](ctx, f.AgentIDs, path.Root("agent_ids"), &diags)
+		return fleet.AgentSelector{IDs: ids}, diags
+	}
+	return fleet.AgentSelector{Kuery: f.Kuery.ValueString()}, diags
+}
+
+func (f selectorFields) includeInactive() bool {
+	return typeutils.IsKnown(f.IncludeInactive) && f.IncludeInactive.ValueBool()
+}
+
+func (f selectorFields) batchSize() *int64 {
+	return f.BatchSize.ValueInt64Pointer()
+}
+
+func (f selectorFields) waitForCompletion() bool {
+	return !typeutils.IsKnown(f.WaitForCompletion) || f.WaitForCompletion.ValueBool()
+}
+
+// submitFunc starts a bulk action on the selected agents and returns the
+// Fleet action ID.
+type submitFunc func(ctx context.Context, client *fleet.Client, spaceID string, selector fleet.AgentSelector) (string, diag.Diagnostics)
+
+// runAgentAction resolves the selector, submits the action and, unless
+// disabled, waits for Fleet to complete it.
+func runAgentAction(ctx context.Context, client *clients.KibanaScopedClient, fields selectorFields, submit submitFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	selector, selectorDiags := fields.selector(ctx)
+	diags.Append(selectorDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	fleetClient := client.GetFleetClient()
+	spaceID := fields.spaceID()
+
+	actionID, submitDiags := submit(ctx, fleetClient, spaceID, selector)
+	diags.Append(submitDiags...)
+	if diags.HasError() || actionID == "" || !fields.waitForCompletion() {
+		return diags
+	}
+
+	diags.Append(waitForAgentAction(ctx, actionID, func(ctx context.Context, actionID string) (*fleet.AgentActionStatus, diag.Diagnostics) {
+		return fleet.GetAgentActionStatus(ctx, fleetClient, spaceID, actionID)
+	})...)
+	return diags
+}
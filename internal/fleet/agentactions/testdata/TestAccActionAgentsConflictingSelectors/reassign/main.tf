@@ -0,0 +1,20 @@
+provider "elasticstack" {
+  kibana {}
+}
+
+action "elasticstack_fleet_agents_reassign" "reassign" {
+  config {
+    agent_ids = ["agent-1"]
+    kuery     = "tags:canary"
+    policy_id = "policy-1"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_fleet_agents_reassign.reassign]
+    }
+  }
+}
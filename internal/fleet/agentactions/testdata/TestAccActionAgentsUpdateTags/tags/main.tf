@@ -0,0 +1,25 @@
+variable "tag" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+action "elasticstack_fleet_agents_update_tags" "tags" {
+  config {
+    kuery               = "tags:\"${var.tag}\""
+    tags_to_add         = ["${var.tag}-done"]
+    tags_to_remove      = [var.tag]
+    wait_for_completion = false
+  }
+}
+
+resource "terraform_data" "trigger" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.elasticstack_fleet_agents_update_tags.tags]
+    }
+  }
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultUnenrollInvokeTimeout = 20 * time.Minute
+
+// UnenrollModel holds the Terraform configuration for the agents unenroll action.
+type UnenrollModel struct {
+	entitycore.KibanaConnectionField
+	entitycore.ActionTimeoutsField
+	selectorFields
+
+	Revoke types.Bool `tfsdk:"revoke"`
+	Force  types.Bool `tfsdk:"force"`
+}
+
+// NewUnenrollAction returns the elasticstack_fleet_agents_unenroll action.
+func NewUnenrollAction() action.Action {
+	return entitycore.NewKibanaAction[UnenrollModel]("agents_unenroll", entitycore.KibanaActionOptions[UnenrollModel]{
+		Component:            entitycore.ComponentFleet,
+		Schema:               getUnenrollSchema,
+		Invoke:               invokeUnenroll,
+		DefaultInvokeTimeout: defaultUnenrollInvokeTimeout,
+	})
+}
+
+func getUnenrollSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Unenrolls Elastic Agents from Fleet with `POST /api/fleet/agents/bulk_unenroll`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"When `wait_for_completion` is `true`, polls `GET /api/fleet/agents/action_status` until the agents are unenrolled or the invoke timeout elapses.",
+		Attributes: withSelectorAttributes(map[string]actionschema.Attribute{
+			"revoke": actionschema.BoolAttribute{
+				MarkdownDescription: "Revoke the agents' API keys immediately instead of waiting for them to acknowledge the unenrollment. Defaults to `false`.",
+				Optional:            true,
+			},
+			"force": actionschema.BoolAttribute{
+				MarkdownDescription: "Also unenroll agents enrolled in managed (hosted) agent policies. Defaults to `false`.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+func invokeUnenroll(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.ActionRequest[UnenrollModel]) diag.Diagnostics {
+	model := req.Config
+	return runAgentAction(ctx, client, model.selectorFields, func(ctx context.Context, c *fleet.Client, spaceID string, selector fleet.AgentSelector) (string, diag.Diagnostics) {
+		return fleet.BulkUnenrollAgents(ctx, c, spaceID, fleet.BulkUnenrollAgentsRequest{
+			Agents:          selector,
+			Revoke:          model.Revoke.ValueBool(),
+			Force:           model.Force.ValueBool(),
+			IncludeInactive: model.selectorFields.includeInactive(),
+			BatchSize:       model.selectorFields.batchSize(),
+		})
+	})
+}
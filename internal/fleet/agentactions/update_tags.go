@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultUpdateTagsInvokeTimeout = 20 * time.Minute
+
+// UpdateTagsModel holds the Terraform configuration for the agents update tags action.
+type UpdateTagsModel struct {
+	entitycore.KibanaConnectionField
+	entitycore.ActionTimeoutsField
+	selectorFields
+
+	TagsToAdd    types.Set `tfsdk:"tags_to_add"`
+	TagsToRemove types.Set `tfsdk:"tags_to_remove"`
+}
+
+// NewUpdateTagsAction returns the elasticstack_fleet_agents_update_tags action.
+func NewUpdateTagsAction() action.Action {
+	return entitycore.NewKibanaAction[UpdateTagsModel]("agents_update_tags", entitycore.KibanaActionOptions[UpdateTagsModel]{
+		Component:            entitycore.ComponentFleet,
+		Schema:               getUpdateTagsSchema,
+		Invoke:               invokeUpdateTags,
+		DefaultInvokeTimeout: defaultUpdateTagsInvokeTimeout,
+	})
+}
+
+func getUpdateTagsSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Adds and removes tags on Elastic Agents with `POST /api/fleet/agents/bulk_update_agent_tags`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"When `wait_for_completion` is `true`, polls `GET /api/fleet/agents/action_status` until the tags are updated or the invoke timeout elapses.",
+		Attributes: withSelectorAttributes(map[string]actionschema.Attribute{
+			"tags_to_add": actionschema.SetAttribute{
+				MarkdownDescription: "The tags to add to the agents. At least one of `tags_to_add` or `tags_to_remove` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AtLeastOneOf(path.MatchRoot("tags_to_add"), path.MatchRoot("tags_to_remove")),
+				},
+			},
+			"tags_to_remove": actionschema.SetAttribute{
+				MarkdownDescription: "The tags to remove from the agents. At least one of `tags_to_add` or `tags_to_remove` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func invokeUpdateTags(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.ActionRequest[UpdateTagsModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model := req.Config
+
+	tagsToAdd := typeutils.SetTypeAs[string](ctx, model.TagsToAdd, path.Root("tags_to_add"), &diags)
+	tagsToRemove := typeutils.SetTypeAs[string](ctx, model.TagsToRemove, path.Root("tags_to_remove"), &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(runAgentAction(ctx, client, model.selectorFields, func(ctx context.Context, c *fleet.Client, spaceID string, selector fleet.AgentSelector) (string, diag.Diagnostics) {
+		return fleet.BulkUpdateAgentTags(ctx, c, spaceID, fleet.BulkUpdateAgentTagsRequest{
+			Agents:          selector,
+			TagsToAdd:       tagsToAdd,
+			TagsToRemove:    tagsToRemove,
+			IncludeInactive: model.selectorFields.includeInactive(),
+			BatchSize:       model.selectorFields.batchSize(),
+		})
+	})...)
+	return diags
+}
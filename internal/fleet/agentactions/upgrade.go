@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/customtypes"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultUpgradeInvokeTimeout = 60 * time.Minute
+
+// UpgradeModel holds the Terraform configuration for the agents upgrade action.
+type UpgradeModel struct {
+	entitycore.KibanaConnectionField
+	entitycore.ActionTimeoutsField
+	selectorFields
+
+	Version            types.String         `tfsdk:"version"`
+	SourceURI          types.String         `tfsdk:"source_uri"`
+	Force              types.Bool           `tfsdk:"force"`
+	SkipRateLimitCheck types.Bool           `tfsdk:"skip_rate_limit_check"`
+	RolloutDuration    customtypes.Duration `tfsdk:"rollout_duration"`
+	StartTime          timetypes.RFC3339    `tfsdk:"start_time"`
+}
+
+// NewUpgradeAction returns the elasticstack_fleet_agents_upgrade action.
+func NewUpgradeAction() action.Action {
+	return entitycore.NewKibanaAction[UpgradeModel]("agents_upgrade", entitycore.KibanaActionOptions[UpgradeModel]{
+		Component:            entitycore.ComponentFleet,
+		Schema:               getUpgradeSchema,
+		Invoke:               invokeUpgrade,
+		DefaultInvokeTimeout: defaultUpgradeInvokeTimeout,
+	})
+}
+
+func getUpgradeSchema(_ context.Context) actionschema.Schema {
+	return actionschema.Schema{
+		MarkdownDescription: "Upgrades Elastic Agents enrolled in Fleet with `POST /api/fleet/agents/bulk_upgrade`. **Requires Terraform 1.14+** (provider-defined actions). " +
+			"When `wait_for_completion` is `true`, polls `GET /api/fleet/agents/action_status` until the upgrade completes or the invoke timeout elapses.",
+		Attributes: withSelectorAttributes(map[string]actionschema.Attribute{
+			"version": actionschema.StringAttribute{
+				MarkdownDescription: "The Elastic Agent version to upgrade to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source_uri": actionschema.StringAttribute{
+				MarkdownDescription: "The URI agents download the upgrade artifact from. Defaults to the agent download source of the agent policy.",
+				Optional:            true,
+			},
+			"force": actionschema.BoolAttribute{
+				MarkdownDescription: "Force the upgrade even when the agents are not upgradeable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"skip_rate_limit_check": actionschema.BoolAttribute{
+				MarkdownDescription: "Skip the check preventing agents from being upgraded again shortly after an upgrade. Defaults to `false`.",
+				Optional:            true,
+			},
+			"rollout_duration": actionschema.StringAttribute{
+				MarkdownDescription: "Spread the upgrade of the agents over this duration, for example `1h`. Upgrades all agents at once when omitted.",
+				Optional:            true,
+				CustomType:          customtypes.DurationType{},
+			},
+			"start_time": actionschema.StringAttribute{
+				MarkdownDescription: "The RFC 3339 time at which the upgrade starts. Starts immediately when omitted.",
+				Optional:            true,
+				CustomType:          timetypes.RFC3339Type{},
+			},
+		}),
+	}
+}
+
+func invokeUpgrade(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.ActionRequest[UpgradeModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model := req.Config
+
+	var rolloutSeconds *int64
+	if typeutils.IsKnown(model.RolloutDuration) {
+		duration, durationDiags := model.RolloutDuration.Parse()
+		diags.Append(durationDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		rolloutSeconds = new(int64(duration.Seconds()))
+	}
+
+	diags.Append(runAgentAction(ctx, client, model.selectorFields, func(ctx context.Context, c *fleet.Client, spaceID string, selector fleet.AgentSelector) (string, diag.Diagnostics) {
+		return fleet.BulkUpgradeAgents(ctx, c, spaceID, fleet.BulkUpgradeAgentsRequest{
+			Agents:                 selector,
+			Version:                model.Version.ValueString(),
+			SourceURI:              model.SourceURI.ValueString(),
+			Force:                  model.Force.ValueBool(),
+			SkipRateLimitCheck:     model.SkipRateLimitCheck.ValueBool(),
+			RolloutDurationSeconds: rolloutSeconds,
+			StartTime:              model.StartTime.ValueString(),
+			IncludeInactive:        model.selectorFields.includeInactive(),
+			BatchSize:              model.selectorFields.batchSize(),
+		})
+	})...)
+	return diags
+}
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/asyncutils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const agentActionPollInterval = 5 * time.Second
+
+// agentActionStatusGetter fetches the status of a Fleet agent action for polling.
+type agentActionStatusGetter func(ctx context.Context, actionID string) (*fleet.AgentActionStatus, diag.Diagnostics)
+
+func waitForAgentAction(ctx context.Context, actionID string, get agentActionStatusGetter) diag.Diagnostics {
+	return waitForAgentActionWithInterval(ctx, actionID, get, agentActionPollInterval)
+}
+
+// waitForAgentActionWithInterval polls the Fleet action status with
+// [asyncutils.WaitForStateTransition]. Terminal states carry their own
+// diagnostics, which the state checker captures via closure variables.
+func waitForAgentActionWithInterval(ctx context.Context, actionID string, get agentActionStatusGetter, pollInterval time.Duration) diag.Diagnostics {
+	var (
+		lastStatus    *fleet.AgentActionStatus
+		terminalDiags diag.Diagnostics
+		getDiags      diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		status, diags := get(ctx, actionID)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errAgentActionGetFailed
+		}
+		if status != nil {
+			lastStatus = status
+		}
+
+		done, statusDiags := classifyAgentActionStatus(status)
+		terminalDiags = statusDiags
+		return done, nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "fleet_agent_action", actionID, stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	switch {
+	case terminalDiags.HasError():
+		return terminalDiags
+	case errors.Is(err, errAgentActionGetFailed):
+		return getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return timeoutDiagnostic(actionID, lastStatus)
+	case err != nil:
+		var diags diag.Diagnostics
+		diags.AddError("Agent action wait failed", err.Error())
+		return diags
+	default:
+		return terminalDiags
+	}
+}
+
+// errAgentActionGetFailed is a sentinel returned by the state checker to bail
+// out of the shared poll loop while preserving the original diagnostics.
+var errAgentActionGetFailed = errors.New("agent action status get failed")
+
+func timeoutDiagnostic(actionID string, lastStatus *fleet.AgentActionStatus) diag.Diagnostics {
+	var diags diag.Diagnostics
+	detail := fmt.Sprintf("Agent action %q was not yet listed by Fleet.", actionID)
+	if lastStatus != nil {
+		detail = fmt.Sprintf("Agent action %q last observed status: %q, %s.", actionID, lastStatus.Status, progress(lastStatus))
+	}
+	diags.AddError("Agent action did not complete within timeout", detail)
+	return diags
+}
+
+// classifyAgentActionStatus reports whether the action reached a terminal
+// status and returns diagnostics for non-success terminal states. A nil
+// status means Fleet has not listed the action yet.
+func classifyAgentActionStatus(status *fleet.AgentActionStatus) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if status == nil {
+		return false, diags
+	}
+
+	switch status.Status {
+	case fleet.AgentActionStatusComplete:
+		return true, diags
+	case fleet.AgentActionStatusRolloutPassed:
+		diags.AddWarning("Agent action rollout passed", fmt.Sprintf("The rollout period of agent action %q passed before every agent acknowledged it: %s.", status.ActionID, progress(status)))
+		return true, diags
+	case fleet.AgentActionStatusFailed:
+		diags.AddError("Agent action failed", fmt.Sprintf("Agent action %q failed: %s.%s", status.ActionID, progress(status), latestErrors(status)))
+		return true, diags
+	case fleet.AgentActionStatusExpired:
+		diags.AddError("Agent action expired", fmt.Sprintf("Agent action %q expired: %s.%s", status.ActionID, progress(status), latestErrors(status)))
+		return true, diags
+	case fleet.AgentActionStatusCancelled:
+		diags.AddError("Agent action cancelled", fmt.Sprintf("Agent action %q was cancelled: %s.", status.ActionID, progress(status)))
+		return true, diags
+	default:
+		return false, diags
+	}
+}
+
+func progress(status *fleet.AgentActionStatus) string {
+	return fmt.Sprintf("%d of %d agents acknowledged, %d failed", status.NbAgentsAck, status.NbAgentsActionCreated, status.NbAgentsFailed)
+}
+
+func latestErrors(status *fleet.AgentActionStatus) string {
+	if len(status.LatestErrors) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(" Latest errors:")
+	for _, e := range status.LatestErrors {
+		host := e.Hostname
+		if host == "" {
+			host = e.AgentID
+		}
+		fmt.Fprintf(&b, "\n- %s: %s", host, e.Error)
+	}
+	return b.String()
+}
@@ -0,0 +1,108 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentactions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForAgentAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		statuses         []*fleet.AgentActionStatus
+		timeout          time.Duration
+		expectedSummary  string
+		expectedWarnings int
+	}{
+		{
+			name: "completes after being listed",
+			statuses: []*fleet.AgentActionStatus{
+				nil,
+				{ActionID: "action-1", Status: fleet.AgentActionStatusInProgress},
+				{ActionID: "action-1", Status: fleet.AgentActionStatusComplete},
+			},
+		},
+		{
+			name: "rollout passed is a warning",
+			statuses: []*fleet.AgentActionStatus{
+				{ActionID: "action-1", Status: fleet.AgentActionStatusRolloutPassed, NbAgentsActionCreated: 2, NbAgentsAck: 1},
+			},
+			expectedWarnings: 1,
+		},
+		{
+			name: "failed",
+			statuses: []*fleet.AgentActionStatus{
+				{ActionID: "action-1", Status: fleet.AgentActionStatusFailed, LatestErrors: []fleet.AgentActionError{{AgentID: "agent-1", Error: "boom"}}},
+			},
+			expectedSummary: "Agent action failed",
+		},
+		{
+			name: "expired",
+			statuses: []*fleet.AgentActionStatus{
+				{ActionID: "action-1", Status: fleet.AgentActionStatusExpired},
+			},
+			expectedSummary: "Agent action expired",
+		},
+		{
+			name: "times out",
+			statuses: []*fleet.AgentActionStatus{
+				{ActionID: "action-1", Status: fleet.AgentActionStatusInProgress},
+			},
+			timeout:         50 * time.Millisecond,
+			expectedSummary: "Agent action did not complete within timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			calls := 0
+			get := func(_ context.Context, actionID string) (*fleet.AgentActionStatus, diag.Diagnostics) {
+				require.Equal(t, "action-1", actionID)
+				status := tt.statuses[min(calls, len(tt.statuses)-1)]
+				calls++
+				return status, nil
+			}
+
+			diags := waitForAgentActionWithInterval(ctx, "action-1", get, time.Millisecond)
+			if tt.expectedSummary == "" {
+				require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			} else {
+				require.True(t, diags.HasError())
+				require.Equal(t, tt.expectedSummary, diags.Errors()[0].Summary())
+			}
+			require.Len(t, diags.Warnings(), tt.expectedWarnings)
+		})
+	}
+}
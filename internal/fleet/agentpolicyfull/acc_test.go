@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentpolicyfull_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var minVersionAgentPolicyFull = version.Must(version.NewVersion("8.6.0"))
+
+const dataSourceName = "data.elasticstack_fleet_agent_policy_full.test"
+
+func TestAccDataSourceAgentPolicyFull(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionAgentPolicyFull, versionutils.FlavorAny)
+
+	policyID := uuid.NewString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("yaml"),
+				ConfigVariables: config.Variables{
+					"policy_id": config.StringVariable(policyID),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "default/"+policyID),
+					resource.TestCheckResourceAttr(dataSourceName, "standalone", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "kubernetes", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "format", "yaml"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "revision"),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`(?m)^outputs:`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`\$\{ES_USERNAME\}`)),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("json"),
+				ConfigVariables: config.Variables{
+					"policy_id": config.StringVariable(policyID),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "format", "json"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "revision"),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexp.MustCompile(`"outputs": \{`)),
+				),
+			},
+		},
+	})
+}
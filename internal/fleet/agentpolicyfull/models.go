@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentpolicyfull
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	formatYAML = "yaml"
+	formatJSON = "json"
+)
+
+type agentPolicyFullModel struct {
+	entitycore.KibanaConnectionField
+	ID               types.String `tfsdk:"id"`
+	PolicyID         types.String `tfsdk:"policy_id"`
+	SpaceID          types.String `tfsdk:"space_id"`
+	Standalone       types.Bool   `tfsdk:"standalone"`
+	Kubernetes       types.Bool   `tfsdk:"kubernetes"`
+	Format           types.String `tfsdk:"format"`
+	Content          types.String `tfsdk:"content"`
+	Revision         types.Int64  `tfsdk:"revision"`
+	SecretReferences types.List   `tfsdk:"secret_references"` // > types.String
+}
+
+// fullAgentPolicy holds the parts of a rendered agent policy the data source
+// exposes besides the document itself.
+type fullAgentPolicy struct {
+	Revision         *int64 `json:"revision"`
+	SecretReferences []struct {
+		ID string `json:"id"`
+	} `json:"secret_references"`
+}
+
+func (p fullAgentPolicy) secretReferenceIDs() []string {
+	ids := make([]string, 0, len(p.SecretReferences))
+	for _, ref := range p.SecretReferences {
+		ids = append(ids, ref.ID)
+	}
+	return ids
+}
+
+// parseFullAgentPolicy decodes the item returned by the full agent policy API.
+// Kubernetes manifests are returned as a JSON string and carry no policy
+// metadata.
+func parseFullAgentPolicy(item json.RawMessage) (fullAgentPolicy, string, error) {
+	var policy fullAgentPolicy
+
+	var manifest string
+	if err := json.Unmarshal(item, &manifest); err == nil {
+		return policy, manifest, nil
+	}
+
+	if err := json.Unmarshal(item, &policy); err != nil {
+		return policy, "", fmt.Errorf("failed to decode full agent policy: %w", err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, item, "", "  "); err != nil {
+		return policy, "", fmt.Errorf("failed to format full agent policy: %w", err)
+	}
+	return policy, indented.String(), nil
+}
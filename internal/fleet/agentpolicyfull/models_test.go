@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentpolicyfull
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFullAgentPolicy(t *testing.T) {
+	tests := []struct {
+		name            string
+		item            string
+		expectedContent string
+		expectedRev     *int64
+		expectedSecrets []string
+	}{
+		{
+			name:            "policy",
+			item:            `{"id":"policy-1","revision":4,"secret_references":[{"id":"secret-1"},{"id":"secret-2"}]}`,
+			expectedContent: "{\n  \"id\": \"policy-1\",\n  \"revision\": 4,\n  \"secret_references\": [\n    {\n      \"id\": \"secret-1\"\n    },\n    {\n      \"id\": \"secret-2\"\n    }\n  ]\n}",
+			expectedRev:     new(int64(4)),
+			expectedSecrets: []string{"secret-1", "secret-2"},
+		},
+		{
+			name:            "kubernetes manifest",
+			item:            `"apiVersion: v1\nkind: ConfigMap\n"`,
+			expectedContent: "apiVersion: v1\nkind: ConfigMap\n",
+			expectedSecrets: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, content, err := parseFullAgentPolicy(json.RawMessage(tt.item))
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedContent, content)
+			require.Equal(t, tt.expectedRev, policy.Revision)
+			require.Equal(t, tt.expectedSecrets, policy.secretReferenceIDs())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentpolicyfull
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, kbClient *clients.KibanaScopedClient, config agentPolicyFullModel) (agentPolicyFullModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(config.SpaceID) {
+		spaceID = config.SpaceID.ValueString()
+	}
+	format := formatYAML
+	if typeutils.IsKnown(config.Format) {
+		format = config.Format.ValueString()
+	}
+	opts := fleet.FullAgentPolicyOptions{
+		Standalone: !typeutils.IsKnown(config.Standalone) || config.Standalone.ValueBool(),
+		Kubernetes: typeutils.IsKnown(config.Kubernetes) && config.Kubernetes.ValueBool(),
+	}
+
+	if opts.Kubernetes && format != formatYAML {
+		diags.AddAttributeError(path.Root("format"), "Invalid format", "Kubernetes manifests can only be rendered as YAML.")
+		return config, diags
+	}
+
+	fleetClient := kbClient.GetFleetClient()
+	policyID := config.PolicyID.ValueString()
+
+	item, getDiags := fleet.GetFullAgentPolicy(ctx, fleetClient, spaceID, policyID, opts)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	if item == nil {
+		diags.AddError("Agent policy not found", fmt.Sprintf("Agent policy %q was not found in space %q.", policyID, spaceID))
+		return config, diags
+	}
+
+	policy, content, err := parseFullAgentPolicy(item)
+	if err != nil {
+		diags.AddError("Failed to parse agent policy", err.Error())
+		return config, diags
+	}
+
+	if format == formatYAML {
+		downloaded, downloadDiags := fleet.DownloadAgentPolicy(ctx, fleetClient, spaceID, policyID, opts)
+		diags.Append(downloadDiags...)
+		if diags.HasError() {
+			return config, diags
+		}
+		if downloaded == nil {
+			diags.AddError("Agent policy not found", fmt.Sprintf("Agent policy %q was not found in space %q.", policyID, spaceID))
+			return config, diags
+		}
+		content = *downloaded
+	}
+
+	config.ID = types.StringValue((&clients.CompositeID{ClusterID: spaceID, ResourceID: policyID}).String())
+	config.SpaceID = types.StringValue(spaceID)
+	config.Standalone = types.BoolValue(opts.Standalone)
+	config.Kubernetes = types.BoolValue(opts.Kubernetes)
+	config.Format = types.StringValue(format)
+	config.Content = types.StringValue(content)
+	config.Revision = types.Int64PointerValue(policy.Revision)
+
+	secretRefs, listDiags := types.ListValueFrom(ctx, types.StringType, policy.secretReferenceIDs())
+	diags.Append(listDiags...)
+	config.SecretReferences = secretRefs
+
+	return config, diags
+}
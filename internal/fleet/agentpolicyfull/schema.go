@@ -0,0 +1,77 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentpolicyfull
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "The ID of the agent policy to render.",
+				Required:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"standalone": schema.BoolAttribute{
+				Description: "Render the policy for standalone Elastic Agents. Output credentials are replaced with the `${ES_USERNAME}` and `${ES_PASSWORD}` placeholders. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kubernetes": schema.BoolAttribute{
+				Description: "Render the policy as an Elastic Agent Kubernetes manifest instead of an `elastic-agent.yml` document. Only available with the `yaml` format. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "The format of `content`, either `yaml` or `json`. Defaults to `yaml`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(formatYAML, formatJSON),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The rendered agent policy.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the rendered agent policy. Not set for Kubernetes manifests.",
+				Computed:    true,
+			},
+			"secret_references": schema.ListAttribute{
+				Description: "The IDs of the Fleet secrets referenced by the policy. Secret values are not inlined in `content`; agents resolve them from Fleet at runtime.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+variable "policy_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  policy_id   = var.policy_id
+  name        = "Standalone policy ${var.policy_id}"
+  namespace   = "default"
+  description = "Agent Policy for testing the full agent policy data source"
+}
+
+data "elasticstack_fleet_agent_policy_full" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+  format    = "json"
+}
@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentsds
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type agentsDataSourceModel struct {
+	entitycore.KibanaConnectionField
+	ID       types.String `tfsdk:"id"`
+	SpaceID  types.String `tfsdk:"space_id"`
+	Kuery    types.String `tfsdk:"kuery"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Statuses types.Set    `tfsdk:"statuses"` // > types.String
+	Version  types.String `tfsdk:"version"`
+	Tags     types.Set    `tfsdk:"tags"`   // > types.String
+	Agents   types.List   `tfsdk:"agents"` // > agentModel
+}
+
+type agentModel struct {
+	ID             types.String `tfsdk:"id"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Version        types.String `tfsdk:"version"`
+	Status         types.String `tfsdk:"status"`
+	LastCheckin    types.String `tfsdk:"last_checkin"`
+	PolicyID       types.String `tfsdk:"policy_id"`
+	PolicyRevision types.Int64  `tfsdk:"policy_revision"`
+	Tags           types.List   `tfsdk:"tags"`       // > types.String
+	Components     types.List   `tfsdk:"components"` // > componentModel
+}
+
+type componentModel struct {
+	ID      types.String `tfsdk:"id"`
+	Type    types.String `tfsdk:"type"`
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+}
+
+func (model *agentsDataSourceModel) toListParams(ctx context.Context) (fleet.AgentListParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return fleet.AgentListParams{
+		Kuery:    model.Kuery.ValueString(),
+		PolicyID: model.PolicyID.ValueString(),
+		Statuses: typeutils.SetTypeAs[string](ctx, model.Statuses, path.Root("statuses"), &diags),
+		Version:  model.Version.ValueString(),
+		Tags:     typeutils.SetTypeAs[string](ctx, model.Tags, path.Root("tags"), &diags),
+	}, diags
+}
+
+func (model *agentsDataSourceModel) populateFromAPI(ctx context.Context, data []fleet.Agent) (diags diag.Diagnostics) {
+	model.Agents = typeutils.SliceToListType(ctx, data, getAgentType(ctx), path.Root("agents"), &diags, func(item fleet.Agent, meta typeutils.ListMeta) agentModel {
+		return newAgentModel(ctx, item, meta)
+	})
+	return
+}
+
+func newAgentModel(ctx context.Context, data fleet.Agent, meta typeutils.ListMeta) agentModel {
+	components := data.Components
+	if components == nil {
+		components = []fleet.AgentComponent{}
+	}
+	tags := data.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return agentModel{
+		ID:             types.StringValue(data.ID),
+		Hostname:       typeutils.NonEmptyStringishValue(data.LocalMetadata.Host.Hostname),
+		Version:        typeutils.NonEmptyStringishValue(data.Agent.Version),
+		Status:         typeutils.NonEmptyStringishValue(data.Status),
+		LastCheckin:    typeutils.NonEmptyStringishValue(data.LastCheckin),
+		PolicyID:       typeutils.NonEmptyStringishValue(data.PolicyID),
+		PolicyRevision: types.Int64PointerValue(data.PolicyRevision),
+		Tags:           typeutils.SliceToListTypeString(ctx, tags, meta.Path.AtName("tags"), meta.Diags),
+		Components:     typeutils.SliceToListType(ctx, components, getComponentType(ctx), meta.Path.AtName("components"), meta.Diags, newComponentModel),
+	}
+}
+
+func newComponentModel(data fleet.AgentComponent, _ typeutils.ListMeta) componentModel {
+	return componentModel{
+		ID:      types.StringValue(data.ID),
+		Type:    typeutils.NonEmptyStringishValue(data.Type),
+		Status:  typeutils.NonEmptyStringishValue(data.Status),
+		Message: typeutils.NonEmptyStringishValue(data.Message),
+	}
+}
@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package agentsds
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var agentStatuses = []string{"online", "offline", "unhealthy", "updating", "enrolling", "unenrolling", "inactive", "unenrolled"}
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"kuery": schema.StringAttribute{
+				Description: "A KQL query over agent fields, for example `local_metadata.os.family:linux`.",
+				Optional:    true,
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "Only select agents enrolled in this agent policy.",
+				Optional:    true,
+			},
+			"statuses": schema.SetAttribute{
+				Description: "Only select agents in one of these statuses. Inactive and unenrolled agents are only returned when explicitly selected.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(agentStatuses...)),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Only select agents running this Elastic Agent version.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only select agents carrying at least one of these tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"agents": schema.ListNestedAttribute{
+				Description: "The matching agents, in enrollment order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The agent ID.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "The hostname of the machine running the agent.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The Elastic Agent version.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The agent status, for example `online` or `unhealthy`.",
+							Computed:    true,
+						},
+						"last_checkin": schema.StringAttribute{
+							Description: "The time the agent last checked in with Fleet.",
+							Computed:    true,
+						},
+						"policy_id": schema.StringAttribute{
+							Description: "The agent policy the agent is enrolled in.",
+							Computed:    true,
+						},
+						"policy_revision": schema.Int64Attribute{
+							Description: "The revision of the agent policy the agent is running.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "The tags of the agent.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"components": schema.ListNestedAttribute{
+							Description: "The health of the components run by the agent.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The component ID.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The component type.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "The component status, for example `HEALTHY` or `DEGRADED`.",
+										Computed:    true,
+									},
+									"message": schema.StringAttribute{
+										Description: "The latest status message of the component.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getAgentType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["agents"].GetType().(attr.TypeWithElementType).ElementType()
+}
+
+func getComponentType(ctx context.Context) attr.Type {
+	return getAgentType(ctx).(attr.TypeWithAttributeTypes).AttributeTypes()["components"].(attr.TypeWithElementType).ElementType()
+}
@@ -0,0 +1,23 @@
+variable "policy_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  policy_id   = var.policy_id
+  name        = "Agents data source policy ${var.policy_id}"
+  namespace   = "default"
+  description = "Agent Policy for testing the agents data source"
+}
+
+data "elasticstack_fleet_agents" "all" {}
+
+data "elasticstack_fleet_agents" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+  statuses  = ["online", "unhealthy"]
+  tags      = ["terraform"]
+}
@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var minVersionEnrollmentToken = version.Must(version.NewVersion("8.6.0"))
+
+const resourceName = "elasticstack_fleet_enrollment_token.test"
+
+func TestAccResourceEnrollmentToken(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionEnrollmentToken, versionutils.FlavorAny)
+
+	policyID := uuid.NewString()
+	tokenName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkEnrollmentTokenRevoked,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"policy_id":  config.StringVariable(policyID),
+					"token_name": config.StringVariable(tokenName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_id", policyID),
+					resource.TestCheckResourceAttr(resourceName, "name", tokenName),
+					resource.TestCheckResourceAttr(resourceName, "space_id", "default"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"policy_id":  config.StringVariable(policyID),
+					"token_name": config.StringVariable(tokenName),
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("rotate"),
+				ConfigVariables: config.Variables{
+					"policy_id":  config.StringVariable(policyID),
+					"token_name": config.StringVariable(tokenName + "-rotated"),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", tokenName+"-rotated"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func checkEnrollmentTokenRevoked(s *terraform.State) error {
+	client, err := clients.NewAcceptanceTestingKibanaScopedClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "elasticstack_fleet_enrollment_token" {
+			continue
+		}
+
+		token, diags := fleet.GetEnrollmentToken(context.Background(), client.GetFleetClient(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["key_id"])
+		if diags.HasError() {
+			return fmt.Errorf("unable to get enrollment token: %v", diags)
+		}
+		if token != nil && token.Active {
+			return fmt.Errorf("enrollment token %s was not revoked", rs.Primary.Attributes["key_id"])
+		}
+	}
+	return nil
+}
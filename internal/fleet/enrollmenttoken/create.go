@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func createEnrollmentToken(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.KibanaWriteRequest[enrollmentTokenModel]) (entitycore.KibanaWriteResult[enrollmentTokenModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := fleet.CreateEnrollmentTokenRequest{
+		PolicyID: req.Plan.PolicyID.ValueString(),
+	}
+	if typeutils.IsKnown(req.Config.Name) {
+		body.Name = req.Config.Name.ValueStringPointer()
+	}
+
+	token, d := fleet.CreateEnrollmentToken(ctx, client.GetFleetClient(), req.SpaceID, body)
+	diags.Append(d...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[enrollmentTokenModel]{}, diags
+	}
+
+	req.Plan.populateFromAPI(req.SpaceID, token)
+	return entitycore.KibanaWriteResult[enrollmentTokenModel]{Model: req.Plan}, diags
+}
+
+// updateEnrollmentToken only persists the plan: every configurable attribute
+// requires replacement.
+func updateEnrollmentToken(_ context.Context, _ *clients.KibanaScopedClient, req entitycore.KibanaWriteRequest[enrollmentTokenModel]) (entitycore.KibanaWriteResult[enrollmentTokenModel], diag.Diagnostics) {
+	return entitycore.KibanaWriteResult[enrollmentTokenModel]{Model: req.Plan}, nil
+}
@@ -0,0 +1,32 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken
+
+import _ "embed"
+
+//go:embed descriptions/resource.md
+var resourceDescription string
+
+// Attribute descriptions shared with the ephemeral resource.
+const (
+	PolicyIDDescription = "The identifier of the agent policy the token enrolls agents into."
+	NameDescription     = "A name for the token. Fleet appends the token ID to it, the name must be unique per agent policy."
+	APIKeyDescription   = "The enrollment token used with `elastic-agent enroll --enrollment-token`."
+	APIKeyIDDescription = "The identifier of the Elasticsearch API key backing the token."
+	KeyIDDescription    = "The identifier of the enrollment token."
+)
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ephemeral_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+var minVersionEnrollmentToken = version.Must(version.NewVersion("8.6.0"))
+
+func ephemeralTestProviders() map[string]func() (tfprotov6.ProviderServer, error) {
+	providers := make(map[string]func() (tfprotov6.ProviderServer, error), len(acctest.Providers)+1)
+	maps.Copy(providers, acctest.Providers)
+	providers["echo"] = echoprovider.NewProviderServer()
+	return providers
+}
+
+func TestAccEphemeralResourceEnrollmentToken(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionEnrollmentToken, versionutils.FlavorAny)
+
+	policyID := uuid.NewString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: ephemeralTestProviders(),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"policy_id": config.StringVariable(policyID),
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.capture", tfjsonpath.New("data").AtMapKey("api_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.capture", tfjsonpath.New("data").AtMapKey("key_id"), knownvalue.NotNull()),
+				},
+				Check: checkEchoCaptureTokenRevoked,
+			},
+		},
+	})
+}
+
+func checkEchoCaptureTokenRevoked(state *terraform.State) error {
+	rs, ok := state.RootModule().Resources["echo.capture"]
+	if !ok {
+		return fmt.Errorf("echo.capture not found in state")
+	}
+	keyID := rs.Primary.Attributes["data.key_id"]
+	if keyID == "" {
+		return fmt.Errorf("echo.capture has no key_id")
+	}
+
+	client, err := clients.NewAcceptanceTestingKibanaScopedClient()
+	if err != nil {
+		return err
+	}
+
+	token, diags := fleet.GetEnrollmentToken(context.Background(), client.GetFleetClient(), clients.DefaultSpaceID, keyID)
+	if diags.HasError() {
+		return fmt.Errorf("unable to get enrollment token %q: %v", keyID, diags)
+	}
+	if token != nil && token.Active {
+		return fmt.Errorf("expected enrollment token %q to be revoked on close", keyID)
+	}
+	return nil
+}
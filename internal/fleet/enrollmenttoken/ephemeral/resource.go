@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ephemeral
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/enrollmenttoken"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwephemeral "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type tfModel struct {
+	entitycore.KibanaConnectionField
+	PolicyID      types.String `tfsdk:"policy_id"`
+	Name          types.String `tfsdk:"name"`
+	SpaceID       types.String `tfsdk:"space_id"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	KeyID         types.String `tfsdk:"key_id"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APIKeyID      types.String `tfsdk:"api_key_id"`
+}
+
+type closeState struct {
+	KeyID         string `json:"key_id"`
+	SpaceID       string `json:"space_id"`
+	RevokeOnClose bool   `json:"revoke_on_close"`
+}
+
+// revokeEnrollmentTokenFn is overridable in tests.
+var revokeEnrollmentTokenFn = fleet.RevokeEnrollmentToken
+
+func NewResource() fwephemeral.EphemeralResource {
+	return entitycore.NewKibanaEphemeralResource[tfModel, closeState](
+		"fleet_enrollment_token",
+		entitycore.KibanaEphemeralOptions[tfModel, closeState]{
+			Schema: getSchema,
+			Open:   openEnrollmentToken,
+			Close:  closeEnrollmentToken,
+		},
+	)
+}
+
+func getSchema(_ context.Context) eschema.Schema {
+	return eschema.Schema{
+		Description:         resourceDescription,
+		MarkdownDescription: resourceDescription,
+		Attributes: map[string]eschema.Attribute{
+			"policy_id": eschema.StringAttribute{
+				Description: enrollmenttoken.PolicyIDDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": eschema.StringAttribute{
+				Description: enrollmenttoken.NameDescription,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"space_id": eschema.StringAttribute{
+				Description: "An identifier for the space. If space_id is not provided, the default space is used.",
+				Optional:    true,
+			},
+			"revoke_on_close": eschema.BoolAttribute{
+				Description: "Whether to revoke the token once the Terraform run completes. Defaults to `true`. Set to `false` only when agents enroll after the run, as every plan and apply then leaves another active token on the agent policy.",
+				Optional:    true,
+			},
+			"key_id": eschema.StringAttribute{
+				Description: enrollmenttoken.KeyIDDescription,
+				Computed:    true,
+			},
+			"api_key": eschema.StringAttribute{
+				Description: enrollmenttoken.APIKeyDescription,
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"api_key_id": eschema.StringAttribute{
+				Description: enrollmenttoken.APIKeyIDDescription,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func openEnrollmentToken(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.OpenRequest[tfModel]) (entitycore.OpenResult[tfModel, closeState], diag.Diagnostics) {
+	model := req.Config
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(model.SpaceID) && model.SpaceID.ValueString() != "" {
+		spaceID = model.SpaceID.ValueString()
+	}
+
+	body := fleet.CreateEnrollmentTokenRequest{
+		PolicyID: model.PolicyID.ValueString(),
+	}
+	if typeutils.IsKnown(model.Name) {
+		body.Name = model.Name.ValueStringPointer()
+	}
+
+	token, diags := fleet.CreateEnrollmentToken(ctx, client.GetFleetClient(), spaceID, body)
+	if diags.HasError() {
+		return entitycore.OpenResult[tfModel, closeState]{}, diags
+	}
+
+	model.KeyID = types.StringValue(token.Id)
+	model.APIKey = types.StringValue(token.ApiKey)
+	model.APIKeyID = types.StringValue(token.ApiKeyId)
+
+	return entitycore.OpenResult[tfModel, closeState]{
+		Model: model,
+		CloseState: closeState{
+			KeyID:         token.Id,
+			SpaceID:       spaceID,
+			RevokeOnClose: revokeOnClose(model.RevokeOnClose),
+		},
+	}, diags
+}
+
+func closeEnrollmentToken(ctx context.Context, client *clients.KibanaScopedClient, req entitycore.CloseRequest[closeState]) (entitycore.CloseResponse, diag.Diagnostics) {
+	if !req.State.RevokeOnClose || req.State.KeyID == "" {
+		return entitycore.CloseResponse{}, nil
+	}
+	return entitycore.CloseResponse{}, revokeEnrollmentTokenFn(ctx, client.GetFleetClient(), req.State.SpaceID, req.State.KeyID)
+}
+
+// revokeOnClose reports whether the token is revoked on close. A token is
+// created on every plan and apply, so tokens are revoked unless the
+// configuration explicitly opts out.
+func revokeOnClose(v types.Bool) bool {
+	return !typeutils.IsKnown(v) || v.ValueBool()
+}
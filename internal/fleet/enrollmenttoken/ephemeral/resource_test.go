@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ephemeral
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseEnrollmentToken(t *testing.T) {
+	testCases := []struct {
+		name         string
+		state        closeState
+		expectRevoke bool
+	}{
+		{name: "revoke on close", state: closeState{KeyID: "key-1", SpaceID: "site-a", RevokeOnClose: true}, expectRevoke: true},
+		{name: "keep token", state: closeState{KeyID: "key-1", SpaceID: "site-a"}},
+		{name: "missing key id", state: closeState{RevokeOnClose: true}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var revoked []string
+			original := revokeEnrollmentTokenFn
+			revokeEnrollmentTokenFn = func(_ context.Context, _ *fleet.Client, spaceID, keyID string) diag.Diagnostics {
+				revoked = append(revoked, spaceID+"/"+keyID)
+				return nil
+			}
+			t.Cleanup(func() { revokeEnrollmentTokenFn = original })
+
+			_, diags := closeEnrollmentToken(context.Background(), &clients.KibanaScopedClient{}, entitycore.CloseRequest[closeState]{State: testCase.state})
+			require.False(t, diags.HasError())
+			if testCase.expectRevoke {
+				require.Equal(t, []string{"site-a/key-1"}, revoked)
+			} else {
+				require.Empty(t, revoked)
+			}
+		})
+	}
+}
+
+func TestRevokeOnClose(t *testing.T) {
+	require.True(t, revokeOnClose(types.BoolNull()))
+	require.True(t, revokeOnClose(types.BoolUnknown()))
+	require.True(t, revokeOnClose(types.BoolValue(true)))
+	require.False(t, revokeOnClose(types.BoolValue(false)))
+}
@@ -0,0 +1,26 @@
+variable "policy_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  policy_id   = var.policy_id
+  name        = "Ephemeral enrollment token policy ${var.policy_id}"
+  namespace   = "default"
+  description = "Agent Policy for testing the ephemeral enrollment token"
+}
+
+ephemeral "elasticstack_fleet_enrollment_token" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+  name      = "ephemeral"
+}
+
+provider "echo" {
+  data = ephemeral.elasticstack_fleet_enrollment_token.test
+}
+
+resource "echo" "capture" {}
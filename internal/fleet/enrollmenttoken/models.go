@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken
+
+import (
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type enrollmentTokenModel struct {
+	entitycore.ResourceTimeoutsField
+	ID               types.String `tfsdk:"id"`
+	KibanaConnection types.List   `tfsdk:"kibana_connection"`
+	KeyID            types.String `tfsdk:"key_id"`
+	PolicyID         types.String `tfsdk:"policy_id"`
+	Name             types.String `tfsdk:"name"`
+	SpaceID          types.String `tfsdk:"space_id"`
+	APIKey           types.String `tfsdk:"api_key"`
+	APIKeyID         types.String `tfsdk:"api_key_id"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (m enrollmentTokenModel) GetID() types.String             { return m.ID }
+func (m enrollmentTokenModel) GetResourceID() types.String     { return m.KeyID }
+func (m enrollmentTokenModel) GetSpaceID() types.String        { return m.SpaceID }
+func (m enrollmentTokenModel) GetKibanaConnection() types.List { return m.KibanaConnection }
+
+func (m *enrollmentTokenModel) populateFromAPI(spaceID string, data *kbapi.KibanaHTTPAPIsEnrollmentApiKey) {
+	m.ID = types.StringValue((&clients.CompositeID{ClusterID: spaceID, ResourceID: data.Id}).String())
+	m.KeyID = types.StringValue(data.Id)
+	m.SpaceID = types.StringValue(spaceID)
+	m.PolicyID = types.StringPointerValue(data.PolicyId)
+	m.Name = types.StringValue(TokenName(data))
+	m.APIKey = types.StringValue(data.ApiKey)
+	m.APIKeyID = types.StringValue(data.ApiKeyId)
+	m.CreatedAt = types.StringValue(data.CreatedAt)
+}
+
+// TokenName returns the name the token was created with. Fleet stores named
+// tokens as "<name> (<id>)" and unnamed tokens under their ID.
+func TokenName(data *kbapi.KibanaHTTPAPIsEnrollmentApiKey) string {
+	if data.Name == nil {
+		return data.Id
+	}
+	return strings.TrimSuffix(*data.Name, " ("+data.Id+")")
+}
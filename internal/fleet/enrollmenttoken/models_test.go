@@ -0,0 +1,47 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		apiName  *string
+		expected string
+	}{
+		{name: "named token", apiName: new("site-a (key-1)"), expected: "site-a"},
+		{name: "name containing parentheses", apiName: new("site (a) (key-1)"), expected: "site (a)"},
+		{name: "unnamed token", apiName: new("key-1"), expected: "key-1"},
+		{name: "missing name", expected: "key-1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, testCase.expected, TokenName(&kbapi.KibanaHTTPAPIsEnrollmentApiKey{Id: "key-1", Name: testCase.apiName}))
+		})
+	}
+}
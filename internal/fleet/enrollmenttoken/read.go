@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package enrollmenttoken
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func readEnrollmentToken(ctx context.Context, client *clients.KibanaScopedClient, resourceID, spaceID string, model enrollmentTokenModel) (enrollmentTokenModel, bool, diag.Diagnostics) {
+	token, diags := fleet.GetEnrollmentToken(ctx, client.GetFleetClient(), spaceID, resourceID)
+	if diags.HasError() {
+		return model, false, diags
+	}
+
+	// Revoked tokens are kept by Fleet as inactive and cannot enroll agents
+	// anymore, treat them as gone so that they are recreated.
+	if token == nil || !token.Active {
+		return model, false, diags
+	}
+
+	model.populateFromAPI(spaceID, token)
+	return model, true, diags
+}
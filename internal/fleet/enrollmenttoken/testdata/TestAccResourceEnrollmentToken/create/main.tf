@@ -0,0 +1,24 @@
+variable "policy_id" {
+  type = string
+}
+
+variable "token_name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  policy_id   = var.policy_id
+  name        = "Enrollment token policy ${var.policy_id}"
+  namespace   = "default"
+  description = "Agent Policy for testing the enrollment token resource"
+}
+
+resource "elasticstack_fleet_enrollment_token" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+  name      = var.token_name
+}
@@ -758,3 +758,73 @@ func TestAccResourceIntegration_destroyWithILMCrossDependency(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceIntegration_DeleteDataStreamsOnUninstall(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionIntegration, versionutils.FlavorAny)
+
+	const (
+		deletedDataStream = "logs-tcp.generic-tfaccdelete"
+		keptDataStream    = "logs-tcp.generic-tfacckeep"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			// Step 1: Install tcp@1.16.0 with data stream deletion restricted to
+			// one namespace, then create a data stream in each namespace.
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration.test", "delete_data_streams_on_uninstall", "true"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration.test", "delete_data_streams_namespaces.#", "1"),
+					func(_ *terraform.State) error {
+						client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+						if err != nil {
+							return err
+						}
+						for _, name := range []string{deletedDataStream, keptDataStream} {
+							if diags := esclient.PutDataStream(context.Background(), client, name); diags.HasError() {
+								return fmt.Errorf("failed to create data stream %s: %v", name, diags)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			// Step 2: Remove the resource from config. Only the data stream of the
+			// selected namespace is deleted along with the package.
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("empty_config"),
+				Check: func(_ *terraform.State) error {
+					client, err := clients.NewAcceptanceTestingElasticsearchScopedClient()
+					if err != nil {
+						return err
+					}
+					ctx := context.Background()
+
+					ds, diags := esclient.GetDataStream(ctx, client, deletedDataStream)
+					if diags.HasError() {
+						return fmt.Errorf("failed to get data stream %s: %v", deletedDataStream, diags)
+					}
+					if ds != nil {
+						return fmt.Errorf("data stream %s still exists after uninstall", deletedDataStream)
+					}
+
+					ds, diags = esclient.GetDataStream(ctx, client, keptDataStream)
+					if diags.HasError() {
+						return fmt.Errorf("failed to get data stream %s: %v", keptDataStream, diags)
+					}
+					if ds == nil {
+						return fmt.Errorf("data stream %s outside of delete_data_streams_namespaces was deleted", keptDataStream)
+					}
+					if diags := esclient.DeleteDataStream(ctx, client, keptDataStream); diags.HasError() {
+						return fmt.Errorf("failed to delete data stream %s: %v", keptDataStream, diags)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
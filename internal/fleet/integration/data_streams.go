@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// dataStreamCleanupRequested returns true when the data streams of the package
+// should be deleted when it is uninstalled.
+func dataStreamCleanupRequested(model integrationModel) bool {
+	return model.DeleteDataStreamsOnUninstall.ValueBool() && !model.SkipDestroy.ValueBool()
+}
+
+// dataStreamsForCleanup lists the names of the data streams installed by the
+// package, restricted to delete_data_streams_namespaces when it is set.
+func dataStreamsForCleanup(ctx context.Context, fleetClient *fleet.Client, model integrationModel, spaceID string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	namespaces := typeutils.SetTypeAs[string](ctx, model.DeleteDataStreamsNamespaces, path.Root("delete_data_streams_namespaces"), &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dataStreams, listDiags := fleet.ListDataStreams(ctx, fleetClient, spaceID)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return selectDataStreams(dataStreams, model.Name.ValueString(), namespaces), diags
+}
+
+// selectDataStreams returns the sorted names of the data streams of the named
+// package. An empty namespaces slice matches every namespace.
+func selectDataStreams(dataStreams []fleet.DataStream, packageName string, namespaces []string) []string {
+	var names []string
+	for _, ds := range dataStreams {
+		if ds.Package != packageName {
+			continue
+		}
+		if len(namespaces) > 0 && !slices.Contains(namespaces, ds.Namespace) {
+			continue
+		}
+		names = append(names, ds.Index)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// dataStreamCleanupWarning describes the data streams that will be deleted
+// along with the package.
+func dataStreamCleanupWarning(packageName string, names []string) diag.Diagnostic {
+	detail := fmt.Sprintf("No data streams of package %q currently match, so no data stream will be deleted.", packageName)
+	if len(names) > 0 {
+		detail = fmt.Sprintf(
+			"Destroying this resource will uninstall package %q and permanently delete the following data streams, along with their data:\n  - %s",
+			packageName, strings.Join(names, "\n  - "),
+		)
+	}
+	return diag.NewAttributeWarningDiagnostic(path.Root("delete_data_streams_on_uninstall"), "Data streams will be deleted", detail)
+}
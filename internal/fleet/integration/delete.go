@@ -117,12 +117,40 @@ func deleteIntegrationWithClients(
 		}
 
 		if isInstalledInMultipleSpaces(pkg, scope.id) {
-			return deleteKibanaAssetsWithFallback(ctx, fleetClient, name, version, scope.id, force)
+			if dataStreamCleanupRequested(model) {
+				diags.AddWarning(
+					"Data streams were not deleted",
+					fmt.Sprintf("Package %q remains installed in other spaces, so its data streams were kept.", name),
+				)
+			}
+			diags.Append(deleteKibanaAssetsWithFallback(ctx, fleetClient, name, version, scope.id, force)...)
+			return diags
+		}
+	}
+
+	// The data streams are listed before uninstalling, while Fleet can still
+	// attribute them to the package, and deleted once the uninstall succeeded
+	// so that a failed uninstall does not lose data.
+	var dataStreams []string
+	if dataStreamCleanupRequested(model) {
+		var listDiags diag.Diagnostics
+		dataStreams, listDiags = dataStreamsForCleanup(ctx, fleetClient, model, scope.id)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	uninstallDiags := fleet.Uninstall(ctx, fleetClient, name, version, scope.id, force)
 	diags.Append(uninstallDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(dataStreams) > 0 {
+		tflog.Debug(ctx, "Deleting data streams of uninstalled integration package", map[string]any{attrName: name, "data_streams": dataStreams})
+		diags.Append(fleet.DeleteDataStreams(ctx, fleetClient, dataStreams)...)
+	}
 	return diags
 }
 
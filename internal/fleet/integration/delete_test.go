@@ -27,6 +27,7 @@ import (
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -272,3 +273,63 @@ func TestDeleteIntegration_defaultSpacePreservesMultiSpaceGuard(t *testing.T) {
 	assert.NotContains(t, diags.Errors()[0].Detail(), `"statusCode":400`)
 	assertDiagnosticsDoNotContainInstallSpaceRejection(t, diags)
 }
+
+func TestSelectDataStreams(t *testing.T) {
+	t.Parallel()
+
+	dataStreams := []fleet.DataStream{
+		{Index: "logs-system.syslog-prod", Package: testPackageName, Namespace: "prod"},
+		{Index: "logs-system.auth-dev", Package: testPackageName, Namespace: "dev"},
+		{Index: "logs-system.auth-prod", Package: testPackageName, Namespace: "prod"},
+		{Index: "logs-tcp.generic-prod", Package: "tcp", Namespace: "prod"},
+	}
+
+	assert.Equal(t, []string{"logs-system.auth-dev", "logs-system.auth-prod", "logs-system.syslog-prod"}, selectDataStreams(dataStreams, testPackageName, nil))
+	assert.Equal(t, []string{"logs-system.auth-prod", "logs-system.syslog-prod"}, selectDataStreams(dataStreams, testPackageName, []string{"prod"}))
+	assert.Empty(t, selectDataStreams(dataStreams, "netflow", nil))
+}
+
+func TestDeleteIntegration_deletesDataStreamsAfterUninstall(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/fleet/data_streams":
+			fmt.Fprint(w, `{"data_streams":[
+				{"index":"logs-system.syslog-prod","package":"system","namespace":"prod"},
+				{"index":"logs-system.syslog-dev","package":"system","namespace":"dev"},
+				{"index":"logs-tcp.generic-prod","package":"tcp","namespace":"prod"}
+			]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == testPackageUninstallPath:
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/console/proxy":
+			deleted = append(deleted, r.URL.Query().Get("path"))
+			fmt.Fprint(w, `{"acknowledged":true}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	model := integrationModel{
+		Name:                         types.StringValue(testPackageName),
+		Version:                      types.StringValue(testPackageVersion),
+		SpaceID:                      types.StringValue(testSpaceID),
+		DeleteDataStreamsOnUninstall: types.BoolValue(true),
+		DeleteDataStreamsNamespaces:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+	}
+
+	diags := deleteIntegrationWithClients(t.Context(), &testMinVersionClient{supported: false}, newTestFleetClient(t, srv), model)
+
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.Equal(t, []string{
+		"GET /api/fleet/data_streams",
+		"DELETE " + testPackageUninstallPath,
+		"POST /api/console/proxy",
+	}, calls)
+	assert.Equal(t, []string{"/_data_stream/logs-system.syslog-prod"}, deleted)
+}
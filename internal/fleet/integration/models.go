@@ -36,17 +36,19 @@ const (
 type integrationModel struct {
 	entitycore.ResourceTimeoutsField
 
-	ID                        types.String `tfsdk:"id"`
-	KibanaConnection          types.List   `tfsdk:"kibana_connection"`
-	Name                      types.String `tfsdk:"name"`
-	Version                   types.String `tfsdk:"version"`
-	Force                     types.Bool   `tfsdk:"force"`
-	Prerelease                types.Bool   `tfsdk:"prerelease"`
-	IgnoreMappingUpdateErrors types.Bool   `tfsdk:"ignore_mapping_update_errors"`
-	SkipDataStreamRollover    types.Bool   `tfsdk:"skip_data_stream_rollover"`
-	IgnoreConstraints         types.Bool   `tfsdk:"ignore_constraints"`
-	SkipDestroy               types.Bool   `tfsdk:"skip_destroy"`
-	SpaceID                   types.String `tfsdk:"space_id"`
+	ID                           types.String `tfsdk:"id"`
+	KibanaConnection             types.List   `tfsdk:"kibana_connection"`
+	Name                         types.String `tfsdk:"name"`
+	Version                      types.String `tfsdk:"version"`
+	Force                        types.Bool   `tfsdk:"force"`
+	Prerelease                   types.Bool   `tfsdk:"prerelease"`
+	IgnoreMappingUpdateErrors    types.Bool   `tfsdk:"ignore_mapping_update_errors"`
+	SkipDataStreamRollover       types.Bool   `tfsdk:"skip_data_stream_rollover"`
+	IgnoreConstraints            types.Bool   `tfsdk:"ignore_constraints"`
+	SkipDestroy                  types.Bool   `tfsdk:"skip_destroy"`
+	SpaceID                      types.String `tfsdk:"space_id"`
+	DeleteDataStreamsOnUninstall types.Bool   `tfsdk:"delete_data_streams_on_uninstall"`
+	DeleteDataStreamsNamespaces  types.Set    `tfsdk:"delete_data_streams_namespaces"` // > types.String
 }
 
 func (m integrationModel) GetID() types.String {
@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ModifyPlan makes data stream deletion reviewable: when the resource is
+// destroyed with delete_data_streams_on_uninstall set, the data streams that
+// will be deleted are listed as a warning.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateModel integrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !dataStreamCleanupRequested(stateModel) || r.Client() == nil {
+		return
+	}
+
+	client, diags := r.Client().GetKibanaClient(ctx, stateModel.KibanaConnection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, diags := dataStreamsForCleanup(ctx, client.GetFleetClient(), stateModel, resolveSpaceScope(stateModel.SpaceID).id)
+	if diags.HasError() {
+		// The data streams are listed again during destroy, so an unavailable
+		// preview should not block planning.
+		for _, d := range diags {
+			resp.Diagnostics.AddWarning("Unable to preview data stream deletion", d.Summary()+": "+d.Detail())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(dataStreamCleanupWarning(stateModel.Name.ValueString(), names))
+}
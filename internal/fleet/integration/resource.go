@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.Resource                 = newIntegrationResource()
 	_ resource.ResourceWithUpgradeState = newIntegrationResource()
+	_ resource.ResourceWithModifyPlan   = newIntegrationResource()
 
 	// MinVersionIgnoreMappingUpdateErrors is the minimum version that supports the ignore_mapping_update_errors parameter
 	MinVersionIgnoreMappingUpdateErrors = version.Must(version.NewVersion("8.11.0"))
@@ -20,9 +20,14 @@ package integration
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func integrationSchema(_ context.Context) schema.Schema {
@@ -33,7 +38,8 @@ used to view available packages. Additional information for managing integration
 packages can be found [here](https://www.elastic.co/guide/en/fleet/current/install-uninstall-integration-assets.html).
 
 To prevent the package from being uninstalled when the resource is destroyed,
-set ` + "`skip_destroy` to `true`.",
+set ` + "`skip_destroy` to `true`. To also delete the data streams installed by the\n" +
+			"package when it is uninstalled, set `delete_data_streams_on_uninstall` to `true`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The ID of this resource.",
@@ -74,6 +80,22 @@ set ` + "`skip_destroy` to `true`.",
 				Description: "Set to true if you do not wish the integration package to be uninstalled at destroy time, and instead just remove the integration package from the Terraform state.",
 				Optional:    true,
 			},
+			"delete_data_streams_on_uninstall": schema.BoolAttribute{
+				Description: "Set to true to delete the data streams installed by the integration package, along with their data, when the package is uninstalled at destroy time. " +
+					"The data streams that will be deleted are listed as a warning in the destroy plan. They are deleted with the Elasticsearch delete data stream API, sent through the Kibana console proxy API, so the Kibana user needs the `delete_index` privilege on them. " +
+					"Has no effect when `skip_destroy` is set, or when the package remains installed in other spaces.",
+				Optional: true,
+			},
+			"delete_data_streams_namespaces": schema.SetAttribute{
+				Description: "Only delete the data streams of these namespaces when `delete_data_streams_on_uninstall` is set. Defaults to all namespaces.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					setvalidator.AlsoRequires(path.MatchRoot("delete_data_streams_on_uninstall")),
+				},
+			},
 			"space_id": schema.StringAttribute{
 				Description: "The Kibana space ID where this integration package should be installed.",
 				Optional:    true,
@@ -108,17 +108,19 @@ func (r *integrationResource) UpgradeState(ctx context.Context) map[int64]resour
 				}
 
 				upgradedState := integrationModel{
-					ID:                        priorState.ID,
-					KibanaConnection:          providerschema.KibanaConnectionNullList(),
-					Name:                      priorState.Name,
-					Version:                   priorState.Version,
-					Force:                     priorState.Force,
-					Prerelease:                priorState.Prerelease,
-					IgnoreMappingUpdateErrors: priorState.IgnoreMappingUpdateErrors,
-					SkipDataStreamRollover:    priorState.SkipDataStreamRollover,
-					IgnoreConstraints:         priorState.IgnoreConstraints,
-					SkipDestroy:               priorState.SkipDestroy,
-					SpaceID:                   types.StringNull(),
+					ID:                           priorState.ID,
+					KibanaConnection:             providerschema.KibanaConnectionNullList(),
+					Name:                         priorState.Name,
+					Version:                      priorState.Version,
+					Force:                        priorState.Force,
+					Prerelease:                   priorState.Prerelease,
+					IgnoreMappingUpdateErrors:    priorState.IgnoreMappingUpdateErrors,
+					SkipDataStreamRollover:       priorState.SkipDataStreamRollover,
+					IgnoreConstraints:            priorState.IgnoreConstraints,
+					SkipDestroy:                  priorState.SkipDestroy,
+					SpaceID:                      types.StringNull(),
+					DeleteDataStreamsOnUninstall: types.BoolNull(),
+					DeleteDataStreamsNamespaces:  types.SetNull(types.StringType),
 				}
 				upgradedState.Timeouts = priorState.Timeouts
 
@@ -0,0 +1,12 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_integration" "test" {
+  name                             = "tcp"
+  version                          = "1.16.0"
+  force                            = true
+  delete_data_streams_on_uninstall = true
+  delete_data_streams_namespaces   = ["tfaccdelete"]
+}
@@ -932,6 +932,45 @@ func TestAccResourceIntegrationPolicy_VersionUpdate(t *testing.T) {
 	})
 }
 
+func TestAccResourceIntegrationPolicy_UpgradeMode(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionIntegrationPolicy, versionutils.FlavorAny)
+
+	policyName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceIntegrationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"policy_name": config.StringVariable(policyName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration_policy.test_policy", "integration_version", "1.16.0"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration_policy.test_policy", "upgrade_mode", "upgrade"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("upgrade"),
+				ConfigVariables: config.Variables{
+					"policy_name": config.StringVariable(policyName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration_policy.test_policy", "name", policyName+"-integration"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_integration_policy.test_policy", "integration_version", "1.17.0"),
+					resource.TestCheckResourceAttrPair(
+						"elasticstack_fleet_integration_policy.test_policy", "agent_policy_id",
+						"elasticstack_fleet_agent_policy.test_policy", "policy_id",
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceIntegrationPolicy_importFromSpace(t *testing.T) {
 	versionutils.SkipIfUnsupported(t, minVersionSpaceIDs, versionutils.FlavorAny)
 
@@ -37,4 +37,11 @@ const (
 	attrDefaults           = "defaults"
 	attrStreams            = "streams"
 	attrCondition          = "condition"
+	attrUpgradeMode        = "upgrade_mode"
+)
+
+// Values accepted by the upgrade_mode attribute.
+const (
+	upgradeModeUpdate  = "update"
+	upgradeModeUpgrade = "upgrade"
 )
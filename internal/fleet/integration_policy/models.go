@@ -49,6 +49,7 @@ type integrationPolicyModel struct {
 	Inputs             InputsValue   `tfsdk:"inputs"` // > integrationPolicyInputsModel
 	VarsJSON           VarsJSONValue `tfsdk:"vars_json"`
 	SpaceIDs           types.Set     `tfsdk:"space_ids"`
+	UpgradeMode        types.String  `tfsdk:"upgrade_mode"`
 }
 
 func (model integrationPolicyModel) GetVersionRequirements(ctx context.Context) ([]entitycore.VersionRequirement, diag.Diagnostics) {
@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationpolicy
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	fleetutils "github.com/elastic/terraform-provider-elasticstack/internal/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ModifyPlan previews integration version changes made with
+// upgrade_mode = "upgrade". The var and stream differences reported by the
+// Fleet upgrade dry run are surfaced as warnings. Conflicts, and a failing dry
+// run, fail the plan as they would fail the apply.
+func (r *integrationPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planModel, stateModel integrationPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !upgradeRequested(planModel, stateModel) || r.Client() == nil {
+		return
+	}
+
+	client, diags := r.Client().GetKibanaClient(ctx, planModel.KibanaConnection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID, diags := fleetutils.GetOperationalSpaceFromState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fleetClient := client.GetFleetClient()
+	dryRun, diags := fleet.DryRunUpgradePackagePolicy(ctx, fleetClient, stateModel.PolicyID.ValueString(), spaceID, planModel.IntegrationVersion.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(upgradeConflicts(ctx, planModel, dryRun)...)
+	sensitive := upgradeSensitiveVars(ctx, fleetClient, planModel, stateModel, spaceID)
+	resp.Diagnostics.Append(upgradeDiffWarning(planModel, stateModel, dryRun, sensitive)...)
+}
@@ -37,6 +37,7 @@ var (
 	_ resource.ResourceWithConfigure    = newIntegrationPolicyResource()
 	_ resource.ResourceWithImportState  = newIntegrationPolicyResource()
 	_ resource.ResourceWithUpgradeState = newIntegrationPolicyResource()
+	_ resource.ResourceWithModifyPlan   = newIntegrationPolicyResource()
 )
 
 var (
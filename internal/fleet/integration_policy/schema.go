@@ -135,6 +135,17 @@ func getSchemaV3() schema.Schema {
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			attrUpgradeMode: schema.StringAttribute{
+				Description: "How a change of `integration_version` is applied. `update` (the default) replaces the policy with the configured version. " +
+					"`upgrade` previews the change with the Fleet upgrade dry run, reports the var and stream differences as plan warnings, " +
+					"and applies it through the Fleet package policy upgrade API before the configured values are written. " +
+					"The values of vars the package declares as secret or of type password are redacted from the warnings. " +
+					"A failing dry run, or conflicts it reports, fail the plan.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(upgradeModeUpdate, upgradeModeUpgrade),
+				},
+			},
 			"inputs": schema.MapNestedAttribute{
 				Description: "Integration inputs mapped by input ID.",
 				CustomType:  NewInputsType(NewInputType(getInputsAttributeTypes())),
@@ -77,6 +77,13 @@ func (r *integrationPolicyResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	if upgradeRequested(planModel, stateModel) {
+		resp.Diagnostics.Append(upgradePackagePolicy(ctx, fleetClient, planModel, spaceID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update using the operational space from STATE
 	// The API will handle adding/removing policy from spaces based on space_ids in body
 	policy, diags := fleet.UpdatePackagePolicy(ctx, fleetClient, policyID, spaceID, body)
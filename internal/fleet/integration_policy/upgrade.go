@@ -0,0 +1,306 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/policyshape"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const sensitiveValuePlaceholder = "(sensitive value)"
+
+// upgradeRequested reports whether the plan moves an existing policy to a
+// different integration version with upgrade_mode = "upgrade".
+func upgradeRequested(plan, state integrationPolicyModel) bool {
+	return plan.UpgradeMode.ValueString() == upgradeModeUpgrade &&
+		typeutils.IsKnown(plan.IntegrationVersion) &&
+		plan.IntegrationVersion.ValueString() != state.IntegrationVersion.ValueString()
+}
+
+// upgradePackagePolicy moves the policy to the planned integration version
+// through the Fleet upgrade API. Fleet upgrades policies to the installed
+// package version, so the planned version is installed first. The configured
+// values are written afterwards by the regular update, which keeps user-set
+// vars while the package defaults of the new version fill in the rest.
+func upgradePackagePolicy(ctx context.Context, client *fleet.Client, plan integrationPolicyModel, spaceID string) diag.Diagnostics {
+	policyID := plan.PolicyID.ValueString()
+
+	dryRun, diags := fleet.DryRunUpgradePackagePolicy(ctx, client, policyID, spaceID, plan.IntegrationVersion.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(upgradeConflicts(ctx, plan, dryRun)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fleet.InstallPackage(ctx, client, plan.IntegrationName.ValueString(), plan.IntegrationVersion.ValueString(), fleet.InstallPackageOptions{
+		SpaceID: spaceID,
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fleet.UpgradePackagePolicy(ctx, client, policyID, spaceID)...)
+	return diags
+}
+
+// upgradeConflicts reports the validation errors of the proposed policy, and
+// any configured input, stream or var the new package version no longer
+// declares.
+func upgradeConflicts(ctx context.Context, plan integrationPolicyModel, dryRun *fleet.PackagePolicyUpgradeDryRun) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var problems []string
+
+	if dryRun.Body != nil && dryRun.Body.Message != "" {
+		problems = append(problems, dryRun.Body.Message)
+	}
+
+	var proposed map[string]string
+	if len(dryRun.Diff) > 1 {
+		next := dryRun.Diff[1]
+		for _, e := range next.Errors {
+			problems = append(problems, fmt.Sprintf("%s: %s", e.Key, e.Message))
+		}
+		for _, name := range next.MissingVars {
+			problems = append(problems, fmt.Sprintf("%s: required variable is not set", name))
+		}
+		proposed = flattenUpgradeDiffItem(next)
+	}
+
+	if proposed != nil {
+		for _, key := range configuredUpgradeKeys(ctx, plan, &diags) {
+			if _, ok := proposed[key]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: not available in version %s", key, plan.IntegrationVersion.ValueString()))
+			}
+		}
+	}
+
+	if len(problems) == 0 && !dryRun.HasErrors {
+		return diags
+	}
+	if len(problems) == 0 {
+		problems = append(problems, "the dry run reported errors without details")
+	}
+
+	diags.AddAttributeError(
+		path.Root(attrIntegrationVersion),
+		"Integration policy upgrade conflict",
+		fmt.Sprintf("Upgrading integration policy %q to version %s would conflict with the current configuration:\n\n- %s",
+			plan.PolicyID.ValueString(), plan.IntegrationVersion.ValueString(), strings.Join(problems, "\n- ")),
+	)
+	return diags
+}
+
+// upgradeDiffWarning summarizes the var, input and stream changes between the
+// current and proposed policy of a dry run. The values of sensitive vars are
+// redacted.
+func upgradeDiffWarning(plan, state integrationPolicyModel, dryRun *fleet.PackagePolicyUpgradeDryRun, sensitive policyshape.SensitiveVars) diag.Diagnostics {
+	if len(dryRun.Diff) < 2 {
+		return nil
+	}
+
+	redacted := map[string]bool{}
+	redactedUpgradeKeys(redacted, dryRun.Diff[0], sensitive)
+	redactedUpgradeKeys(redacted, dryRun.Diff[1], sensitive)
+	changes := diffUpgradeItems(dryRun.Diff[0], dryRun.Diff[1], redacted)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	diags.AddAttributeWarning(
+		path.Root(attrIntegrationVersion),
+		"Integration policy upgrade changes",
+		fmt.Sprintf("Upgrading integration policy %q from version %s to %s changes the following values:\n\n%s",
+			state.PolicyID.ValueString(), state.IntegrationVersion.ValueString(), plan.IntegrationVersion.ValueString(), strings.Join(changes, "\n")),
+	)
+	return diags
+}
+
+// diffUpgradeItems returns one line per added (+), removed (-) or changed (~)
+// key, sorted by key. The values of redacted keys are replaced by a
+// placeholder.
+func diffUpgradeItems(current, proposed fleet.PackagePolicyUpgradeDiffItem, redacted map[string]bool) []string {
+	before := flattenUpgradeDiffItem(current)
+	after := flattenUpgradeDiffItem(proposed)
+
+	keys := slices.Collect(maps.Keys(before))
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []string
+	for _, key := range keys {
+		oldValue, hadOld := before[key]
+		newValue, hasNew := after[key]
+		valueOf := func(v string) string {
+			if redacted[key] {
+				return sensitiveValuePlaceholder
+			}
+			return v
+		}
+		switch {
+		case !hadOld:
+			changes = append(changes, fmt.Sprintf("+ %s = %s", key, valueOf(newValue)))
+		case !hasNew:
+			changes = append(changes, fmt.Sprintf("- %s", key))
+		case oldValue != newValue:
+			changes = append(changes, fmt.Sprintf("~ %s: %s => %s", key, valueOf(oldValue), valueOf(newValue)))
+		}
+	}
+	return changes
+}
+
+// flattenUpgradeDiffItem maps a non-simplified package policy to keys that
+// mirror the resource attributes, e.g. "inputs.<input id>.streams.<stream
+// id>.vars.<name>", with JSON encoded values.
+func flattenUpgradeDiffItem(item fleet.PackagePolicyUpgradeDiffItem) map[string]string {
+	result := make(map[string]string)
+	flattenUpgradeVars(result, "vars", item.Vars)
+
+	for _, input := range item.Inputs {
+		inputKey := "inputs." + simplifiedInputID(input)
+		result[inputKey+".enabled"] = fmt.Sprint(input.Enabled)
+		flattenUpgradeVars(result, inputKey+".vars", input.Vars)
+
+		for _, stream := range input.Streams {
+			streamKey := inputKey + ".streams." + stream.DataStream.Dataset
+			result[streamKey+".enabled"] = fmt.Sprint(stream.Enabled)
+			flattenUpgradeVars(result, streamKey+".vars", stream.Vars)
+		}
+	}
+	return result
+}
+
+func flattenUpgradeVars(result map[string]string, prefix string, vars map[string]fleet.PackagePolicyVar) {
+	for name, v := range vars {
+		encoded, err := json.Marshal(v.Value)
+		if err != nil {
+			encoded = []byte(fmt.Sprint(v.Value))
+		}
+		result[prefix+"."+name] = string(encoded)
+	}
+}
+
+// upgradeSensitiveVars returns the vars that the current or the target
+// package version declares as secret or of type password. It returns nil, so
+// that every var value is redacted, when the package metadata is unavailable.
+func upgradeSensitiveVars(ctx context.Context, client *fleet.Client, plan, state integrationPolicyModel, spaceID string) policyshape.SensitiveVars {
+	sensitive := policyshape.SensitiveVars{}
+	for _, version := range []string{state.IntegrationVersion.ValueString(), plan.IntegrationVersion.ValueString()} {
+		pkg, diags := getPackageInfo(ctx, client, plan.IntegrationName.ValueString(), version, spaceID)
+		if diags.HasError() || pkg == nil {
+			return nil
+		}
+		vars, diags := policyshape.PackageInfoToSensitiveVars(pkg)
+		if diags.HasError() {
+			return nil
+		}
+		maps.Copy(sensitive, vars)
+	}
+	return sensitive
+}
+
+// redactedUpgradeKeys adds to redacted the flattened keys of the vars of item
+// whose values must not be shown: the vars in sensitive, those of type
+// password and those holding a secret reference. A nil sensitive redacts every
+// var.
+func redactedUpgradeKeys(redacted map[string]bool, item fleet.PackagePolicyUpgradeDiffItem, sensitive policyshape.SensitiveVars) {
+	addVars := func(prefix, inputID, streamID string, vars map[string]fleet.PackagePolicyVar) {
+		for name, v := range vars {
+			if sensitive == nil || sensitive.Contains(inputID, streamID, name) || v.Type == "password" || isSecretRef(v.Value) {
+				redacted[prefix+"."+name] = true
+			}
+		}
+	}
+
+	addVars("vars", "", "", item.Vars)
+	for _, input := range item.Inputs {
+		inputID := simplifiedInputID(input)
+		inputKey := "inputs." + inputID
+		addVars(inputKey+".vars", inputID, "", input.Vars)
+		for _, stream := range input.Streams {
+			addVars(inputKey+".streams."+stream.DataStream.Dataset+".vars", inputID, stream.DataStream.Dataset, stream.Vars)
+		}
+	}
+}
+
+func isSecretRef(value any) bool {
+	ref, ok := value.(map[string]any)
+	return ok && ref["isSecretRef"] == true
+}
+
+// simplifiedInputID returns the key Fleet uses for an input in the
+// simplified package policy format.
+func simplifiedInputID(input fleet.PackagePolicyUpgradeInput) string {
+	if input.PolicyTemplate == "" {
+		return input.Type
+	}
+	return input.PolicyTemplate + "-" + input.Type
+}
+
+// configuredUpgradeKeys returns the flattened keys of the inputs, streams and
+// vars set in the configuration.
+func configuredUpgradeKeys(ctx context.Context, plan integrationPolicyModel, diags *diag.Diagnostics) []string {
+	var keys []string
+
+	if typeutils.IsKnown(plan.VarsJSON) {
+		sanitized, d := plan.VarsJSON.SanitizedValue()
+		diags.Append(d...)
+		var vars map[string]any
+		if err := json.Unmarshal([]byte(sanitized), &vars); err == nil {
+			for name := range vars {
+				keys = append(keys, "vars."+name)
+			}
+		}
+	}
+
+	for inputID, input := range plan.decodeInputs(ctx, diags) {
+		inputKey := "inputs." + inputID
+		keys = append(keys, inputKey+".enabled")
+		for name := range typeutils.NormalizedTypeToMap[any](input.Model.Vars, path.Root("inputs").AtMapKey(inputID).AtName(attrVars), diags) {
+			keys = append(keys, inputKey+".vars."+name)
+		}
+
+		for streamID, stream := range input.Streams {
+			streamKey := inputKey + ".streams." + streamID
+			keys = append(keys, streamKey+".enabled")
+			varsPath := path.Root("inputs").AtMapKey(inputID).AtName(attrStreams).AtMapKey(streamID).AtName(attrVars)
+			for name := range typeutils.NormalizedTypeToMap[any](stream.Vars, varsPath, diags) {
+				keys = append(keys, streamKey+".vars."+name)
+			}
+		}
+	}
+
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/policyshape"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const upgradeDryRunFixture = `{
+	"name": "tcp-1",
+	"hasErrors": false,
+	"diff": [
+		{
+			"name": "tcp-1",
+			"package": {"name": "tcp", "version": "1.16.0"},
+			"inputs": [{
+				"type": "tcp",
+				"policy_template": "tcp",
+				"enabled": true,
+				"streams": [{
+					"enabled": true,
+					"data_stream": {"type": "logs", "dataset": "tcp.generic"},
+					"vars": {
+						"listen_port": {"type": "integer", "value": 8080},
+						"tags": {"type": "text", "value": ["forwarded"]}
+					}
+				}]
+			}]
+		},
+		{
+			"name": "tcp-1",
+			"package": {"name": "tcp", "version": "1.17.0"},
+			"inputs": [{
+				"type": "tcp",
+				"policy_template": "tcp",
+				"enabled": true,
+				"streams": [{
+					"enabled": false,
+					"data_stream": {"type": "logs", "dataset": "tcp.generic"},
+					"vars": {
+						"listen_port": {"type": "integer", "value": 9090},
+						"ssl": {"type": "yaml"}
+					}
+				}]
+			}]
+		}
+	]
+}`
+
+func loadUpgradeDryRunFixture(t *testing.T) *fleet.PackagePolicyUpgradeDryRun {
+	t.Helper()
+	var dryRun fleet.PackagePolicyUpgradeDryRun
+	require.NoError(t, json.Unmarshal([]byte(upgradeDryRunFixture), &dryRun))
+	return &dryRun
+}
+
+func TestUpgradeRequested(t *testing.T) {
+	t.Parallel()
+
+	state := integrationPolicyModel{IntegrationVersion: types.StringValue("1.16.0")}
+
+	assert.True(t, upgradeRequested(integrationPolicyModel{
+		UpgradeMode:        types.StringValue(upgradeModeUpgrade),
+		IntegrationVersion: types.StringValue("1.17.0"),
+	}, state))
+	assert.False(t, upgradeRequested(integrationPolicyModel{
+		UpgradeMode:        types.StringValue(upgradeModeUpgrade),
+		IntegrationVersion: types.StringValue("1.16.0"),
+	}, state))
+	assert.False(t, upgradeRequested(integrationPolicyModel{
+		UpgradeMode:        types.StringNull(),
+		IntegrationVersion: types.StringValue("1.17.0"),
+	}, state))
+	assert.False(t, upgradeRequested(integrationPolicyModel{
+		UpgradeMode:        types.StringValue(upgradeModeUpgrade),
+		IntegrationVersion: types.StringUnknown(),
+	}, state))
+}
+
+func TestDiffUpgradeItems(t *testing.T) {
+	t.Parallel()
+
+	dryRun := loadUpgradeDryRunFixture(t)
+
+	assert.Equal(t, []string{
+		"~ inputs.tcp-tcp.streams.tcp.generic.enabled: true => false",
+		"~ inputs.tcp-tcp.streams.tcp.generic.vars.listen_port: 8080 => 9090",
+		"+ inputs.tcp-tcp.streams.tcp.generic.vars.ssl = null",
+		"- inputs.tcp-tcp.streams.tcp.generic.vars.tags",
+	}, diffUpgradeItems(dryRun.Diff[0], dryRun.Diff[1], nil))
+
+	assert.Equal(t, []string{
+		"~ inputs.tcp-tcp.streams.tcp.generic.enabled: true => false",
+		"~ inputs.tcp-tcp.streams.tcp.generic.vars.listen_port: (sensitive value) => (sensitive value)",
+		"+ inputs.tcp-tcp.streams.tcp.generic.vars.ssl = null",
+		"- inputs.tcp-tcp.streams.tcp.generic.vars.tags",
+	}, diffUpgradeItems(dryRun.Diff[0], dryRun.Diff[1], map[string]bool{"inputs.tcp-tcp.streams.tcp.generic.vars.listen_port": true}))
+}
+
+func TestRedactedUpgradeKeys(t *testing.T) {
+	t.Parallel()
+
+	item := fleet.PackagePolicyUpgradeDiffItem{
+		Vars: map[string]fleet.PackagePolicyVar{
+			"api_key": {Type: "password", Value: "changeme"},
+			"region":  {Type: "text", Value: "eu"},
+		},
+		Inputs: []fleet.PackagePolicyUpgradeInput{{
+			Type:           "tcp",
+			PolicyTemplate: "tcp",
+			Vars: map[string]fleet.PackagePolicyVar{
+				"token": {Type: "text", Value: map[string]any{"id": "abc", "isSecretRef": true}},
+			},
+			Streams: []fleet.PackagePolicyUpgradeStream{{
+				Vars: map[string]fleet.PackagePolicyVar{
+					"listen_port": {Type: "integer", Value: 8080},
+					"secret_tag":  {Type: "text", Value: "internal"},
+				},
+			}},
+		}},
+	}
+
+	item.Inputs[0].Streams[0].DataStream.Dataset = "tcp.generic"
+	sensitive := policyshape.SensitiveVars{{"tcp-tcp", "tcp.generic", "secret_tag"}: true}
+
+	redacted := map[string]bool{}
+	redactedUpgradeKeys(redacted, item, sensitive)
+	assert.Equal(t, map[string]bool{
+		"vars.api_key":              true,
+		"inputs.tcp-tcp.vars.token": true,
+		"inputs.tcp-tcp.streams.tcp.generic.vars.secret_tag": true,
+	}, redacted)
+
+	redacted = map[string]bool{}
+	redactedUpgradeKeys(redacted, item, nil)
+	assert.Len(t, redacted, 5)
+}
+
+func TestUpgradeConflicts(t *testing.T) {
+	t.Parallel()
+
+	plan := integrationPolicyModel{
+		PolicyID:           types.StringValue("pp-1"),
+		IntegrationVersion: types.StringValue("1.17.0"),
+		VarsJSON:           NewVarsJSONNull(),
+		Inputs:             NewInputsNull(getInputsElementType()),
+	}
+
+	t.Run("no conflicts", func(t *testing.T) {
+		t.Parallel()
+		diags := upgradeConflicts(context.Background(), plan, loadUpgradeDryRunFixture(t))
+		assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		dryRun := loadUpgradeDryRunFixture(t)
+		dryRun.HasErrors = true
+		dryRun.Diff[1].Errors = []fleet.PackagePolicyUpgradeValidation{{Key: "inputs.tcp-tcp.streams.tcp.generic.vars.ssl", Message: "Invalid YAML format"}}
+		dryRun.Diff[1].MissingVars = []string{"listen_address"}
+
+		diags := upgradeConflicts(context.Background(), plan, dryRun)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "inputs.tcp-tcp.streams.tcp.generic.vars.ssl: Invalid YAML format")
+		assert.Contains(t, diags[0].Detail(), "listen_address: required variable is not set")
+	})
+
+	t.Run("errors without details", func(t *testing.T) {
+		t.Parallel()
+		dryRun := loadUpgradeDryRunFixture(t)
+		dryRun.HasErrors = true
+
+		diags := upgradeConflicts(context.Background(), plan, dryRun)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "without details")
+	})
+}
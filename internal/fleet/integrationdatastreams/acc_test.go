@@ -0,0 +1,49 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationdatastreams_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var minVersionIntegrationDataStreams = version.Must(version.NewVersion("8.6.0"))
+
+func TestAccDataSourceIntegrationDataStreams(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionIntegrationDataStreams, versionutils.FlavorAny)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("data"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_integration_data_streams.test", "id"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_integration_data_streams.test", "space_id", "default"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_integration_data_streams.test", "package", "tcp"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_integration_data_streams.test", "data_streams.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationdatastreams
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type integrationDataStreamsDataSourceModel struct {
+	entitycore.KibanaConnectionField
+	ID          types.String `tfsdk:"id"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	Package     types.String `tfsdk:"package"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Type        types.String `tfsdk:"type"`
+	DataStreams types.List   `tfsdk:"data_streams"` // > dataStreamModel
+}
+
+type dataStreamModel struct {
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Dataset        types.String `tfsdk:"dataset"`
+	Namespace      types.String `tfsdk:"namespace"`
+	Package        types.String `tfsdk:"package"`
+	PackageVersion types.String `tfsdk:"package_version"`
+	SizeInBytes    types.Int64  `tfsdk:"size_in_bytes"`
+	LastActivity   types.String `tfsdk:"last_activity"`
+}
+
+func (model *integrationDataStreamsDataSourceModel) populateFromAPI(ctx context.Context, data []fleet.DataStream) (diags diag.Diagnostics) {
+	matches := func(filter types.String, value string) bool {
+		return !typeutils.IsKnown(filter) || filter.ValueString() == value
+	}
+
+	dataStreams := make([]fleet.DataStream, 0, len(data))
+	for _, ds := range data {
+		if matches(model.Package, ds.Package) && matches(model.Namespace, ds.Namespace) && matches(model.Type, ds.Type) {
+			dataStreams = append(dataStreams, ds)
+		}
+	}
+	slices.SortFunc(dataStreams, func(a, b fleet.DataStream) int { return strings.Compare(a.Index, b.Index) })
+
+	model.DataStreams = typeutils.SliceToListType(ctx, dataStreams, getDataStreamType(ctx), path.Root("data_streams"), &diags, newDataStreamModel)
+	return diags
+}
+
+func newDataStreamModel(data fleet.DataStream, _ typeutils.ListMeta) dataStreamModel {
+	lastActivity := types.StringNull()
+	if data.LastActivityMs > 0 {
+		lastActivity = types.StringValue(time.UnixMilli(data.LastActivityMs).UTC().Format(time.RFC3339))
+	}
+	return dataStreamModel{
+		Name:           types.StringValue(data.Index),
+		Type:           typeutils.NonEmptyStringishValue(data.Type),
+		Dataset:        typeutils.NonEmptyStringishValue(data.Dataset),
+		Namespace:      typeutils.NonEmptyStringishValue(data.Namespace),
+		Package:        typeutils.NonEmptyStringishValue(data.Package),
+		PackageVersion: typeutils.NonEmptyStringishValue(data.PackageVersion),
+		SizeInBytes:    types.Int64Value(data.SizeInBytes),
+		LastActivity:   lastActivity,
+	}
+}
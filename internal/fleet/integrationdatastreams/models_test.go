@@ -0,0 +1,58 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationdatastreams
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	data := []fleet.DataStream{
+		{Index: "logs-tcp.generic-prod", Type: "logs", Dataset: "tcp.generic", Namespace: "prod", Package: "tcp", PackageVersion: "1.16.0", SizeInBytes: 2048, LastActivityMs: 1700000000000},
+		{Index: "logs-tcp.generic-dev", Type: "logs", Dataset: "tcp.generic", Namespace: "dev", Package: "tcp", PackageVersion: "1.16.0"},
+		{Index: "metrics-system.cpu-prod", Type: "metrics", Dataset: "system.cpu", Namespace: "prod", Package: "system", PackageVersion: "1.60.0"},
+	}
+
+	model := integrationDataStreamsDataSourceModel{
+		Package:   types.StringValue("tcp"),
+		Namespace: types.StringNull(),
+		Type:      types.StringValue("logs"),
+	}
+	diags := model.populateFromAPI(ctx, data)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var dataStreams []dataStreamModel
+	require.False(t, model.DataStreams.ElementsAs(ctx, &dataStreams, false).HasError())
+	require.Len(t, dataStreams, 2)
+
+	assert.Equal(t, "logs-tcp.generic-dev", dataStreams[0].Name.ValueString())
+	assert.True(t, dataStreams[0].LastActivity.IsNull())
+	assert.Equal(t, "logs-tcp.generic-prod", dataStreams[1].Name.ValueString())
+	assert.Equal(t, "prod", dataStreams[1].Namespace.ValueString())
+	assert.Equal(t, int64(2048), dataStreams[1].SizeInBytes.ValueInt64())
+	assert.Equal(t, "2023-11-14T22:13:20Z", dataStreams[1].LastActivity.ValueString())
+}
@@ -0,0 +1,55 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationdatastreams
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, kbClient *clients.KibanaScopedClient, config integrationDataStreamsDataSourceModel) (integrationDataStreamsDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(config.SpaceID) {
+		spaceID = config.SpaceID.ValueString()
+	}
+
+	dataStreams, listDiags := fleet.ListDataStreams(ctx, kbClient.GetFleetClient(), spaceID)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	hash, err := typeutils.StringToHash(fmt.Sprintf("%s/%s/%s/%s", spaceID, config.Package.ValueString(), config.Namespace.ValueString(), config.Type.ValueString()))
+	if err != nil {
+		diags.AddError(err.Error(), "")
+		return config, diags
+	}
+	config.ID = types.StringPointerValue(hash)
+	config.SpaceID = types.StringValue(spaceID)
+
+	diags.Append(config.populateFromAPI(ctx, dataStreams)...)
+	return config, diags
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package integrationdatastreams
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"package": schema.StringAttribute{
+				Description: "Only list data streams installed by this integration package, for example `tcp`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "Only list data streams of this namespace.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list data streams of this type, for example `logs` or `metrics`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"data_streams": schema.ListNestedAttribute{
+				Description: "The matching data streams, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The data stream name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The data stream type.",
+							Computed:    true,
+						},
+						"dataset": schema.StringAttribute{
+							Description: "The data stream dataset.",
+							Computed:    true,
+						},
+						"namespace": schema.StringAttribute{
+							Description: "The data stream namespace.",
+							Computed:    true,
+						},
+						"package": schema.StringAttribute{
+							Description: "The integration package that installed the data stream.",
+							Computed:    true,
+						},
+						"package_version": schema.StringAttribute{
+							Description: "The version of the integration package that installed the data stream.",
+							Computed:    true,
+						},
+						"size_in_bytes": schema.Int64Attribute{
+							Description: "The total size of the data stream backing indices, in bytes.",
+							Computed:    true,
+						},
+						"last_activity": schema.StringAttribute{
+							Description: "The timestamp of the latest document of the data stream, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDataStreamType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["data_streams"].GetType().(attr.TypeWithElementType).ElementType()
+}
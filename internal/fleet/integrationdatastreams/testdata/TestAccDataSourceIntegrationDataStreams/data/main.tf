@@ -0,0 +1,18 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_integration" "tcp" {
+  name         = "tcp"
+  version      = "1.16.0"
+  force        = true
+  skip_destroy = false
+}
+
+data "elasticstack_fleet_integration_data_streams" "test" {
+  package   = "tcp"
+  namespace = "default"
+
+  depends_on = [elasticstack_fleet_integration.tcp]
+}
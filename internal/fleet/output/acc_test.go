@@ -43,6 +43,7 @@ import (
 var (
 	minVersionOutput       = version.Must(version.NewVersion("8.6.0"))
 	minVersionOutputSpaces = version.Must(version.NewVersion("9.1.0"))
+	minVersionOutputHealth = version.Must(version.NewVersion("8.14.0"))
 )
 
 //go:embed testdata/TestAccResourceOutputElasticsearchFromSDK/create/main.tf
@@ -806,6 +807,42 @@ func TestAccResourceOutputKafkaPartitions(t *testing.T) {
 	})
 }
 
+func TestAccResourceOutputWaitForHealthy(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionOutputHealth, versionutils.FlavorAny)
+
+	policyName := sdkacctest.RandString(22)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceOutputDestroy,
+		Steps: []resource.TestStep{
+			// A new output is not referenced by any agent policy yet, so no
+			// agent reports on it and the wait accepts it.
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("wait"),
+				ConfigVariables: config.Variables{
+					"policy_name": config.StringVariable(policyName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_output.test_output", "output_id", fmt.Sprintf("%s-wait-output", policyName)),
+					resource.TestCheckResourceAttr("elasticstack_fleet_output.test_output", "wait_for_healthy", "true"),
+				),
+			},
+			// On update, the wait requires an agent to report the output as
+			// healthy, which never happens without an agent shipping to it.
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("wait_update"),
+				ConfigVariables: config.Variables{
+					"policy_name": config.StringVariable(policyName),
+				},
+				ExpectError: regexp.MustCompile(`Output did not become healthy within timeout`),
+			},
+		},
+	})
+}
+
 func checkResourceOutputDestroy(s *terraform.State) error {
 	client, err := clients.NewAcceptanceTestingKibanaScopedClient()
 	if err != nil {
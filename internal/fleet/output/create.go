@@ -49,5 +49,15 @@ func createOutput(ctx context.Context, client *clients.KibanaScopedClient, req e
 		return entitycore.KibanaWriteResult[outputModel]{}, diags
 	}
 
+	waitDiags := waitForHealthyIfRequested(ctx, fleetClient, planModel, req.SpaceID, true)
+	diags.Append(waitDiags...)
+	if waitDiags.HasError() {
+		// A failed create is not saved to state, so remove the output rather
+		// than leaving it unmanaged. The wait may have exhausted the create
+		// timeout, hence the uncancelled context.
+		diags.Append(fleet.DeleteOutput(context.WithoutCancel(ctx), fleetClient, planModel.OutputID.ValueString(), req.SpaceID)...)
+		return entitycore.KibanaWriteResult[outputModel]{}, diags
+	}
+
 	return entitycore.KibanaWriteResult[outputModel]{Model: planModel}, diags
 }
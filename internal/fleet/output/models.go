@@ -50,6 +50,7 @@ type outputModel struct {
 	SyncIntegrations            types.Bool                      `tfsdk:"sync_integrations"`
 	SyncUninstalledIntegrations types.Bool                      `tfsdk:"sync_uninstalled_integrations"`
 	WriteToLogsStreams          types.Bool                      `tfsdk:"write_to_logs_streams"`
+	WaitForHealthy              types.Bool                      `tfsdk:"wait_for_healthy"`
 }
 
 func (model outputModel) GetID() types.String             { return model.ID }
@@ -117,6 +117,14 @@ func getSchema(_ context.Context) schema.Schema {
 					validators.AllowedIfDependentPathEquals(path.Root("type"), "remote_elasticsearch", validators.AllowedIfOptions{}),
 				},
 			},
+			"wait_for_healthy": schema.BoolAttribute{
+				Description: "Set to true to wait, after the output is created or updated, until an agent reports the output as healthy. " +
+					"Fleet only reports health for `remote_elasticsearch` outputs, and only once an agent policy referencing the output has been deployed. " +
+					"On create, an output that no agent has reported on yet is therefore accepted, while a degraded output is polled until it recovers. " +
+					"Fails when the create or update timeout expires first, reporting the latest health message, for example an unreachable remote cluster. " +
+					"A newly created output that does not become healthy is deleted again.",
+				Optional: true,
+			},
 			"ca_sha256": schema.StringAttribute{
 				Description: "Fingerprint of the Elasticsearch CA certificate.",
 				Optional:    true,
@@ -0,0 +1,25 @@
+variable "policy_name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_output" "test_output" {
+  name                 = "Updated Wait Output ${var.policy_name}"
+  output_id            = "${var.policy_name}-wait-output"
+  type                 = "elasticsearch"
+  default_integrations = false
+  default_monitoring   = false
+  hosts = [
+    "https://elasticsearch:9200"
+  ]
+  wait_for_healthy = true
+
+  timeouts = {
+    create = "20s"
+    update = "20s"
+  }
+}
@@ -55,5 +55,10 @@ func updateOutput(ctx context.Context, client *clients.KibanaScopedClient, req e
 		return entitycore.KibanaWriteResult[outputModel]{}, diags
 	}
 
+	diags.Append(waitForHealthyIfRequested(ctx, fleetClient, planModel, spaceID, false)...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[outputModel]{}, diags
+	}
+
 	return entitycore.KibanaWriteResult[outputModel]{Model: planModel}, diags
 }
@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package output
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/asyncutils"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const outputHealthPollInterval = 5 * time.Second
+
+// outputHealthGetter fetches the health of a Fleet output for polling.
+type outputHealthGetter func(ctx context.Context, outputID string) (*fleet.OutputHealth, diag.Diagnostics)
+
+func waitForOutputHealthy(ctx context.Context, outputID string, get outputHealthGetter, acceptUnknown bool) diag.Diagnostics {
+	return waitForOutputHealthyWithInterval(ctx, outputID, get, acceptUnknown, outputHealthPollInterval)
+}
+
+// waitForOutputHealthyWithInterval polls the output health with
+// [asyncutils.WaitForStateTransition] until an agent reports the output as
+// healthy. A degraded output is polled further, since it may recover once
+// agents pick up the new configuration. With acceptUnknown set, an output that
+// no agent has reported on yet counts as healthy as well.
+func waitForOutputHealthyWithInterval(ctx context.Context, outputID string, get outputHealthGetter, acceptUnknown bool, pollInterval time.Duration) diag.Diagnostics {
+	var (
+		lastHealth *fleet.OutputHealth
+		getDiags   diag.Diagnostics
+	)
+
+	stateChecker := func(ctx context.Context) (bool, error) {
+		health, diags := get(ctx, outputID)
+		if diags.HasError() {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			getDiags = diags
+			return false, errOutputHealthGetFailed
+		}
+		if health != nil {
+			lastHealth = health
+		}
+		if health == nil {
+			return false, nil
+		}
+		return health.State == fleet.OutputHealthStateHealthy ||
+			(acceptUnknown && health.State == fleet.OutputHealthStateUnknown), nil
+	}
+
+	err := asyncutils.WaitForStateTransition(ctx, "fleet_output", outputID, stateChecker, asyncutils.WithPollInterval(pollInterval))
+
+	var diags diag.Diagnostics
+	switch {
+	case err == nil:
+	case errors.Is(err, errOutputHealthGetFailed):
+		return getDiags
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		detail := fmt.Sprintf("No agent reported the health of output %q.", outputID)
+		if lastHealth != nil && lastHealth.State != fleet.OutputHealthStateUnknown {
+			detail = fmt.Sprintf("Output %q last reported state %q at %s: %s", outputID, lastHealth.State, lastHealth.Timestamp, lastHealth.Message)
+		}
+		diags.AddError("Output did not become healthy within timeout", detail)
+	default:
+		diags.AddError("Output health wait failed", err.Error())
+	}
+	return diags
+}
+
+// errOutputHealthGetFailed is a sentinel returned by the state checker to bail
+// out of the shared poll loop while preserving the original diagnostics.
+var errOutputHealthGetFailed = errors.New("output health get failed")
+
+// waitForHealthyIfRequested waits for the output to become healthy when
+// wait_for_healthy is set. Agents only report on an output once an agent
+// policy references it, which cannot be the case right after it is created,
+// so on create an output without any report yet is accepted.
+func waitForHealthyIfRequested(ctx context.Context, fleetClient *fleet.Client, model outputModel, spaceID string, created bool) diag.Diagnostics {
+	if !model.WaitForHealthy.ValueBool() {
+		return nil
+	}
+	return waitForOutputHealthy(ctx, model.OutputID.ValueString(), func(ctx context.Context, outputID string) (*fleet.OutputHealth, diag.Diagnostics) {
+		return fleet.GetOutputHealth(ctx, fleetClient, outputID, spaceID)
+	}, created)
+}
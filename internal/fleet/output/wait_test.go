@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package output
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForOutputHealthy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		health          []*fleet.OutputHealth
+		getDiags        diag.Diagnostics
+		acceptUnknown   bool
+		timeout         time.Duration
+		expectedSummary string
+		expectedDetail  string
+	}{
+		{
+			name: "healthy after recovering",
+			health: []*fleet.OutputHealth{
+				{State: fleet.OutputHealthStateUnknown},
+				{State: fleet.OutputHealthStateDegraded, Message: "connecting"},
+				{State: fleet.OutputHealthStateHealthy},
+			},
+		},
+		{
+			name:          "unknown accepted",
+			health:        []*fleet.OutputHealth{{State: fleet.OutputHealthStateUnknown}},
+			acceptUnknown: true,
+		},
+		{
+			name:            "times out while degraded",
+			health:          []*fleet.OutputHealth{{State: fleet.OutputHealthStateDegraded, Message: "dial tcp: lookup remote-es: no such host", Timestamp: "2024-05-01T10:00:00Z"}},
+			timeout:         50 * time.Millisecond,
+			expectedSummary: "Output did not become healthy within timeout",
+			expectedDetail:  "dial tcp: lookup remote-es: no such host",
+		},
+		{
+			name:            "degraded not accepted with unknown",
+			health:          []*fleet.OutputHealth{{State: fleet.OutputHealthStateDegraded, Message: "connecting"}},
+			acceptUnknown:   true,
+			timeout:         50 * time.Millisecond,
+			expectedSummary: "Output did not become healthy within timeout",
+			expectedDetail:  "connecting",
+		},
+		{
+			name:            "times out without report",
+			health:          []*fleet.OutputHealth{{State: fleet.OutputHealthStateUnknown}},
+			timeout:         50 * time.Millisecond,
+			expectedSummary: "Output did not become healthy within timeout",
+			expectedDetail:  "No agent reported the health",
+		},
+		{
+			name:            "get fails",
+			health:          []*fleet.OutputHealth{nil},
+			getDiags:        diag.Diagnostics{diag.NewErrorDiagnostic("Unexpected status code", "500")},
+			expectedSummary: "Unexpected status code",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			calls := 0
+			get := func(_ context.Context, outputID string) (*fleet.OutputHealth, diag.Diagnostics) {
+				require.Equal(t, "output-1", outputID)
+				health := tt.health[min(calls, len(tt.health)-1)]
+				calls++
+				return health, tt.getDiags
+			}
+
+			diags := waitForOutputHealthyWithInterval(ctx, "output-1", get, tt.acceptUnknown, time.Millisecond)
+			if tt.expectedSummary == "" {
+				require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			require.Equal(t, tt.expectedSummary, diags.Errors()[0].Summary())
+			require.Contains(t, diags.Errors()[0].Detail(), tt.expectedDetail)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputhealth
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type outputHealthModel struct {
+	entitycore.KibanaConnectionField
+	ID        types.String `tfsdk:"id"`
+	SpaceID   types.String `tfsdk:"space_id"`
+	OutputID  types.String `tfsdk:"output_id"`
+	State     types.String `tfsdk:"state"`
+	Healthy   types.Bool   `tfsdk:"healthy"`
+	Message   types.String `tfsdk:"message"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+func (model *outputHealthModel) populateFromAPI(data fleet.OutputHealth) {
+	model.State = types.StringValue(data.State)
+	model.Healthy = types.BoolValue(data.State == fleet.OutputHealthStateHealthy)
+	model.Message = typeutils.NonEmptyStringishValue(data.Message)
+	model.Timestamp = typeutils.NonEmptyStringishValue(data.Timestamp)
+}
@@ -0,0 +1,65 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputhealth
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Returns the latest health status reported by agents for a Fleet output, for example whether a remote Elasticsearch cluster is reachable. " +
+			"Fleet only reports health for `remote_elasticsearch` outputs; other outputs stay in the `UNKNOWN` state. " +
+			"See the [Fleet output API documentation](https://www.elastic.co/docs/api/doc/kibana/v9/group/endpoint-fleet-outputs) for more details.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"output_id": schema.StringAttribute{
+				Description: "The ID of the output.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "The health state of the output: `HEALTHY`, `DEGRADED`, or `UNKNOWN` when no agent has reported the health of the output yet.",
+				Computed:    true,
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether the output is reported as healthy.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The message of the latest health report, for example the connection error.",
+				Computed:    true,
+			},
+			"timestamp": schema.StringAttribute{
+				Description: "The time of the latest health report.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,23 @@
+variable "output_name" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_output" "test" {
+  name                 = "Output ${var.output_name}"
+  output_id            = "${var.output_name}-output"
+  type                 = "elasticsearch"
+  default_integrations = false
+  default_monitoring   = false
+  hosts = [
+    "https://elasticsearch:9200"
+  ]
+}
+
+data "elasticstack_fleet_output_health" "test" {
+  output_id = elasticstack_fleet_output.test.output_id
+}
@@ -0,0 +1,54 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package packagesds_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var minVersionPackages = version.Must(version.NewVersion("8.6.0"))
+
+func TestAccDataSourcePackages(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionPackages, versionutils.FlavorAny)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("data"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_packages.test", "id"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_packages.test", "packages.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_packages.test", "packages.0.name", "tcp"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_packages.test", "packages.0.installed_version", "1.16.0"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_packages.test", "packages.0.status", "installed"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_packages.test", "packages.0.latest_version"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_packages.test", "resolved_versions.tcp"),
+					resource.TestCheckResourceAttr("data.elasticstack_fleet_packages.pinned", "resolved_versions.tcp", "1.16.0"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_fleet_packages.history", "resolved_versions.tcp"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package packagesds
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type packagesDataSourceModel struct {
+	entitycore.KibanaConnectionField
+	ID                 types.String `tfsdk:"id"`
+	SpaceID            types.String `tfsdk:"space_id"`
+	Category           types.String `tfsdk:"category"`
+	Prerelease         types.Bool   `tfsdk:"prerelease"`
+	InstalledOnly      types.Bool   `tfsdk:"installed_only"`
+	NamePattern        types.String `tfsdk:"name_pattern"`
+	RegistryURL        types.String `tfsdk:"registry_url"`
+	VersionConstraints types.Map    `tfsdk:"version_constraints"` // > types.String
+	ResolvedVersions   types.Map    `tfsdk:"resolved_versions"`   // > types.String
+	Packages           types.List   `tfsdk:"packages"`            // > packageModel
+}
+
+type packageModel struct {
+	Name             types.String `tfsdk:"name"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+	Type             types.String `tfsdk:"type"`
+	Release          types.String `tfsdk:"release"`
+	Categories       types.List   `tfsdk:"categories"` // > types.String
+	LatestVersion    types.String `tfsdk:"latest_version"`
+	InstalledVersion types.String `tfsdk:"installed_version"`
+	Status           types.String `tfsdk:"status"`
+}
+
+// populateFromAPI fills the model from the catalog. versionHistory holds the
+// published versions of each package with a version constraint.
+func (model *packagesDataSourceModel) populateFromAPI(ctx context.Context, data []fleet.PackageListItem, versionHistory map[string][]string) (diags diag.Diagnostics) {
+	var namePattern *regexp.Regexp
+	if typeutils.IsKnown(model.NamePattern) {
+		var err error
+		namePattern, err = regexp.Compile(model.NamePattern.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_pattern"), "Invalid name pattern", err.Error())
+			return diags
+		}
+	}
+
+	resolved := make(map[string]string)
+	constraints := typeutils.MapTypeAs[string](ctx, model.VersionConstraints, path.Root("version_constraints"), &diags)
+	for name, constraint := range constraints {
+		v, d := resolveVersion(data, name, constraint, versionHistory[name])
+		diags.Append(d...)
+		resolved[name] = v
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	packages := make([]fleet.PackageListItem, 0, len(data))
+	for _, pkg := range data {
+		if typeutils.IsKnown(model.Category) && !slices.Contains(pkg.Categories, model.Category.ValueString()) {
+			continue
+		}
+		if model.InstalledOnly.ValueBool() && pkg.InstalledVersion() == "" {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(pkg.Name) {
+			continue
+		}
+		packages = append(packages, pkg)
+	}
+	slices.SortFunc(packages, func(a, b fleet.PackageListItem) int { return strings.Compare(a.Name, b.Name) })
+
+	model.ResolvedVersions = typeutils.MapValueFrom(ctx, resolved, types.StringType, path.Root("resolved_versions"), &diags)
+	model.Packages = typeutils.SliceToListType(ctx, packages, getPackageType(ctx), path.Root("packages"), &diags, newPackageModel(ctx))
+	return diags
+}
+
+func newPackageModel(ctx context.Context) func(fleet.PackageListItem, typeutils.ListMeta) packageModel {
+	return func(data fleet.PackageListItem, meta typeutils.ListMeta) packageModel {
+		categories := data.Categories
+		if categories == nil {
+			categories = []string{}
+		}
+		return packageModel{
+			Name:             types.StringValue(data.Name),
+			Title:            typeutils.NonEmptyStringishValue(data.Title),
+			Description:      typeutils.NonEmptyStringishValue(data.Description),
+			Type:             typeutils.NonEmptyStringishValue(data.Type),
+			Release:          typeutils.NonEmptyStringishValue(data.Release),
+			Categories:       typeutils.SliceToListTypeString(ctx, categories, meta.Path.AtName("categories"), meta.Diags),
+			LatestVersion:    typeutils.NonEmptyStringishValue(data.Version),
+			InstalledVersion: typeutils.NonEmptyStringishValue(data.InstalledVersion()),
+			Status:           typeutils.NonEmptyStringishValue(data.Status),
+		}
+	}
+}
+
+// resolveVersion returns the highest version of the named package satisfying
+// the constraint. The candidates are the published versions of the package,
+// the latest version in the catalog and the installed version.
+func resolveVersion(packages []fleet.PackageListItem, name, constraint string, history []string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	constraintPath := path.Root("version_constraints").AtMapKey(name)
+
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		diags.AddAttributeError(constraintPath, "Invalid version constraint", err.Error())
+		return "", diags
+	}
+
+	idx := slices.IndexFunc(packages, func(p fleet.PackageListItem) bool { return p.Name == name })
+	if idx < 0 {
+		diags.AddAttributeError(constraintPath, "Package not found", fmt.Sprintf("Package %q is not in the Fleet catalog.", name))
+		return "", diags
+	}
+	pkg := packages[idx]
+
+	var best *version.Version
+	candidates := slices.Concat(history, []string{pkg.Version, pkg.InstalledVersion()})
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		v, err := version.NewVersion(candidate)
+		if err != nil || !constraints.Check(v) {
+			continue
+		}
+		if best == nil || v.GreaterThan(best) {
+			best = v
+		}
+	}
+
+	if best == nil {
+		diags.AddAttributeError(
+			constraintPath,
+			"No matching package version",
+			fmt.Sprintf("No published version of package %q satisfies %q. Latest version: %s.", name, constraint, pkg.Version),
+		)
+		return "", diags
+	}
+	return best.Original(), diags
+}
@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package packagesds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog() []fleet.PackageListItem {
+	return []fleet.PackageListItem{
+		{Name: "udp", Version: "2.0.0", Categories: []string{"network"}, Status: fleet.PackageStatusInstalled, InstallationInfo: &fleet.PackageInstallationInfo{Version: "1.9.2"}},
+		{Name: "tcp", Version: "1.17.0", Categories: []string{"network", "custom"}, Status: fleet.PackageStatusInstalled, InstallationInfo: &fleet.PackageInstallationInfo{Version: "1.16.0"}},
+		{Name: "aws", Version: "3.1.0", Categories: []string{"aws"}, Status: fleet.PackageStatusNotInstalled},
+	}
+}
+
+func testVersionHistory() map[string][]string {
+	return map[string][]string{
+		"aws": {"2.4.0", "2.6.1", "2.7.0-beta1", "3.0.0", "3.1.0"},
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		pkg        string
+		constraint string
+		expected   string
+		errSummary string
+	}{
+		{name: "latest matches", pkg: "tcp", constraint: "~> 1.16", expected: "1.17.0"},
+		{name: "older release from the version history", pkg: "aws", constraint: "~> 2.4", expected: "2.6.1"},
+		{name: "history without a match", pkg: "aws", constraint: "~> 1.0", errSummary: "No matching package version"},
+		{name: "installed matches when latest is a new major", pkg: "udp", constraint: "~> 1.9", expected: "1.9.2"},
+		{name: "exact installed version", pkg: "tcp", constraint: "= 1.16.0", expected: "1.16.0"},
+		{name: "no candidate", pkg: "tcp", constraint: "< 1.0", errSummary: "No matching package version"},
+		{name: "unknown package", pkg: "netflow", constraint: ">= 1.0", errSummary: "Package not found"},
+		{name: "invalid constraint", pkg: "tcp", constraint: "latest", errSummary: "Invalid version constraint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v, diags := resolveVersion(testCatalog(), tt.pkg, tt.constraint, testVersionHistory()[tt.pkg])
+			if tt.errSummary != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, tt.errSummary, diags[0].Summary())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestPopulateFromAPI(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	model := packagesDataSourceModel{
+		Category:      types.StringValue("network"),
+		InstalledOnly: types.BoolValue(true),
+		NamePattern:   types.StringValue("^[tu]"),
+		VersionConstraints: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"aws": types.StringValue("~> 3.0"),
+		}),
+	}
+	diags := model.populateFromAPI(ctx, testCatalog(), testVersionHistory())
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var packages []packageModel
+	require.False(t, model.Packages.ElementsAs(ctx, &packages, false).HasError())
+	require.Len(t, packages, 2)
+	assert.Equal(t, "tcp", packages[0].Name.ValueString())
+	assert.Equal(t, "1.16.0", packages[0].InstalledVersion.ValueString())
+	assert.Equal(t, "1.17.0", packages[0].LatestVersion.ValueString())
+	assert.Equal(t, "udp", packages[1].Name.ValueString())
+
+	// Constraints are resolved against the whole catalog.
+	assert.Equal(t, types.StringValue("3.1.0"), model.ResolvedVersions.Elements()["aws"])
+}
+
+func TestPopulateFromAPI_invalidNamePattern(t *testing.T) {
+	t.Parallel()
+
+	model := packagesDataSourceModel{
+		NamePattern:        types.StringValue("["),
+		VersionConstraints: types.MapNull(types.StringType),
+	}
+	diags := model.populateFromAPI(context.Background(), testCatalog(), nil)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid name pattern", diags[0].Summary())
+}
@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package packagesds
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, kbClient *clients.KibanaScopedClient, config packagesDataSourceModel) (packagesDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(config.SpaceID) {
+		spaceID = config.SpaceID.ValueString()
+	}
+	prerelease := config.Prerelease.ValueBool()
+
+	// The filters are applied locally, so that version constraints can be
+	// resolved against the whole catalog.
+	packages, listDiags := fleet.ListPackages(ctx, kbClient.GetFleetClient(), spaceID, prerelease)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	hash, err := typeutils.StringToHash(fmt.Sprintf("%s/%t", spaceID, prerelease))
+	if err != nil {
+		diags.AddError(err.Error(), "")
+		return config, diags
+	}
+	config.ID = types.StringPointerValue(hash)
+	config.SpaceID = types.StringValue(spaceID)
+
+	versionHistory, historyDiags := readVersionHistory(ctx, kbClient, config, prerelease)
+	diags.Append(historyDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	diags.Append(config.populateFromAPI(ctx, packages, versionHistory)...)
+	return config, diags
+}
+
+// registryHTTPClient reads the package registry. It does not reuse the Kibana
+// client, whose transport would send the Kibana credentials to the registry.
+var registryHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// readVersionHistory returns the published versions of every package with a
+// version constraint, limited to the versions compatible with the Kibana
+// version.
+func readVersionHistory(ctx context.Context, kbClient *clients.KibanaScopedClient, config packagesDataSourceModel, prerelease bool) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	constraints := typeutils.MapTypeAs[string](ctx, config.VersionConstraints, path.Root("version_constraints"), &diags)
+	if len(constraints) == 0 || diags.HasError() {
+		return nil, diags
+	}
+
+	kibanaVersion, flavor, statusDiags := kibanaoapi.GetKibanaStatus(ctx, kbClient.GetKibanaOapiClient().API)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if flavor == clients.ServerlessFlavor {
+		// Serverless projects always run the latest Kibana.
+		kibanaVersion = ""
+	}
+
+	registryURL := fleet.DefaultPackageRegistryURL
+	if typeutils.IsKnown(config.RegistryURL) {
+		registryURL = config.RegistryURL.ValueString()
+	}
+
+	history := make(map[string][]string, len(constraints))
+	for name := range constraints {
+		versions, d := fleet.ListRegistryPackageVersions(ctx, registryHTTPClient, fleet.RegistryVersionsRequest{
+			RegistryURL:   registryURL,
+			Name:          name,
+			KibanaVersion: kibanaVersion,
+			Prerelease:    prerelease,
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		history[name] = versions
+	}
+	return history, diags
+}
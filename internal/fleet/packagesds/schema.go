@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package packagesds
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"category": schema.StringAttribute{
+				Description: "Only list packages of this category, for example `security`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"prerelease": schema.BoolAttribute{
+				Description: "Include prerelease versions. When enabled, the latest version of a package may be a prerelease.",
+				Optional:    true,
+			},
+			"installed_only": schema.BoolAttribute{
+				Description: "Only list installed packages.",
+				Optional:    true,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description: "Only list packages whose name matches this regular expression, for example `^aws`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version_constraints": schema.MapAttribute{
+				Description: "Version constraints to resolve, keyed by package name, for example `{ tcp = \"~> 1.16\" }`. Constraints are resolved against every published version of the package that is compatible with the Kibana version, regardless of the other filters.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"registry_url": schema.StringAttribute{
+				Description: "The package registry the version history used by `version_constraints` is read from. Set it when Kibana is configured with a custom registry. Defaults to `" + fleet.DefaultPackageRegistryURL + "`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resolved_versions": schema.MapAttribute{
+				Description: "The highest version satisfying each entry of `version_constraints`, keyed by package name.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"packages": schema.ListNestedAttribute{
+				Description: "The matching packages, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The package name.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The package title.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The package description.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The package type, for example `integration` or `input`.",
+							Computed:    true,
+						},
+						"release": schema.StringAttribute{
+							Description: "The release stage of the latest version, for example `ga` or `beta`.",
+							Computed:    true,
+						},
+						"categories": schema.ListAttribute{
+							Description: "The package categories.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"latest_version": schema.StringAttribute{
+							Description: "The latest version available in the catalog.",
+							Computed:    true,
+						},
+						"installed_version": schema.StringAttribute{
+							Description: "The installed version, if the package is installed.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The install status of the package, for example `installed` or `not_installed`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPackageType(ctx context.Context) attr.Type {
+	return getDataSourceSchema(ctx).Attributes["packages"].GetType().(attr.TypeWithElementType).ElementType()
+}
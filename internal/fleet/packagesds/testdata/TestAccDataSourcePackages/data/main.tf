@@ -0,0 +1,37 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_integration" "tcp" {
+  name         = "tcp"
+  version      = "1.16.0"
+  force        = true
+  skip_destroy = false
+}
+
+data "elasticstack_fleet_packages" "test" {
+  installed_only = true
+  name_pattern   = "^tcp$"
+  version_constraints = {
+    tcp = ">= 1.16.0"
+  }
+
+  depends_on = [elasticstack_fleet_integration.tcp]
+}
+
+data "elasticstack_fleet_packages" "pinned" {
+  version_constraints = {
+    tcp = "= 1.16.0"
+  }
+
+  depends_on = [elasticstack_fleet_integration.tcp]
+}
+
+data "elasticstack_fleet_packages" "history" {
+  version_constraints = {
+    tcp = "< 1.16.0"
+  }
+
+  depends_on = [elasticstack_fleet_integration.tcp]
+}
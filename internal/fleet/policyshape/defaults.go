@@ -152,8 +152,10 @@ func (dataStreams apiDatastreams) defaults() (map[string]map[string]InputDefault
 type apiVars []apiVar
 type apiVar struct {
 	Name    string `json:"name"`
+	Type    string `json:"type"`
 	Default any    `json:"default"`
 	Multi   bool   `json:"multi"`
+	Secret  bool   `json:"secret"`
 }
 
 func (v apiVars) defaults() (jsontypes.Normalized, diag.Diagnostics) {
@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package policyshape
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SensitiveVars holds the vars a package declares as secret or of type
+// password, keyed by input ID, stream ID and var name. Package level vars have
+// an empty input and stream ID, input level vars an empty stream ID.
+type SensitiveVars map[[3]string]bool
+
+// Contains reports whether the named var of the given input and stream is
+// sensitive.
+func (s SensitiveVars) Contains(inputID, streamID, name string) bool {
+	return s[[3]string{inputID, streamID, name}]
+}
+
+func (s SensitiveVars) add(inputID, streamID string, vars apiVars) {
+	for _, v := range vars {
+		if v.Secret || v.Type == "password" {
+			s[[3]string{inputID, streamID, v.Name}] = true
+		}
+	}
+}
+
+// PackageInfoToSensitiveVars collects the vars the package metadata declares
+// as secret or of type password, using the same input and stream IDs as
+// PackageInfoToDefaults.
+func PackageInfoToSensitiveVars(pkg *kbapi.KibanaHTTPAPIsGetPackageInfo) (SensitiveVars, diag.Diagnostics) {
+	sensitive := SensitiveVars{}
+
+	vars, diags := varsFromPackageInfo(pkg)
+	if diags.HasError() {
+		return nil, diags
+	}
+	sensitive.add("", "", vars)
+
+	policyTemplates, dataStreams, d := policyTemplateAndDataStreamsFromPackageInfo(pkg)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, policyTemplate := range policyTemplates {
+		for _, inputTemplate := range policyTemplate.Inputs {
+			sensitive.add(inputID(policyTemplate.Name, inputTemplate.Type), "", inputTemplate.Vars)
+		}
+		if policyTemplate.Input != "" {
+			sensitive.add(inputID(policyTemplate.Name, policyTemplate.Input), streamID(pkg.Name, policyTemplate.Name), policyTemplate.Vars)
+		}
+
+		for _, dataStream := range dataStreams {
+			for _, stream := range dataStream.Streams {
+				sensitive.add(inputID(policyTemplate.Name, stream.Input), dataStream.Dataset, stream.Vars)
+			}
+		}
+	}
+
+	return sensitive, diags
+}
@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package policyshape
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/generated/kbapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfoToSensitiveVars_Kafka(t *testing.T) {
+	var wrapper struct {
+		Item kbapi.KibanaHTTPAPIsGetPackageInfo `json:"item"`
+	}
+	require.NoError(t, json.Unmarshal(kafkaIntegrationJSON, &wrapper))
+
+	sensitive, diags := PackageInfoToSensitiveVars(&wrapper.Item)
+	require.False(t, diags.HasError(), "Expected no error but got: %v", diags)
+
+	assert.True(t, sensitive.Contains("kafka-jolokia/metrics", "", "password"))
+	assert.True(t, sensitive.Contains("kafka-jolokia/metrics", "", "ssl.key_passphrase"))
+	assert.True(t, sensitive.Contains("kafka-kafka/metrics", "kafka.consumergroup", "password"))
+	assert.False(t, sensitive.Contains("kafka-jolokia/metrics", "", "hosts"))
+	assert.False(t, sensitive.Contains("kafka-kafka/metrics", "kafka.consumergroup", "username"))
+}
+
+func TestPackageInfoToSensitiveVars_Secret(t *testing.T) {
+	var pkg kbapi.KibanaHTTPAPIsGetPackageInfo
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "tcp",
+		"policy_templates": [{"name": "tcp", "input": "tcp", "vars": [
+			{"name": "api_token", "type": "text", "secret": true},
+			{"name": "listen_port", "type": "integer"}
+		]}]
+	}`), &pkg))
+
+	sensitive, diags := PackageInfoToSensitiveVars(&pkg)
+	require.False(t, diags.HasError(), "Expected no error but got: %v", diags)
+
+	assert.True(t, sensitive.Contains("tcp-tcp", "tcp.tcp", "api_token"))
+	assert.False(t, sensitive.Contains("tcp-tcp", "tcp.tcp", "listen_port"))
+
+	sensitive, diags = PackageInfoToSensitiveVars(nil)
+	require.False(t, diags.HasError(), "Expected no error but got: %v", diags)
+	assert.Empty(t, sensitive)
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var minVersionDeleteUnenrolledAgents = version.Must(version.NewVersion("8.17.0"))
+
+func TestAccResourceFleetSettings(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionDeleteUnenrolledAgents, versionutils.FlavorAny)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("elasticstack_fleet_settings.test", "id"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "prerelease_integrations_enabled", "true"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "delete_unenrolled_agents", "false"),
+					resource.TestCheckResourceAttrSet("elasticstack_fleet_settings.test", "secret_storage_requirements_met"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "skip_delete", "false"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "prerelease_integrations_enabled", "false"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "delete_unenrolled_agents", "true"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("unmanaged"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_settings.test", "prerelease_integrations_enabled", "false"),
+					resource.TestCheckNoResourceAttr("elasticstack_fleet_settings.test", "delete_unenrolled_agents"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package settings
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type settingsModel struct {
+	entitycore.ResourceTimeoutsField
+	ID                                 types.String `tfsdk:"id"`
+	KibanaConnection                   types.List   `tfsdk:"kibana_connection"`
+	PrereleaseIntegrationsEnabled      types.Bool   `tfsdk:"prerelease_integrations_enabled"`
+	DeleteUnenrolledAgents             types.Bool   `tfsdk:"delete_unenrolled_agents"`
+	SecretStorageRequirementsMet       types.Bool   `tfsdk:"secret_storage_requirements_met"`
+	OutputSecretStorageRequirementsMet types.Bool   `tfsdk:"output_secret_storage_requirements_met"`
+	SkipDelete                         types.Bool   `tfsdk:"skip_delete"`
+}
+
+func (m settingsModel) GetID() types.String             { return m.ID }
+func (m settingsModel) GetResourceID() types.String     { return m.ID }
+func (m settingsModel) GetSpaceID() types.String        { return types.StringValue("") }
+func (m settingsModel) GetKibanaConnection() types.List { return m.KibanaConnection }
+
+// IsUnscopedSpace implements entitycore.KibanaUnscopedSpace.
+func (m settingsModel) IsUnscopedSpace() bool { return true }
+
+var (
+	_ entitycore.KibanaResourceModel = settingsModel{}
+	_ entitycore.KibanaUnscopedSpace = settingsModel{}
+)
+
+func (m settingsModel) toAPIModel() fleet.UpdateSettingsRequest {
+	var body fleet.UpdateSettingsRequest
+	if typeutils.IsKnown(m.PrereleaseIntegrationsEnabled) {
+		body.PrereleaseIntegrationsEnabled = m.PrereleaseIntegrationsEnabled.ValueBoolPointer()
+	}
+	if typeutils.IsKnown(m.DeleteUnenrolledAgents) {
+		body.DeleteUnenrolledAgents = &fleet.DeleteUnenrolledAgentsSettings{Enabled: m.DeleteUnenrolledAgents.ValueBool()}
+	}
+	return body
+}
+
+// toResetAPIModel returns the request resetting the managed settings to their
+// Fleet defaults, and whether any setting is managed.
+func (m settingsModel) toResetAPIModel() (fleet.UpdateSettingsRequest, bool) {
+	var body fleet.UpdateSettingsRequest
+	if typeutils.IsKnown(m.PrereleaseIntegrationsEnabled) {
+		body.PrereleaseIntegrationsEnabled = new(false)
+	}
+	if typeutils.IsKnown(m.DeleteUnenrolledAgents) {
+		body.DeleteUnenrolledAgents = &fleet.DeleteUnenrolledAgentsSettings{Enabled: false}
+	}
+	return body, body.PrereleaseIntegrationsEnabled != nil || body.DeleteUnenrolledAgents != nil
+}
+
+// populateFromAPI refreshes the model from the Fleet settings. Settings that
+// are null in the model are not managed and stay null.
+func (m *settingsModel) populateFromAPI(data fleet.Settings) {
+	m.ID = types.StringValue(data.ID)
+	if typeutils.IsKnown(m.PrereleaseIntegrationsEnabled) {
+		m.PrereleaseIntegrationsEnabled = types.BoolValue(data.PrereleaseIntegrationsEnabled)
+	}
+	if typeutils.IsKnown(m.DeleteUnenrolledAgents) {
+		m.DeleteUnenrolledAgents = types.BoolValue(data.DeleteUnenrolledAgents != nil && data.DeleteUnenrolledAgents.Enabled)
+	}
+	m.SecretStorageRequirementsMet = types.BoolValue(data.SecretStorageRequirementsMet != nil && *data.SecretStorageRequirementsMet)
+	m.OutputSecretStorageRequirementsMet = types.BoolValue(data.OutputSecretStorageRequirementsMet != nil && *data.OutputSecretStorageRequirementsMet)
+}
@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func getSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: settingsDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal identifier of the resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prerelease_integrations_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Allow prerelease (beta and technical preview) versions of integrations to be installed. When omitted, the setting is not managed.",
+				Optional:            true,
+			},
+			"delete_unenrolled_agents": schema.BoolAttribute{
+				MarkdownDescription: "Automatically delete agents once they are unenrolled. When omitted, the setting is not managed.",
+				Optional:            true,
+			},
+			"secret_storage_requirements_met": schema.BoolAttribute{
+				MarkdownDescription: "Whether every Fleet Server meets the requirements for storing integration secrets as Fleet secrets.",
+				Computed:            true,
+			},
+			"output_secret_storage_requirements_met": schema.BoolAttribute{
+				MarkdownDescription: "Whether every Fleet Server meets the requirements for storing output secrets as Fleet secrets.",
+				Computed:            true,
+			},
+			"skip_delete": schema.BoolAttribute{
+				MarkdownDescription: "If set to true, the settings are left unchanged when the resource is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
@@ -0,0 +1,9 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_settings" "test" {
+  prerelease_integrations_enabled = true
+  delete_unenrolled_agents        = false
+}
@@ -0,0 +1,8 @@
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_settings" "test" {
+  prerelease_integrations_enabled = false
+}
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spacesettings_test
+
+import (
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var minVersionSpaceSettings = version.Must(version.NewVersion("9.1.0"))
+
+func TestAccResourceFleetSpaceSettings(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionSpaceSettings, versionutils.FlavorAny)
+
+	spaceID := "fleet-space-" + sdkacctest.RandStringFromCharSet(6, sdkacctest.CharSetAlphaNum)
+	vars := config.Variables{
+		"space_id": config.StringVariable(spaceID),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_space_settings.test", "id", spaceID),
+					resource.TestCheckResourceAttr("elasticstack_fleet_space_settings.test", "space_id", spaceID),
+					resource.TestCheckResourceAttr("elasticstack_fleet_space_settings.test", "allowed_namespace_prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_fleet_space_settings.test", "allowed_namespace_prefixes.*", "team_a"),
+					resource.TestCheckResourceAttr("elasticstack_fleet_space_settings.test", "skip_delete", "false"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_fleet_space_settings.test", "allowed_namespace_prefixes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_fleet_space_settings.test", "allowed_namespace_prefixes.*", "team_a"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_fleet_space_settings.test", "allowed_namespace_prefixes.*", "shared"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spacesettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var minVersionSpaceSettings = version.Must(version.NewVersion("9.1.0"))
+
+type spaceSettingsModel struct {
+	entitycore.ResourceTimeoutsField
+	ID                       types.String `tfsdk:"id"`
+	KibanaConnection         types.List   `tfsdk:"kibana_connection"`
+	AllowedNamespacePrefixes types.Set    `tfsdk:"allowed_namespace_prefixes"`
+	ManagedBy                types.String `tfsdk:"managed_by"`
+	SkipDelete               types.Bool   `tfsdk:"skip_delete"`
+	SpaceID                  types.String `tfsdk:"space_id"`
+}
+
+func (m spaceSettingsModel) GetID() types.String             { return m.ID }
+func (m spaceSettingsModel) GetResourceID() types.String     { return m.SpaceID }
+func (m spaceSettingsModel) GetSpaceID() types.String        { return m.SpaceID }
+func (m spaceSettingsModel) GetKibanaConnection() types.List { return m.KibanaConnection }
+
+func (m spaceSettingsModel) GetVersionRequirements(_ context.Context) ([]entitycore.VersionRequirement, diag.Diagnostics) {
+	return []entitycore.VersionRequirement{
+		{
+			MinVersion:   *minVersionSpaceSettings,
+			ErrorMessage: fmt.Sprintf("This resource requires stack version %s or higher.", minVersionSpaceSettings),
+		},
+	}, nil
+}
+
+var _ entitycore.KibanaResourceModel = spaceSettingsModel{}
+
+func (m spaceSettingsModel) toAPIModel(ctx context.Context) (fleet.SpaceSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	prefixes := typeutils.SetTypeAs[string](ctx, m.AllowedNamespacePrefixes, path.Root("allowed_namespace_prefixes"), &diags)
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+	return fleet.SpaceSettings{AllowedNamespacePrefixes: prefixes}, diags
+}
+
+func (m *spaceSettingsModel) populateFromAPI(ctx context.Context, spaceID string, data fleet.SpaceSettings) diag.Diagnostics {
+	prefixes := data.AllowedNamespacePrefixes
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, prefixes)
+
+	m.ID = types.StringValue(spaceID)
+	m.SpaceID = types.StringValue(spaceID)
+	m.AllowedNamespacePrefixes = set
+	m.ManagedBy = typeutils.NonEmptyStringishValue(data.ManagedBy)
+	return diags
+}
@@ -0,0 +1,53 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spacesettings
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource              = newResource()
+	_ resource.ResourceWithConfigure = newResource()
+)
+
+type Resource struct {
+	*entitycore.KibanaResource[spaceSettingsModel]
+}
+
+func newResource() *Resource {
+	return &Resource{
+		KibanaResource: entitycore.NewKibanaResource[spaceSettingsModel](
+			entitycore.ComponentFleet,
+			"space_settings",
+			entitycore.KibanaResourceOptions[spaceSettingsModel]{
+				Schema: getSchema,
+				Read:   readSpaceSettings,
+				Delete: deleteSpaceSettings,
+				Create: writeSpaceSettings,
+				Update: writeSpaceSettings,
+			},
+		),
+	}
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return newResource()
+}
@@ -0,0 +1,49 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package spacesettings
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func writeSpaceSettings(
+	ctx context.Context,
+	client *clients.KibanaScopedClient,
+	req entitycore.KibanaWriteRequest[spaceSettingsModel],
+) (entitycore.KibanaWriteResult[spaceSettingsModel], diag.Diagnostics) {
+	plan := req.Plan
+
+	body, diags := plan.toAPIModel(ctx)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[spaceSettingsModel]{}, diags
+	}
+
+	settings, updateDiags := fleet.UpdateSpaceSettings(ctx, client.GetFleetClient(), req.SpaceID, body)
+	diags.Append(updateDiags...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[spaceSettingsModel]{}, diags
+	}
+
+	diags.Append(plan.populateFromAPI(ctx, req.SpaceID, *settings)...)
+	return entitycore.KibanaWriteResult[spaceSettingsModel]{Model: plan}, diags
+}
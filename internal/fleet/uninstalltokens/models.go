@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package uninstalltokens
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type uninstallTokenModel struct {
+	entitycore.KibanaConnectionField
+	ID         types.String `tfsdk:"id"`
+	PolicyID   types.String `tfsdk:"policy_id"`
+	SpaceID    types.String `tfsdk:"space_id"`
+	TokenID    types.String `tfsdk:"token_id"`
+	PolicyName types.String `tfsdk:"policy_name"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	Token      types.String `tfsdk:"token"`
+}
+
+func (model *uninstallTokenModel) populateFromAPI(data fleet.UninstallToken) {
+	model.TokenID = types.StringValue(data.ID)
+	model.PolicyName = types.StringPointerValue(data.PolicyName)
+	model.CreatedAt = types.StringValue(data.CreatedAt)
+	model.Token = types.StringValue(data.Token)
+}
@@ -0,0 +1,66 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package uninstalltokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/fleet"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readDataSource(ctx context.Context, kbClient *clients.KibanaScopedClient, config uninstallTokenModel) (uninstallTokenModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(config.SpaceID) {
+		spaceID = config.SpaceID.ValueString()
+	}
+	policyID := config.PolicyID.ValueString()
+	fleetClient := kbClient.GetFleetClient()
+
+	latest, getDiags := fleet.GetLatestUninstallToken(ctx, fleetClient, spaceID, policyID)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	if latest == nil {
+		diags.AddError("Uninstall token not found", fmt.Sprintf("No uninstall token was found for agent policy %q in space %q.", policyID, spaceID))
+		return config, diags
+	}
+
+	token, getDiags := fleet.GetUninstallToken(ctx, fleetClient, spaceID, latest.ID)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+	if token == nil {
+		diags.AddError("Uninstall token not found", fmt.Sprintf("Uninstall token %q of agent policy %q was not found.", latest.ID, policyID))
+		return config, diags
+	}
+
+	config.ID = types.StringValue((&clients.CompositeID{ClusterID: spaceID, ResourceID: policyID}).String())
+	config.SpaceID = types.StringValue(spaceID)
+	config.populateFromAPI(*token)
+
+	return config, diags
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package uninstalltokens
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: dataSourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of this data source.",
+				Computed:    true,
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "The ID of the agent policy to retrieve the uninstall token for.",
+				Required:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"token_id": schema.StringAttribute{
+				Description: "The ID of the uninstall token.",
+				Computed:    true,
+			},
+			"policy_name": schema.StringAttribute{
+				Description: "The name of the agent policy.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time at which the uninstall token was created.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The decrypted uninstall token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+variable "policy_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  elasticsearch {}
+  kibana {}
+}
+
+resource "elasticstack_fleet_agent_policy" "test" {
+  policy_id   = var.policy_id
+  name        = "Uninstall token policy ${var.policy_id}"
+  namespace   = "default"
+  description = "Agent Policy for testing the uninstall tokens data source"
+}
+
+data "elasticstack_fleet_uninstall_tokens" "test" {
+  policy_id = elasticstack_fleet_agent_policy.test.policy_id
+}
@@ -490,6 +490,40 @@ func TestAccResourceAlertingRuleEnabledFalseOnCreate(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAlertingRules(t *testing.T) {
+	ruleName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceAlertingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("read"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(ruleName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "space_id", "default"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.id", "elasticstack_kibana_alerting_rule.test", "rule_id"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.name", ruleName),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.rule_type_id", ".index-threshold"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.consumer", "alerts"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.interval", "1m"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.tags.0", ruleName),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.actions.#", "0"),
+					resource.TestCheckResourceAttrSet("data.elasticstack_kibana_alerting_rules.by_tag", "rules.0.last_execution_status"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_name", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.by_name", "rules.0.name", ruleName),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_alerting_rules.none", "rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccResourceAlertingRuleCustomThreshold validates the default discriminator
 // validation path end-to-end for observability.rules.custom_threshold (REQ-018,
 // REQ-051). This is the primary motivating rule type from issue #940.
@@ -521,6 +555,141 @@ func TestAccResourceAlertingRuleCustomThreshold(t *testing.T) {
 	})
 }
 
+func TestAccResourceAlertingRuleTypedParams(t *testing.T) {
+	minSupportedVersion := version.Must(version.NewSemver("8.13.0"))
+
+	ruleName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceAlertingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				SkipFunc:                 versionutils.CheckIfVersionIsUnsupported(minSupportedVersion),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(ruleName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "name", ruleName),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.index.0", "logs-*"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.threshold.0", "10"),
+					resource.TestCheckNoResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.group_by"),
+					resource.TestCheckResourceAttrSet("elasticstack_kibana_alerting_rule.index_threshold", "params"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.esql", "es_query_params.search_type", "esqlQuery"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.esql", "es_query_params.esql_query.esql", "FROM logs-* | STATS count = COUNT(*)"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				SkipFunc:                 versionutils.CheckIfVersionIsUnsupported(minSupportedVersion),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(ruleName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.agg_type", "avg"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.agg_field", "bytes"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.index_threshold", "index_threshold_params.threshold.0", "100"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.esql", "es_query_params.time_window_size", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAlertingRuleSnoozeAndMute(t *testing.T) {
+	minSupportedVersion := version.Must(version.NewSemver("8.13.0"))
+
+	ruleName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+	vars := config.Variables{
+		"name": config.StringVariable(ruleName),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceAlertingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				SkipFunc:                 versionutils.CheckIfVersionIsUnsupported(minSupportedVersion),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "mute_all", "true"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "muted_alert_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("elasticstack_kibana_alerting_rule.test_rule", "muted_alert_ids.*", "host-1"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.0.duration", "1h"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.0.timezone", "UTC"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				SkipFunc:                 versionutils.CheckIfVersionIsUnsupported(minSupportedVersion),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "mute_all", "false"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "muted_alert_ids.#", "2"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.#", "1"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.0.recurring.every", "1w"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.0.recurring.on_week_day.0", "SA"),
+					// Remove the snooze outside Terraform; the next plan must show it as drift.
+					testUnsnoozeAlertingRule("elasticstack_kibana_alerting_rule.test_rule"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				SkipFunc:                 versionutils.CheckIfVersionIsUnsupported(minSupportedVersion),
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("elasticstack_kibana_alerting_rule.test_rule", "snooze_schedule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testUnsnoozeAlertingRule removes every snooze from the rule directly
+// through the Kibana API.
+func testUnsnoozeAlertingRule(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		compID, _ := clients.CompositeIDFromStr(rs.Primary.ID)
+
+		client, err := clients.NewAcceptanceTestingKibanaScopedClient()
+		if err != nil {
+			return err
+		}
+		oapiClient := client.GetKibanaOapiClient()
+
+		rule, diags := kibanaoapi.GetAlertingRule(context.Background(), oapiClient, compID.ClusterID, compID.ResourceID)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get alerting rule: %v", diags)
+		}
+		if rule == nil {
+			return fmt.Errorf("alerting rule (%s) not found", compID.ResourceID)
+		}
+
+		var ids []string
+		for _, snooze := range rule.SnoozeSchedule {
+			ids = append(ids, snooze.ID)
+		}
+		if diags := kibanaoapi.UnsnoozeAlertingRule(context.Background(), oapiClient, compID.ClusterID, compID.ResourceID, ids); diags.HasError() {
+			return fmt.Errorf("failed to unsnooze alerting rule: %v", diags)
+		}
+		return nil
+	}
+}
+
 func TestAccResourceAlertingRuleInconsistentParams(t *testing.T) {
 	minSupportedVersion := version.Must(version.NewSemver("8.13.0"))
 
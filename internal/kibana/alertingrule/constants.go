@@ -28,14 +28,17 @@ const (
 
 // Terraform schema attribute keys.
 const (
-	attrTags          = "tags"
-	attrParams        = "params"
-	attrNotifyWhen    = "notify_when"
-	attrRuleTypeID    = "rule_type_id"
-	attrEnabled       = "enabled"
-	attrThrottle      = "throttle"
-	blockFrequency    = "frequency"
-	blockAlertsFilter = "alerts_filter"
+	attrTags           = "tags"
+	attrParams         = "params"
+	attrNotifyWhen     = "notify_when"
+	attrRuleTypeID     = "rule_type_id"
+	attrEnabled        = "enabled"
+	attrThrottle       = "throttle"
+	attrMuteAll        = "mute_all"
+	attrMutedAlertIDs  = "muted_alert_ids"
+	attrSnoozeSchedule = "snooze_schedule"
+	blockFrequency     = "frequency"
+	blockAlertsFilter  = "alerts_filter"
 )
 
 // JSON params keys used across rule types.
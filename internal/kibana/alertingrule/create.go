@@ -50,6 +50,11 @@ func createAlertingRule(
 		return entitycore.KibanaWriteResult[alertingRuleModel]{}, diags
 	}
 
+	diags.Append(reconcileSnoozeAndMute(ctx, oapiClient, req.SpaceID, m, createdRule)...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[alertingRuleModel]{}, diags
+	}
+
 	// Set identity fields so the envelope can locate the resource for
 	// read-after-write. The envelope will re-read full state.
 	compID := clients.CompositeID{
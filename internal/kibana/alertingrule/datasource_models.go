@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rulesDataSourceModel struct {
+	entitycore.KibanaConnectionField
+	SpaceID    types.String `tfsdk:"space_id"`
+	Search     types.String `tfsdk:"search"`
+	RuleTypeID types.String `tfsdk:"rule_type_id"`
+	Consumer   types.String `tfsdk:"consumer"`
+	Tags       types.Set    `tfsdk:"tags"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Rules      types.List   `tfsdk:"rules"`
+}
+
+type ruleItemModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RuleTypeID          types.String `tfsdk:"rule_type_id"`
+	Consumer            types.String `tfsdk:"consumer"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Tags                types.List   `tfsdk:"tags"`
+	Interval            types.String `tfsdk:"interval"`
+	LastExecutionStatus types.String `tfsdk:"last_execution_status"`
+	LastExecutionDate   types.String `tfsdk:"last_execution_date"`
+	Actions             types.List   `tfsdk:"actions"`
+}
+
+type ruleItemActionModel struct {
+	Group  types.String         `tfsdk:"group"`
+	ID     types.String         `tfsdk:"id"`
+	Params jsontypes.Normalized `tfsdk:"params"`
+}
+
+func ruleItemActionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"group":    types.StringType,
+		"id":       types.StringType,
+		attrParams: jsontypes.NormalizedType{},
+	}
+}
+
+func ruleItemAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                    types.StringType,
+		"name":                  types.StringType,
+		attrRuleTypeID:          types.StringType,
+		"consumer":              types.StringType,
+		attrEnabled:             types.BoolType,
+		attrTags:                types.ListType{ElemType: types.StringType},
+		"interval":              types.StringType,
+		"last_execution_status": types.StringType,
+		"last_execution_date":   types.StringType,
+		"actions":               types.ListType{ElemType: types.ObjectType{AttrTypes: ruleItemActionAttrTypes()}},
+	}
+}
+
+func ruleItemFromAPI(ctx context.Context, rule models.AlertingRule) (ruleItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := ruleItemModel{
+		ID:                  types.StringValue(rule.RuleID),
+		Name:                types.StringValue(rule.Name),
+		RuleTypeID:          types.StringValue(rule.RuleTypeID),
+		Consumer:            types.StringValue(rule.Consumer),
+		Enabled:             types.BoolPointerValue(rule.Enabled),
+		Interval:            types.StringValue(rule.Schedule.Interval),
+		LastExecutionStatus: types.StringPointerValue(rule.ExecutionStatus.Status),
+		LastExecutionDate:   types.StringNull(),
+	}
+	if rule.ExecutionStatus.LastExecutionDate != nil {
+		item.LastExecutionDate = types.StringValue(rule.ExecutionStatus.LastExecutionDate.Format("2006-01-02 15:04:05.999 -0700 MST"))
+	}
+
+	tags := rule.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	var d diag.Diagnostics
+	item.Tags, d = types.ListValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+
+	actions := make([]ruleItemActionModel, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		params := jsontypes.NewNormalizedNull()
+		if action.Params != nil {
+			paramsJSON, err := json.Marshal(action.Params)
+			if err != nil {
+				diags.AddError("Failed to marshal action params", err.Error())
+				continue
+			}
+			params = jsontypes.NewNormalizedValue(string(paramsJSON))
+		}
+		actions = append(actions, ruleItemActionModel{
+			Group:  types.StringValue(action.Group),
+			ID:     types.StringValue(action.ID),
+			Params: params,
+		})
+	}
+	item.Actions, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleItemActionAttrTypes()}, actions)
+	diags.Append(d...)
+
+	return item, diags
+}
+
+func (m *rulesDataSourceModel) setRules(ctx context.Context, rules []models.AlertingRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	items := make([]ruleItemModel, 0, len(rules))
+	for _, rule := range rules {
+		item, d := ruleItemFromAPI(ctx, rule)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		items = append(items, item)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleItemAttrTypes()}, items)
+	diags.Append(d...)
+	m.Rules = list
+	return diags
+}
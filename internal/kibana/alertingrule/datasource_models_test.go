@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesDataSourceModel_setRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	executed := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var model rulesDataSourceModel
+	diags := model.setRules(ctx, []models.AlertingRule{
+		{
+			RuleID:     "rule-1",
+			Name:       "cpu",
+			RuleTypeID: ".index-threshold",
+			Consumer:   "alerts",
+			Enabled:    new(true),
+			Tags:       []string{"prod"},
+			Schedule:   models.AlertingRuleSchedule{Interval: "1m"},
+			ExecutionStatus: models.AlertingRuleExecutionStatus{
+				Status:            new("error"),
+				LastExecutionDate: &executed,
+			},
+			Actions: []models.AlertingRuleAction{
+				{Group: "threshold met", ID: "connector-1", Params: map[string]any{"message": "hi"}},
+			},
+		},
+		{
+			RuleID:   "rule-2",
+			Name:     "memory",
+			Schedule: models.AlertingRuleSchedule{Interval: "5m"},
+		},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	var items []ruleItemModel
+	require.False(t, model.Rules.ElementsAs(ctx, &items, false).HasError())
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "rule-1", items[0].ID.ValueString())
+	assert.Equal(t, "error", items[0].LastExecutionStatus.ValueString())
+	assert.Equal(t, "2026-01-02 03:04:05 +0000 UTC", items[0].LastExecutionDate.ValueString())
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}), items[0].Tags)
+
+	var actions []ruleItemActionModel
+	require.False(t, items[0].Actions.ElementsAs(ctx, &actions, false).HasError())
+	require.Len(t, actions, 1)
+	assert.Equal(t, "connector-1", actions[0].ID.ValueString())
+	assert.JSONEq(t, `{"message":"hi"}`, actions[0].Params.ValueString())
+
+	assert.True(t, items[1].Enabled.IsNull())
+	assert.True(t, items[1].LastExecutionDate.IsNull())
+	assert.Empty(t, items[1].Tags.Elements())
+	assert.Empty(t, items[1].Actions.Elements())
+}
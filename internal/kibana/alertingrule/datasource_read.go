@@ -0,0 +1,64 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readRulesDataSource(
+	ctx context.Context,
+	client *clients.KibanaScopedClient,
+	config rulesDataSourceModel,
+) (rulesDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if typeutils.IsKnown(config.SpaceID) && config.SpaceID.ValueString() != "" {
+		spaceID = config.SpaceID.ValueString()
+	}
+	config.SpaceID = types.StringValue(spaceID)
+
+	params := kibanaoapi.AlertingRuleFindParams{
+		Search:     config.Search.ValueString(),
+		RuleTypeID: config.RuleTypeID.ValueString(),
+		Consumer:   config.Consumer.ValueString(),
+		Enabled:    config.Enabled.ValueBoolPointer(),
+	}
+	if typeutils.IsKnown(config.Tags) {
+		diags.Append(config.Tags.ElementsAs(ctx, &params.Tags, false)...)
+		if diags.HasError() {
+			return config, diags
+		}
+	}
+
+	rules, findDiags := kibanaoapi.FindAlertingRules(ctx, client.GetKibanaOapiClient(), spaceID, params)
+	diags.Append(findDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	diags.Append(config.setRules(ctx, rules)...)
+	return config, diags
+}
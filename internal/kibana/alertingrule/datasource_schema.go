@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Lists Kibana alerting rules in a space, optionally filtered by rule type, consumer, tags, enabled state and name. See the [Find rules API](https://www.elastic.co/docs/api/doc/kibana/operation/operation-findrules).",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Kibana space identifier. When omitted, the default space is used.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Simple query string matched against the rule name, e.g. `cpu*`.",
+				Optional:            true,
+			},
+			attrRuleTypeID: schema.StringAttribute{
+				MarkdownDescription: "Only return rules of this rule type, e.g. `.index-threshold`.",
+				Optional:            true,
+			},
+			"consumer": schema.StringAttribute{
+				MarkdownDescription: "Only return rules owned by this consumer, e.g. `alerts` or `siem`.",
+				Optional:            true,
+			},
+			attrTags: schema.SetAttribute{
+				MarkdownDescription: "Only return rules carrying at least one of these tags.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			attrEnabled: schema.BoolAttribute{
+				MarkdownDescription: "When set, only return rules that are enabled (`true`) or disabled (`false`).",
+				Optional:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Rules matching the filters, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Rule identifier.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the rule.",
+							Computed:            true,
+						},
+						attrRuleTypeID: schema.StringAttribute{
+							MarkdownDescription: "Rule type ID.",
+							Computed:            true,
+						},
+						"consumer": schema.StringAttribute{
+							MarkdownDescription: "Application that owns the rule.",
+							Computed:            true,
+						},
+						attrEnabled: schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is enabled.",
+							Computed:            true,
+						},
+						attrTags: schema.ListAttribute{
+							MarkdownDescription: "Tags of the rule.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"interval": schema.StringAttribute{
+							MarkdownDescription: "Check interval of the rule schedule, e.g. `1m`.",
+							Computed:            true,
+						},
+						"last_execution_status": schema.StringAttribute{
+							MarkdownDescription: "Status of the last rule execution, e.g. `ok`, `active` or `error`.",
+							Computed:            true,
+						},
+						"last_execution_date": schema.StringAttribute{
+							MarkdownDescription: "Date of the last rule execution.",
+							Computed:            true,
+						},
+						"actions": schema.ListNestedAttribute{
+							MarkdownDescription: "Actions run by the rule.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"group": schema.StringAttribute{
+										MarkdownDescription: "Action group the action runs for.",
+										Computed:            true,
+									},
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Connector ID used by the action.",
+										Computed:            true,
+									},
+									attrParams: schema.StringAttribute{
+										MarkdownDescription: "Action parameters as JSON.",
+										CustomType:          jsontypes.NormalizedType{},
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -48,3 +48,6 @@ var timeframeDaysDescription string
 
 //go:embed descriptions/flapping.md
 var flappingDescription string
+
+//go:embed descriptions/snooze_schedule.md
+var snoozeScheduleDescription string
@@ -20,6 +20,7 @@ package alertingrule
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
 	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
@@ -56,6 +57,16 @@ type alertingRuleModel struct {
 	AlertDelay          types.Int64                        `tfsdk:"alert_delay"`
 	Flapping            types.Object                       `tfsdk:"flapping"`
 	Actions             types.List                         `tfsdk:"actions"`
+	MuteAll             types.Bool                         `tfsdk:"mute_all"`
+	MutedAlertIDs       types.Set                          `tfsdk:"muted_alert_ids"`
+	SnoozeSchedule      types.List                         `tfsdk:"snooze_schedule"`
+
+	ESQueryParams         types.Object `tfsdk:"es_query_params"`
+	IndexThresholdParams  types.Object `tfsdk:"index_threshold_params"`
+	MetricThresholdParams types.Object `tfsdk:"metric_threshold_params"`
+	CustomThresholdParams types.Object `tfsdk:"custom_threshold_params"`
+	LogThresholdParams    types.Object `tfsdk:"log_threshold_params"`
+	SloBurnRateParams     types.Object `tfsdk:"slo_burn_rate_params"`
 }
 
 // actionModel is the Terraform model for a rule action.
@@ -136,6 +147,20 @@ func (m *alertingRuleModel) populateFromAPI(ctx context.Context, rule *models.Al
 	}
 	m.Params = jsontypes.NewNormalizedValue(string(paramsJSON))
 
+	// Typed params are refreshed from the same API params, only for the typed
+	// attribute already in use, so changes made outside Terraform show as drift.
+	for name, field := range m.typedParamsFields() {
+		if field.IsNull() {
+			continue
+		}
+		typedParams, d := typedParamsFromAPI(ctx, getTypedParamsAttrTypes(name), rule.Params, *field)
+		diags.Append(d...)
+		*field = typedParams
+	}
+	if diags.HasError() {
+		return diags
+	}
+
 	if rule.Enabled != nil {
 		m.Enabled = types.BoolValue(*rule.Enabled)
 	} else {
@@ -197,6 +222,22 @@ func (m *alertingRuleModel) populateFromAPI(ctx context.Context, rule *models.Al
 		m.Flapping = types.ObjectNull(getFlappingAttrTypes())
 	}
 
+	// Mute state and snoozes are only reconciled when managed, so drift
+	// surfaces for configured attributes without adopting unmanaged ones.
+	if !m.MuteAll.IsNull() && rule.MuteAll != nil {
+		m.MuteAll = types.BoolValue(*rule.MuteAll)
+	}
+	if !m.MutedAlertIDs.IsNull() {
+		mutedAlertIDs, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, rule.MutedAlertIDs...))
+		diags.Append(d...)
+		m.MutedAlertIDs = mutedAlertIDs
+	}
+	if !m.SnoozeSchedule.IsNull() {
+		snoozeSchedule, d := populateSnoozeScheduleFromAPI(ctx, m.SnoozeSchedule, rule.SnoozeSchedule, time.Now())
+		diags.Append(d...)
+		m.SnoozeSchedule = snoozeSchedule
+	}
+
 	// Actions
 	if len(rule.Actions) > 0 {
 		actionsList, d := convertActionsFromAPI(ctx, rule.Actions)
@@ -418,16 +459,25 @@ func (m alertingRuleModel) toAPIModel(ctx context.Context) (models.AlertingRule,
 		},
 	}
 
-	// Params from JSON string.
+	// Params from the typed params attribute when set, otherwise from the JSON
+	// string.
 	// Note: params validation is handled exclusively by ValidateConfig during
 	// the plan phase. We intentionally do not re-validate here to avoid
 	// duplicate error messages.
-	if typeutils.IsKnown(m.Params) {
-		params := map[string]any{}
+	var params map[string]any
+	if _, typedParams, ok := m.configuredTypedParams(); ok {
+		var d diag.Diagnostics
+		params, d = typedParamsToMap(typedParams)
+		diags.Append(d...)
+	} else if typeutils.IsKnown(m.Params) {
+		params = map[string]any{}
 		diags.Append(m.Params.Unmarshal(&params)...)
-		if diags.HasError() {
-			return models.AlertingRule{}, diags
-		}
+	}
+	if diags.HasError() {
+		return models.AlertingRule{}, diags
+	}
+
+	if params != nil {
 
 		// Compatibility: older Kibana versions reject `.index-threshold` rule params
 		// when `groupBy` is omitted (server-side expects a string, but sees undefined).
@@ -0,0 +1,341 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kibanacustomtypes"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsKeyFromAttrName(t *testing.T) {
+	tests := map[string]string{
+		"size":                           "size",
+		"time_window_size":               "timeWindowSize",
+		"exclude_hits_from_previous_run": "excludeHitsFromPreviousRun",
+		"esql_query":                     "esqlQuery",
+		"log_view_id":                    "logViewId",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, paramsKeyFromAttrName(name), name)
+	}
+}
+
+func TestTypedParamsSchema_EveryAttributeMatchesOneRuleType(t *testing.T) {
+	s := getSchema(context.Background())
+	seen := map[string]struct{}{}
+	for _, tp := range typedParamsAttributes {
+		_, ok := s.Attributes[tp.name]
+		require.True(t, ok, "missing schema attribute %s", tp.name)
+		_, dup := seen[tp.ruleTypeID]
+		require.False(t, dup, "rule type %s has more than one typed params attribute", tp.ruleTypeID)
+		seen[tp.ruleTypeID] = struct{}{}
+	}
+	assert.True(t, s.Attributes[attrParams].IsOptional())
+	assert.True(t, s.Attributes[attrParams].IsComputed())
+}
+
+// typedParamsFromMap builds a fully populated typed params object from a params map.
+func typedParamsFromMap(t *testing.T, name string, params map[string]any) types.Object {
+	t.Helper()
+	attrTypes := getTypedParamsAttrTypes(name)
+	obj, diags := typedParamsFromAPI(context.Background(), attrTypes, params, types.ObjectUnknown(attrTypes))
+	require.False(t, diags.HasError(), "%v", diags)
+	return obj
+}
+
+func baseTypedParamsModel(ruleTypeID string) alertingRuleModel {
+	return alertingRuleModel{
+		RuleID:     types.StringValue("rule-id"),
+		SpaceID:    types.StringValue("default"),
+		Name:       types.StringValue("name"),
+		Consumer:   types.StringValue("alerts"),
+		RuleTypeID: types.StringValue(ruleTypeID),
+		Interval:   kibanacustomtypes.NewAlertingDurationValue("1m"),
+		Params:     jsontypes.NewNormalizedUnknown(),
+	}
+}
+
+func TestToAPIModel_TypedParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		attrName   string
+		ruleTypeID string
+		params     map[string]any
+		want       map[string]any
+	}{
+		{
+			name:       "index threshold backfills compatibility defaults",
+			attrName:   attrIndexThresholdParams,
+			ruleTypeID: ruleTypeIndexThreshold,
+			params: map[string]any{
+				"index":               []any{"logs-*"},
+				"timeField":           "@timestamp",
+				"timeWindowSize":      float64(5),
+				"timeWindowUnit":      "m",
+				"threshold":           []any{float64(10)},
+				"thresholdComparator": ">",
+			},
+			want: map[string]any{
+				"index":               []any{"logs-*"},
+				"timeField":           "@timestamp",
+				"timeWindowSize":      int64(5),
+				"timeWindowUnit":      "m",
+				"threshold":           []any{float64(10)},
+				"thresholdComparator": ">",
+				"groupBy":             "all",
+				"aggType":             "count",
+			},
+		},
+		{
+			name:       "es query with ES|QL",
+			attrName:   attrESQueryParams,
+			ruleTypeID: ruleTypeESQuery,
+			params: map[string]any{
+				"searchType":          "esqlQuery",
+				"esqlQuery":           map[string]any{"esql": "FROM logs-* | LIMIT 10"},
+				"timeField":           "@timestamp",
+				"size":                float64(0),
+				"timeWindowSize":      float64(5),
+				"timeWindowUnit":      "m",
+				"threshold":           []any{float64(0)},
+				"thresholdComparator": ">",
+			},
+			want: map[string]any{
+				"searchType":          "esqlQuery",
+				"esqlQuery":           map[string]any{"esql": "FROM logs-* | LIMIT 10"},
+				"timeField":           "@timestamp",
+				"size":                int64(0),
+				"timeWindowSize":      int64(5),
+				"timeWindowUnit":      "m",
+				"threshold":           []any{float64(0)},
+				"thresholdComparator": ">",
+			},
+		},
+		{
+			name:       "custom threshold with nested criteria and search configuration",
+			attrName:   attrCustomThresholdParams,
+			ruleTypeID: ruleTypeCustomThreshold,
+			params: map[string]any{
+				"criteria": []any{map[string]any{
+					"comparator": ">",
+					"threshold":  []any{float64(1)},
+					"timeSize":   float64(5),
+					"timeUnit":   "m",
+					"metrics":    []any{map[string]any{"name": "A", "aggType": "count"}},
+				}},
+				"searchConfiguration": map[string]any{
+					"index": "logs-*",
+					"query": map[string]any{"query": "", "language": "kuery"},
+				},
+			},
+			want: map[string]any{
+				"criteria": []any{map[string]any{
+					"comparator": ">",
+					"threshold":  []any{float64(1)},
+					"timeSize":   int64(5),
+					"timeUnit":   "m",
+					"metrics":    []any{map[string]any{"name": "A", "aggType": "count"}},
+				}},
+				"searchConfiguration": map[string]any{
+					"index": "logs-*",
+					"query": map[string]any{"query": "", "language": "kuery"},
+				},
+			},
+		},
+		{
+			name:       "slo burn rate windows",
+			attrName:   attrSloBurnRateParams,
+			ruleTypeID: ruleTypeSloBurnRate,
+			params: map[string]any{
+				"sloId": "abc123",
+				"windows": []any{map[string]any{
+					"id":                   "0c59b724-200b-462f-928c-d975e69b1eef",
+					"burnRateThreshold":    3.36,
+					"maxBurnRateThreshold": 168.0,
+					"longWindow":           map[string]any{"value": float64(1), "unit": "h"},
+					"shortWindow":          map[string]any{"value": float64(5), "unit": "m"},
+					"actionGroup":          "slo.burnRate.alert",
+				}},
+			},
+			want: map[string]any{
+				"sloId": "abc123",
+				"windows": []any{map[string]any{
+					"id":                   "0c59b724-200b-462f-928c-d975e69b1eef",
+					"burnRateThreshold":    3.36,
+					"maxBurnRateThreshold": 168.0,
+					"longWindow":           map[string]any{"value": int64(1), "unit": "h"},
+					"shortWindow":          map[string]any{"value": int64(5), "unit": "m"},
+					"actionGroup":          "slo.burnRate.alert",
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := baseTypedParamsModel(tt.ruleTypeID)
+			*m.typedParamsFields()[tt.attrName] = typedParamsFromMap(t, tt.attrName, tt.params)
+
+			apiRule, diags := m.toAPIModel(context.Background())
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.want, apiRule.Params)
+		})
+	}
+}
+
+func TestTypedParams_SloBurnRateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	params := map[string]any{
+		"sloId": "abc123",
+		"windows": []any{map[string]any{
+			"id":                "high",
+			"burnRateThreshold": 14.4,
+			"longWindow":        map[string]any{"value": float64(1), "unit": "h"},
+			"shortWindow":       map[string]any{"value": float64(5), "unit": "m"},
+			"actionGroup":       "slo.burnRate.high",
+		}},
+	}
+	configured := typedParamsFromMap(t, attrSloBurnRateParams, params)
+
+	windows := configured.Attributes()["windows"].(types.List).Elements()
+	require.Len(t, windows, 1)
+	assert.Equal(t, types.StringValue("slo.burnRate.high"), windows[0].(types.Object).Attributes()["action_group"])
+
+	m := baseTypedParamsModel(ruleTypeSloBurnRate)
+	m.SloBurnRateParams = configured
+	apiRule, diags := m.toAPIModel(ctx)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	sent := apiRule.Params["windows"].([]any)[0].(map[string]any)
+	assert.Equal(t, "slo.burnRate.high", sent["actionGroup"])
+	assert.NotContains(t, sent, "actionGroupId")
+
+	apiRule.Params = params
+	diags = m.populateFromAPI(ctx, &apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, m.SloBurnRateParams.Equal(configured), "expected typed params to round-trip, got %s", m.SloBurnRateParams)
+}
+
+func TestPopulateFromAPI_TypedParamsIgnoreServerDefaults(t *testing.T) {
+	attrTypes := getTypedParamsAttrTypes(attrIndexThresholdParams)
+	configured := typedParamsFromMap(t, attrIndexThresholdParams, map[string]any{
+		"index":               []any{"logs-*"},
+		"timeField":           "@timestamp",
+		"timeWindowSize":      float64(5),
+		"timeWindowUnit":      "m",
+		"threshold":           []any{float64(10)},
+		"thresholdComparator": ">",
+	})
+
+	m := baseTypedParamsModel(ruleTypeIndexThreshold)
+	m.IndexThresholdParams = configured
+
+	apiRule := &models.AlertingRule{
+		RuleID:     "rule-id",
+		SpaceID:    "default",
+		Name:       "name",
+		Consumer:   "alerts",
+		RuleTypeID: ruleTypeIndexThreshold,
+		Schedule:   models.AlertingRuleSchedule{Interval: "1m"},
+		Params: map[string]any{
+			"index":               []any{"logs-*"},
+			"timeField":           "@timestamp",
+			"timeWindowSize":      float64(5),
+			"timeWindowUnit":      "m",
+			"threshold":           []any{float64(10)},
+			"thresholdComparator": ">",
+			// Server-side defaults the practitioner never configured.
+			"groupBy":  "all",
+			"aggType":  "count",
+			"termSize": float64(5),
+		},
+	}
+
+	diags := m.populateFromAPI(context.Background(), apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, m.IndexThresholdParams.Equal(configured), "expected typed params to round-trip, got %s", m.IndexThresholdParams)
+	assert.Equal(t, attrTypes, m.IndexThresholdParams.AttributeTypes(context.Background()))
+	assert.False(t, m.Params.IsUnknown())
+}
+
+func TestPopulateFromAPI_TypedParamsReportDrift(t *testing.T) {
+	m := baseTypedParamsModel(ruleTypeMetricThreshold)
+	m.MetricThresholdParams = typedParamsFromMap(t, attrMetricThresholdParams, map[string]any{
+		"criteria": []any{map[string]any{
+			"aggType":    "avg",
+			"metric":     "system.cpu.total.norm.pct",
+			"comparator": ">",
+			"threshold":  []any{float64(0.9)},
+			"timeSize":   float64(1),
+			"timeUnit":   "m",
+		}},
+	})
+
+	apiRule := &models.AlertingRule{
+		RuleID:     "rule-id",
+		SpaceID:    "default",
+		Name:       "name",
+		Consumer:   "infrastructure",
+		RuleTypeID: ruleTypeMetricThreshold,
+		Schedule:   models.AlertingRuleSchedule{Interval: "1m"},
+		Params: map[string]any{
+			"criteria": []any{map[string]any{
+				"aggType":    "avg",
+				"metric":     "system.cpu.total.norm.pct",
+				"comparator": ">",
+				"threshold":  []any{float64(0.8)},
+				"timeSize":   float64(1),
+				"timeUnit":   "m",
+			}},
+			"sourceId": "default",
+		},
+	}
+
+	diags := m.populateFromAPI(context.Background(), apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	criteria := m.MetricThresholdParams.Attributes()["criteria"].(types.List).Elements()
+	require.Len(t, criteria, 1)
+	threshold := criteria[0].(types.Object).Attributes()["threshold"].(types.List).Elements()
+	assert.Equal(t, []attr.Value{types.Float64Value(0.8)}, threshold)
+	assert.True(t, m.MetricThresholdParams.Attributes()["source_id"].IsNull())
+}
+
+func TestPopulateFromAPI_TypedParamsStayNullWhenUnused(t *testing.T) {
+	m := baseTypedParamsModel(ruleTypeIndexThreshold)
+	m.IndexThresholdParams = types.ObjectNull(getTypedParamsAttrTypes(attrIndexThresholdParams))
+
+	apiRule := &models.AlertingRule{
+		RuleID:     "rule-id",
+		SpaceID:    "default",
+		RuleTypeID: ruleTypeIndexThreshold,
+		Params:     map[string]any{"index": []any{"logs-*"}},
+	}
+
+	diags := m.populateFromAPI(context.Background(), apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, m.IndexThresholdParams.IsNull())
+}
@@ -0,0 +1,304 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// typedParamsFields returns pointers to the typed params attributes of the
+// model, keyed by attribute name.
+func (m *alertingRuleModel) typedParamsFields() map[string]*types.Object {
+	return map[string]*types.Object{
+		attrESQueryParams:         &m.ESQueryParams,
+		attrIndexThresholdParams:  &m.IndexThresholdParams,
+		attrMetricThresholdParams: &m.MetricThresholdParams,
+		attrCustomThresholdParams: &m.CustomThresholdParams,
+		attrLogThresholdParams:    &m.LogThresholdParams,
+		attrSloBurnRateParams:     &m.SloBurnRateParams,
+	}
+}
+
+// configuredTypedParams returns the name and value of the typed params
+// attribute that is set, if any. Schema validation guarantees at most one is
+// set, and only the one matching rule_type_id.
+func (m *alertingRuleModel) configuredTypedParams() (string, types.Object, bool) {
+	fields := m.typedParamsFields()
+	for _, t := range typedParamsAttributes {
+		if v := *fields[t.name]; !v.IsNull() {
+			return t.name, v, true
+		}
+	}
+	return "", types.Object{}, false
+}
+
+// typedParamsToMap converts a typed params object into the Kibana params map.
+// Nested attribute names are converted to the camelCase params keys; null
+// attributes are omitted so Kibana applies its own defaults.
+func typedParamsToMap(obj types.Object) (map[string]any, diag.Diagnostics) {
+	v, diags := typedParamsValueToJSON(obj)
+	if diags.HasError() {
+		return nil, diags
+	}
+	params, _ := v.(map[string]any)
+	if params == nil {
+		params = map[string]any{}
+	}
+	return params, diags
+}
+
+func typedParamsValueToJSON(v attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	switch value := v.(type) {
+	case jsontypes.Normalized:
+		var out any
+		diags.Append(value.Unmarshal(&out)...)
+		return out, diags
+	case types.String:
+		return value.ValueString(), diags
+	case types.Bool:
+		return value.ValueBool(), diags
+	case types.Int64:
+		return value.ValueInt64(), diags
+	case types.Float64:
+		return value.ValueFloat64(), diags
+	case types.List:
+		out := make([]any, 0, len(value.Elements()))
+		for _, elem := range value.Elements() {
+			item, d := typedParamsValueToJSON(elem)
+			diags.Append(d...)
+			out = append(out, item)
+		}
+		return out, diags
+	case types.Object:
+		out := map[string]any{}
+		for name, attrValue := range value.Attributes() {
+			item, d := typedParamsValueToJSON(attrValue)
+			diags.Append(d...)
+			if item != nil {
+				out[paramsKeyFromAttrName(name)] = item
+			}
+		}
+		return out, diags
+	default:
+		diags.AddError("Unsupported typed params value", fmt.Sprintf("Unexpected value type %T", v))
+		return nil, diags
+	}
+}
+
+// typedParamsFullyKnown reports whether v and every nested value is known.
+func typedParamsFullyKnown(v attr.Value) bool {
+	if v == nil || v.IsNull() {
+		return true
+	}
+	if v.IsUnknown() {
+		return false
+	}
+	switch value := v.(type) {
+	case types.List:
+		for _, elem := range value.Elements() {
+			if !typedParamsFullyKnown(elem) {
+				return false
+			}
+		}
+	case types.Object:
+		for _, attrValue := range value.Attributes() {
+			if !typedParamsFullyKnown(attrValue) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// paramsKeyFromAttrName converts a snake_case attribute name into the
+// camelCase params key Kibana uses, e.g. time_window_size -> timeWindowSize.
+func paramsKeyFromAttrName(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// typedParamsFromAPI builds the typed params object from the API params.
+//
+// It mirrors normalizeRuleParamsForState: attributes that are null in prior
+// (the plan or previous state) stay null even when Kibana returns a
+// server-side default for them, so injected defaults do not surface as drift.
+// Attributes that were set are refreshed from the API so out-of-band changes
+// do. Without a known prior, everything the API returns is kept.
+func typedParamsFromAPI(ctx context.Context, attrTypes map[string]attr.Type, apiParams map[string]any, prior types.Object) (types.Object, diag.Diagnostics) {
+	objectType := types.ObjectType{AttrTypes: attrTypes}
+	var priorValue attr.Value
+	if typeutils.IsKnown(prior) {
+		priorValue = prior
+	}
+
+	var raw any
+	if apiParams != nil {
+		raw = apiParams
+	}
+	v, diags := typedParamsValueFromJSON(ctx, objectType, raw, priorValue)
+	if diags.HasError() {
+		return types.ObjectNull(attrTypes), diags
+	}
+	obj, ok := v.(types.Object)
+	if !ok {
+		diags.AddError("Unsupported typed params value", fmt.Sprintf("Expected an object, got %T", v))
+		return types.ObjectNull(attrTypes), diags
+	}
+	return obj, diags
+}
+
+func typedParamsValueFromJSON(ctx context.Context, t attr.Type, raw any, prior attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if raw == nil || (prior != nil && prior.IsNull()) {
+		return typedParamsNullValue(ctx, t)
+	}
+	if prior != nil && prior.IsUnknown() {
+		prior = nil
+	}
+
+	switch typ := t.(type) {
+	case jsontypes.NormalizedType:
+		b, err := json.Marshal(raw)
+		if err != nil {
+			diags.AddError("Failed to marshal params", err.Error())
+			return nil, diags
+		}
+		return jsontypes.NewNormalizedValue(string(b)), diags
+	case basetypes.StringType:
+		switch s := raw.(type) {
+		case string:
+			return types.StringValue(s), diags
+		case float64:
+			return types.StringValue(strconv.FormatFloat(s, 'f', -1, 64)), diags
+		default:
+			return types.StringValue(fmt.Sprint(s)), diags
+		}
+	case basetypes.BoolType:
+		b, ok := raw.(bool)
+		if !ok {
+			return nil, typedParamsTypeMismatch(raw, "boolean")
+		}
+		return types.BoolValue(b), diags
+	case basetypes.Int64Type:
+		f, ok := raw.(float64)
+		if !ok {
+			return nil, typedParamsTypeMismatch(raw, "number")
+		}
+		return types.Int64Value(int64(f)), diags
+	case basetypes.Float64Type:
+		f, ok := raw.(float64)
+		if !ok {
+			return nil, typedParamsTypeMismatch(raw, "number")
+		}
+		return types.Float64Value(f), diags
+	case basetypes.ListType:
+		items, ok := raw.([]any)
+		if !ok {
+			return nil, typedParamsTypeMismatch(raw, "array")
+		}
+		var priorElems []attr.Value
+		if priorList, ok := prior.(types.List); ok {
+			priorElems = priorList.Elements()
+		}
+		elems := make([]attr.Value, 0, len(items))
+		for i, item := range items {
+			var priorElem attr.Value
+			if i < len(priorElems) {
+				priorElem = priorElems[i]
+			}
+			elem, d := typedParamsValueFromJSON(ctx, typ.ElemType, item, priorElem)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			elems = append(elems, elem)
+		}
+		list, d := types.ListValue(typ.ElemType, elems)
+		diags.Append(d...)
+		return list, diags
+	case basetypes.ObjectType:
+		values, ok := raw.(map[string]any)
+		if !ok {
+			return nil, typedParamsTypeMismatch(raw, "object")
+		}
+		var priorAttrs map[string]attr.Value
+		if priorObj, ok := prior.(types.Object); ok {
+			priorAttrs = priorObj.Attributes()
+		}
+		attrs := make(map[string]attr.Value, len(typ.AttrTypes))
+		for name, attrType := range typ.AttrTypes {
+			var priorAttr attr.Value
+			if priorAttrs != nil {
+				priorAttr = priorAttrs[name]
+			}
+			v, d := typedParamsValueFromJSON(ctx, attrType, values[paramsKeyFromAttrName(name)], priorAttr)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			attrs[name] = v
+		}
+		obj, d := types.ObjectValue(typ.AttrTypes, attrs)
+		diags.Append(d...)
+		return obj, diags
+	default:
+		diags.AddError("Unsupported typed params attribute", fmt.Sprintf("Unexpected attribute type %T", t))
+		return nil, diags
+	}
+}
+
+func typedParamsNullValue(ctx context.Context, t attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	v, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("Failed to build null typed params value", err.Error())
+	}
+	return v, diags
+}
+
+func typedParamsTypeMismatch(raw any, expected string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"Unexpected params value returned by Kibana",
+		fmt.Sprintf("Expected a %s, got %T (%v)", expected, raw, raw),
+	)
+	return diags
+}
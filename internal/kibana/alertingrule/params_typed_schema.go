@@ -0,0 +1,583 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/validators"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kibana rule type IDs with a typed params attribute.
+const (
+	ruleTypeMetricThreshold = "metrics.alert.threshold"
+	ruleTypeCustomThreshold = "observability.rules.custom_threshold"
+	ruleTypeLogThreshold    = "logs.alert.document.count"
+	ruleTypeSloBurnRate     = "slo.rules.burnRate"
+)
+
+// Typed params attribute keys. Each maps to exactly one rule_type_id.
+const (
+	attrESQueryParams         = "es_query_params"
+	attrIndexThresholdParams  = "index_threshold_params"
+	attrMetricThresholdParams = "metric_threshold_params"
+	attrCustomThresholdParams = "custom_threshold_params"
+	attrLogThresholdParams    = "log_threshold_params"
+	attrSloBurnRateParams     = "slo_burn_rate_params"
+)
+
+// ES query search types.
+const (
+	searchTypeESQuery      = "esQuery"
+	searchTypeSearchSource = "searchSource"
+	searchTypeESQLQuery    = "esqlQuery"
+)
+
+var (
+	timeUnits                  = []string{"s", "m", "h", "d"}
+	thresholdComparators       = []string{">", ">=", "<", "<=", "between", "notBetween"}
+	metricThresholdComparators = []string{">", ">=", "<", "<=", "between", "outside"}
+	basicAggTypes              = []string{"count", "avg", "sum", "min", "max"}
+	metricThresholdAggTypes    = []string{"avg", "max", "min", "cardinality", "rate", "count", "sum", "p95", "p99", "custom"}
+	customThresholdAggTypes    = []string{"avg", "max", "min", "cardinality", "count", "sum", "last_value", "p95", "p99"}
+	logCountComparators        = []string{"more than", "more than or equals", "less than", "less than or equals", "equals", "does not equal"}
+	logCriteriaComparators     = append([]string{"matches", "does not match", "matches phrase", "does not match phrase"}, logCountComparators...)
+)
+
+// typedParamsAttribute describes a typed alternative to the raw `params` JSON
+// for a single rule type.
+type typedParamsAttribute struct {
+	name        string
+	ruleTypeID  string
+	description string
+	attributes  func() map[string]schema.Attribute
+}
+
+// typedParamsAttributes lists every typed params attribute. The nested
+// attribute names are the snake_case form of the Kibana params keys, which
+// lets conversion to and from the API params map stay generic.
+var typedParamsAttributes = []typedParamsAttribute{
+	{
+		name:        attrESQueryParams,
+		ruleTypeID:  ruleTypeESQuery,
+		description: "Typed params for the Elasticsearch query (`.es-query`) rule type. Exactly one of Query DSL (`es_query`), KQL via `search_configuration`, or ES|QL (`esql_query`) must be used, as selected by `search_type`.",
+		attributes:  esQueryParamsAttributes,
+	},
+	{
+		name:        attrIndexThresholdParams,
+		ruleTypeID:  ruleTypeIndexThreshold,
+		description: "Typed params for the index threshold (`.index-threshold`) rule type.",
+		attributes:  indexThresholdParamsAttributes,
+	},
+	{
+		name:        attrMetricThresholdParams,
+		ruleTypeID:  ruleTypeMetricThreshold,
+		description: "Typed params for the metric threshold (`metrics.alert.threshold`) rule type.",
+		attributes:  metricThresholdParamsAttributes,
+	},
+	{
+		name:        attrCustomThresholdParams,
+		ruleTypeID:  ruleTypeCustomThreshold,
+		description: "Typed params for the custom threshold (`observability.rules.custom_threshold`) rule type.",
+		attributes:  customThresholdParamsAttributes,
+	},
+	{
+		name:        attrLogThresholdParams,
+		ruleTypeID:  ruleTypeLogThreshold,
+		description: "Typed params for the log threshold (`logs.alert.document.count`) rule type.",
+		attributes:  logThresholdParamsAttributes,
+	},
+	{
+		name:        attrSloBurnRateParams,
+		ruleTypeID:  ruleTypeSloBurnRate,
+		description: "Typed params for the SLO burn rate (`slo.rules.burnRate`) rule type.",
+		attributes:  sloBurnRateParamsAttributes,
+	},
+}
+
+func (t typedParamsAttribute) schemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: t.description + " Conflicts with `params`; when set, `params` is computed from this attribute.",
+		Optional:            true,
+		Attributes:          t.attributes(),
+		Validators: []validator.Object{
+			validators.AllowedIfDependentPathEquals(path.Root(attrRuleTypeID), t.ruleTypeID, validators.AllowedIfOptions{}),
+			objectvalidator.ConflictsWith(path.MatchRoot(attrParams)),
+		},
+	}
+}
+
+func timeWindowAttributes(sizeKey, unitKey string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		sizeKey: schema.Int64Attribute{
+			Description: "The size of the time window used to evaluate the rule.",
+			Required:    true,
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		},
+		unitKey: schema.StringAttribute{
+			Description: "The unit of the time window. One of `s`, `m`, `h` or `d`.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.OneOf(timeUnits...)},
+		},
+	}
+}
+
+func thresholdAttributes(comparators []string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"threshold": schema.ListAttribute{
+			Description: "The threshold value(s). Comparators `between` and `notBetween`/`outside` take two values, all others take one.",
+			Required:    true,
+			ElementType: types.Float64Type,
+			Validators:  []validator.List{listvalidator.SizeBetween(1, 2)},
+		},
+		"threshold_comparator": schema.StringAttribute{
+			Description: "The comparison applied to the threshold.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.OneOf(comparators...)},
+		},
+	}
+}
+
+func mergeAttributes(sets ...map[string]schema.Attribute) map[string]schema.Attribute {
+	out := map[string]schema.Attribute{}
+	for _, s := range sets {
+		for k, v := range s {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func esQueryParamsAttributes() map[string]schema.Attribute {
+	searchTypePath := path.Root(attrESQueryParams).AtName("search_type")
+	return mergeAttributes(
+		timeWindowAttributes("time_window_size", "time_window_unit"),
+		thresholdAttributes(thresholdComparators),
+		map[string]schema.Attribute{
+			"search_type": schema.StringAttribute{
+				Description: "The query language. `esQuery` (Query DSL, the Kibana default when omitted), `searchSource` (KQL or Lucene against a data view) or `esqlQuery` (ES|QL).",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(searchTypeESQuery, searchTypeSearchSource, searchTypeESQLQuery)},
+			},
+			"es_query": schema.StringAttribute{
+				Description: "The Query DSL query, as a JSON encoded string. Required when `search_type` is `esQuery` or omitted, and not allowed otherwise.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.ForbiddenIfDependentPathOneOf(searchTypePath, []string{searchTypeSearchSource, searchTypeESQLQuery}),
+				},
+			},
+			"search_configuration": schema.StringAttribute{
+				Description: "The search source configuration (data view, KQL or Lucene query and filters), as JSON. Required when `search_type` is `searchSource`.",
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Validators: []validator.String{
+					validators.RequiredIfDependentPathEquals(searchTypePath, searchTypeSearchSource),
+					validators.AllowedIfDependentPathEquals(searchTypePath, searchTypeSearchSource, validators.AllowedIfOptions{}),
+				},
+			},
+			"esql_query": schema.SingleNestedAttribute{
+				Description: "The ES|QL query. Required when `search_type` is `esqlQuery`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"esql": schema.StringAttribute{
+						Description: "The ES|QL query string.",
+						Required:    true,
+					},
+				},
+				Validators: []validator.Object{
+					validators.RequiredIfDependentPathEquals(searchTypePath, searchTypeESQLQuery),
+					validators.AllowedIfDependentPathEquals(searchTypePath, searchTypeESQLQuery, validators.AllowedIfOptions{}),
+				},
+			},
+			"index": schema.ListAttribute{
+				Description: "The indices to query. Used with Query DSL.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"time_field": schema.StringAttribute{
+				Description: "The field used to filter documents by time.",
+				Optional:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "The number of documents to include in the alert context.",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.Between(0, 10000)},
+			},
+			"agg_type": schema.StringAttribute{
+				Description: "The aggregation applied to the documents. One of `count`, `avg`, `sum`, `min` or `max`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(basicAggTypes...)},
+			},
+			"agg_field": schema.StringAttribute{
+				Description: "The field to aggregate. Required for aggregations other than `count`.",
+				Optional:    true,
+			},
+			"group_by": schema.StringAttribute{
+				Description: "Whether the aggregation is applied over `all` documents, the `top` groups of `term_field`, or each `row` of an ES|QL result.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("all", "top", "row")},
+			},
+			"term_field": schema.StringAttribute{
+				Description: "The field to group by when `group_by` is `top`.",
+				Optional:    true,
+			},
+			"term_size": schema.Int64Attribute{
+				Description: "The number of groups to check when `group_by` is `top`.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"exclude_hits_from_previous_run": schema.BoolAttribute{
+				Description: "Whether documents matched in the previous run are excluded from this run.",
+				Optional:    true,
+			},
+		},
+	)
+}
+
+func indexThresholdParamsAttributes() map[string]schema.Attribute {
+	return mergeAttributes(
+		timeWindowAttributes("time_window_size", "time_window_unit"),
+		thresholdAttributes(thresholdComparators),
+		map[string]schema.Attribute{
+			"index": schema.ListAttribute{
+				Description: "The indices to query.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"time_field": schema.StringAttribute{
+				Description: "The field used to filter documents by time.",
+				Required:    true,
+			},
+			"agg_type": schema.StringAttribute{
+				Description: "The aggregation applied to the documents. One of `count`, `avg`, `sum`, `min` or `max`. Kibana defaults to `count`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(basicAggTypes...)},
+			},
+			"agg_field": schema.StringAttribute{
+				Description: "The field to aggregate. Required for aggregations other than `count`.",
+				Optional:    true,
+			},
+			"group_by": schema.StringAttribute{
+				Description: "Whether the aggregation is applied over `all` documents or the `top` groups of `term_field`. Kibana defaults to `all`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("all", "top")},
+			},
+			"term_field": schema.StringAttribute{
+				Description: "The field to group by when `group_by` is `top`.",
+				Optional:    true,
+			},
+			"term_size": schema.Int64Attribute{
+				Description: "The number of groups to check when `group_by` is `top`.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"filter_kuery": schema.StringAttribute{
+				Description: "A KQL expression that limits the documents the rule evaluates.",
+				Optional:    true,
+			},
+		},
+	)
+}
+
+func metricThresholdParamsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"criteria": schema.ListNestedAttribute{
+			Description: "The conditions that trigger an alert.",
+			Required:    true,
+			Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mergeAttributes(
+					timeWindowAttributes("time_size", "time_unit"),
+					map[string]schema.Attribute{
+						"agg_type": schema.StringAttribute{
+							Description: "The aggregation applied to `metric`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(metricThresholdAggTypes...)},
+						},
+						"metric": schema.StringAttribute{
+							Description: "The metric field. Not used for `count`.",
+							Optional:    true,
+						},
+						"comparator": schema.StringAttribute{
+							Description: "The comparison applied to the threshold.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(metricThresholdComparators...)},
+						},
+						"threshold": schema.ListAttribute{
+							Description: "The threshold value(s). `between` and `outside` take two values, all others take one.",
+							Required:    true,
+							ElementType: types.Float64Type,
+							Validators:  []validator.List{listvalidator.SizeBetween(1, 2)},
+						},
+					},
+				),
+			},
+		},
+		"group_by": schema.ListAttribute{
+			Description: "The fields to create an alert per group for.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"filter_query": schema.StringAttribute{
+			Description: "An Elasticsearch query, as a JSON encoded string, that limits the documents the rule evaluates.",
+			Optional:    true,
+		},
+		"source_id": schema.StringAttribute{
+			Description: "The metrics source (infrastructure UI settings) ID. Kibana defaults to `default`.",
+			Optional:    true,
+		},
+		"alert_on_no_data": schema.BoolAttribute{
+			Description: "Whether to alert when the metric reports no data.",
+			Optional:    true,
+		},
+		"alert_on_group_disappear": schema.BoolAttribute{
+			Description: "Whether to alert when a group stops reporting data.",
+			Optional:    true,
+		},
+	}
+}
+
+func customThresholdParamsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"criteria": schema.ListNestedAttribute{
+			Description: "The conditions that trigger an alert.",
+			Required:    true,
+			Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mergeAttributes(
+					timeWindowAttributes("time_size", "time_unit"),
+					map[string]schema.Attribute{
+						"comparator": schema.StringAttribute{
+							Description: "The comparison applied to the threshold.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(metricThresholdComparators...)},
+						},
+						"threshold": schema.ListAttribute{
+							Description: "The threshold value(s). `between` and `outside` take two values, all others take one.",
+							Required:    true,
+							ElementType: types.Float64Type,
+							Validators:  []validator.List{listvalidator.SizeBetween(1, 2)},
+						},
+						"equation": schema.StringAttribute{
+							Description: "A custom equation combining the metrics by name, for example `(A + B) / 2`.",
+							Optional:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The label shown for a custom equation.",
+							Optional:    true,
+						},
+						"metrics": schema.ListNestedAttribute{
+							Description: "The aggregations the criterion evaluates.",
+							Required:    true,
+							Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name used to reference this metric in `equation`, for example `A`.",
+										Required:    true,
+									},
+									"agg_type": schema.StringAttribute{
+										Description: "The aggregation type.",
+										Required:    true,
+										Validators:  []validator.String{stringvalidator.OneOf(customThresholdAggTypes...)},
+									},
+									"field": schema.StringAttribute{
+										Description: "The field to aggregate. Not used for `count`.",
+										Optional:    true,
+									},
+									"filter": schema.StringAttribute{
+										Description: "A KQL filter applied to this metric.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				),
+			},
+		},
+		"search_configuration": schema.SingleNestedAttribute{
+			Description: "The data source and query for the rule.",
+			Required:    true,
+			Attributes: map[string]schema.Attribute{
+				"index": schema.StringAttribute{
+					Description: "The data view ID, or an index pattern for an ad-hoc data view.",
+					Required:    true,
+				},
+				"query": schema.SingleNestedAttribute{
+					Description: "The query that limits the documents the rule evaluates.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"query": schema.StringAttribute{
+							Description: "The query string.",
+							Required:    true,
+						},
+						"language": schema.StringAttribute{
+							Description: "The query language. One of `kuery` (KQL) or `lucene`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf("kuery", "lucene")},
+						},
+					},
+				},
+			},
+		},
+		"group_by": schema.ListAttribute{
+			Description: "The fields to create an alert per group for.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"alert_on_no_data": schema.BoolAttribute{
+			Description: "Whether to alert when the query reports no data.",
+			Optional:    true,
+		},
+		"alert_on_group_disappear": schema.BoolAttribute{
+			Description: "Whether to alert when a group stops reporting data.",
+			Optional:    true,
+		},
+	}
+}
+
+func logThresholdParamsAttributes() map[string]schema.Attribute {
+	return mergeAttributes(
+		timeWindowAttributes("time_size", "time_unit"),
+		map[string]schema.Attribute{
+			"count": schema.SingleNestedAttribute{
+				Description: "The document count condition.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"value": schema.Int64Attribute{
+						Description: "The document count to compare against.",
+						Required:    true,
+						Validators:  []validator.Int64{int64validator.AtLeast(0)},
+					},
+					"comparator": schema.StringAttribute{
+						Description: "The comparison applied to the document count.",
+						Required:    true,
+						Validators:  []validator.String{stringvalidator.OneOf(logCountComparators...)},
+					},
+				},
+			},
+			"criteria": schema.ListNestedAttribute{
+				Description: "The conditions log entries must match to be counted.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "The log field.",
+							Required:    true,
+						},
+						"comparator": schema.StringAttribute{
+							Description: "The comparison applied to the field value.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(logCriteriaComparators...)},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value to compare the field against.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"log_view": schema.SingleNestedAttribute{
+				Description: "The log view the rule evaluates.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"log_view_id": schema.StringAttribute{
+						Description: "The log view ID, usually `default`.",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "The log view reference type. Must be `log-view-reference`.",
+						Required:    true,
+						Validators:  []validator.String{stringvalidator.OneOf("log-view-reference")},
+					},
+				},
+			},
+			"group_by": schema.ListAttribute{
+				Description: "The fields to create an alert per group for.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	)
+}
+
+func sloBurnRateWindowAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"value": schema.Int64Attribute{
+				Description: "The window duration.",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"unit": schema.StringAttribute{
+				Description: "The window duration unit. One of `m`, `h` or `d`.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("m", "h", "d")},
+			},
+		},
+	}
+}
+
+func sloBurnRateParamsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slo_id": schema.StringAttribute{
+			Description: "The ID of the SLO to monitor.",
+			Required:    true,
+		},
+		"windows": schema.ListNestedAttribute{
+			Description: "The burn rate windows to evaluate.",
+			Required:    true,
+			Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "A unique identifier for the window.",
+						Required:    true,
+					},
+					"burn_rate_threshold": schema.Float64Attribute{
+						Description: "The burn rate that triggers the window.",
+						Required:    true,
+						Validators:  []validator.Float64{float64validator.AtLeast(0)},
+					},
+					"max_burn_rate_threshold": schema.Float64Attribute{
+						Description: "The maximum burn rate possible for the SLO budget.",
+						Optional:    true,
+					},
+					"long_window":  sloBurnRateWindowAttribute("The long lookback window."),
+					"short_window": sloBurnRateWindowAttribute("The short lookback window."),
+					"action_group": schema.StringAttribute{
+						Description: "The action group the window triggers, for example `slo.burnRate.high`.",
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -125,3 +125,39 @@ func throttleSetUnknownIfActionsFrequencyConfigured() planmodifier.String {
 		throttleShouldResetForActionFrequency,
 	)
 }
+
+// paramsShouldResetForTypedParams is the predicate driving the
+// planmodifiers.StringSetUnknownIf modifier registered on params after
+// UseStateForUnknown. When params is computed from a typed params attribute,
+// USFU keeps the previous params while the typed attribute is unchanged; the
+// predicate resets params to unknown once any typed params attribute differs
+// between plan and prior state, so the recomputed value is not reported as an
+// inconsistent result after apply.
+func paramsShouldResetForTypedParams(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) bool {
+	if !req.ConfigValue.IsNull() {
+		return false
+	}
+
+	for _, t := range typedParamsAttributes {
+		var planValue, stateValue types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(t.name), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(t.name), &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return false
+		}
+		if !planValue.Equal(stateValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// paramsSetUnknownIfTypedParamsChanged returns the params plan modifier
+// described by paramsShouldResetForTypedParams.
+func paramsSetUnknownIfTypedParamsChanged() planmodifier.String {
+	return planmodifiers.StringSetUnknownIf(
+		"Resets the planned params to unknown when they are computed from a typed params attribute that changed, "+
+			"so the value preserved from prior state by UseStateForUnknown is not kept for the new typed params.",
+		paramsShouldResetForTypedParams,
+	)
+}
@@ -50,10 +50,13 @@ var (
 	cachedFilterTypes    map[string]attr.Type
 	cachedTimeframeTypes map[string]attr.Type
 	cachedFlappingTypes  map[string]attr.Type
+	cachedTypedParams    map[string]map[string]attr.Type
+	cachedSnoozeTypes    map[string]attr.Type
+	cachedRecurringTypes map[string]attr.Type
 )
 
 func getSchema(_ context.Context) schema.Schema {
-	return schema.Schema{
+	s := schema.Schema{
 		Version:             1,
 		MarkdownDescription: resourceDescription,
 		Attributes: map[string]schema.Attribute{
@@ -100,9 +103,14 @@ func getSchema(_ context.Context) schema.Schema {
 				},
 			},
 			attrParams: schema.StringAttribute{
-				Description: "The rule parameters, which differ for each rule type.",
-				Required:    true,
-				CustomType:  jsontypes.NormalizedType{},
+				MarkdownDescription: "The rule parameters, which differ for each rule type, as JSON. Exactly one of `params` or the typed params attribute matching `rule_type_id` must be set; when a typed params attribute is used, `params` is computed from it.",
+				Optional:            true,
+				Computed:            true,
+				CustomType:          jsontypes.NormalizedType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					paramsSetUnknownIfTypedParamsChanged(),
+				},
 			},
 			attrRuleTypeID: schema.StringAttribute{
 				Description: ruleTypeIDDescription,
@@ -166,6 +174,91 @@ func getSchema(_ context.Context) schema.Schema {
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			attrMuteAll: schema.BoolAttribute{
+				Description: "Whether all alerts of the rule are muted. When omitted, the mute state is left untouched.",
+				Optional:    true,
+			},
+			attrMutedAlertIDs: schema.SetAttribute{
+				Description: "The IDs of individual alerts (alert instances) to mute. When set, alerts muted or unmuted outside Terraform show as drift. When omitted, muted alerts are left untouched.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			attrSnoozeSchedule: schema.ListNestedAttribute{
+				MarkdownDescription: snoozeScheduleDescription,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							Description: "The start date and time of the snooze, in ISO 8601 format. For example: `2025-03-12T12:00:00.000Z`.",
+							Required:    true,
+							Validators: []validator.String{
+								validators.StringIsISO8601,
+							},
+						},
+						"duration": schema.StringAttribute{
+							Description: "The duration of each snooze occurrence, in `<integer><unit>` format where `<unit>` is one of `d`, `h`, `m` or `s`. For example: `1d`, `5h`, `30m`.",
+							Required:    true,
+							CustomType:  kibanacustomtypes.AlertingDurationType{Units: kibanacustomtypes.AlertingDurationUnitsSubDay},
+						},
+						"timezone": schema.StringAttribute{
+							Description: "The timezone of the snooze. Defaults to `UTC`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("UTC"),
+						},
+						"recurring": schema.SingleNestedAttribute{
+							Description: "Repeats the snooze. When omitted, the snooze occurs once.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"every": schema.StringAttribute{
+									Description: "The recurrence interval, in `<integer><unit>` format where `<unit>` is one of `d`, `w`, `M` or `y`. For example: `1w`.",
+									Required:    true,
+									CustomType:  kibanacustomtypes.AlertingDurationType{Units: kibanacustomtypes.IntervalFrequencyUnits},
+								},
+								"end": schema.StringAttribute{
+									Description: "The date and time after which the snooze no longer recurs, in ISO 8601 format.",
+									Optional:    true,
+									Validators: []validator.String{
+										validators.StringIsISO8601,
+									},
+								},
+								"occurrences": schema.Int64Attribute{
+									Description: "The total number of snooze occurrences.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("end")),
+									},
+								},
+								"on_week_day": schema.ListAttribute{
+									Description: "The specific days of the week (`[MO,TU,WE,TH,FR,SA,SU]`) or nth day of month (`[+1MO, -3FR, +2WE, -4SA, -5SU]`) the snooze recurs on.",
+									Optional:    true,
+									ElementType: types.StringType,
+									Validators: []validator.List{
+										listvalidator.ValueStringsAre(validators.StringIsMaintenanceWindowOnWeekDay),
+									},
+								},
+								"on_month_day": schema.ListAttribute{
+									Description: "The specific days of the month the snooze recurs on. Valid values are 1-31.",
+									Optional:    true,
+									ElementType: types.Int64Type,
+									Validators: []validator.List{
+										listvalidator.ValueInt64sAre(int64validator.Between(1, 31)),
+									},
+								},
+								"on_month": schema.ListAttribute{
+									Description: "The specific months the snooze recurs in. Valid values are 1-12.",
+									Optional:    true,
+									ElementType: types.Int64Type,
+									Validators: []validator.List{
+										listvalidator.ValueInt64sAre(int64validator.Between(1, 12)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"flapping": schema.SingleNestedAttribute{
 				MarkdownDescription: flappingDescription,
 				Optional:            true,
@@ -307,6 +400,12 @@ func getSchema(_ context.Context) schema.Schema {
 			},
 		},
 	}
+
+	for _, t := range typedParamsAttributes {
+		s.Attributes[t.name] = t.schemaAttribute()
+	}
+
+	return s
 }
 
 // initAttrTypes initializes and caches all attribute types from the schema.
@@ -328,6 +427,16 @@ func initAttrTypes() {
 
 	flapAttr := s.Attributes["flapping"].(schema.SingleNestedAttribute)
 	cachedFlappingTypes = flapAttr.GetType().(attr.TypeWithAttributeTypes).AttributeTypes()
+
+	snoozeAttr := s.Attributes[attrSnoozeSchedule].(schema.ListNestedAttribute)
+	cachedSnoozeTypes = snoozeAttr.NestedObject.Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+	recurringAttr := snoozeAttr.NestedObject.Attributes["recurring"].(schema.SingleNestedAttribute)
+	cachedRecurringTypes = recurringAttr.GetType().(attr.TypeWithAttributeTypes).AttributeTypes()
+
+	cachedTypedParams = make(map[string]map[string]attr.Type, len(typedParamsAttributes))
+	for _, t := range typedParamsAttributes {
+		cachedTypedParams[t.name] = s.Attributes[t.name].GetType().(attr.TypeWithAttributeTypes).AttributeTypes()
+	}
 }
 
 // getActionsAttrTypes returns the attribute types for actions list elements.
@@ -359,3 +468,22 @@ func getFlappingAttrTypes() map[string]attr.Type {
 	attrTypesOnce.Do(initAttrTypes)
 	return cachedFlappingTypes
 }
+
+// getTypedParamsAttrTypes returns the attribute types for the named typed
+// params object.
+func getTypedParamsAttrTypes(name string) map[string]attr.Type {
+	attrTypesOnce.Do(initAttrTypes)
+	return cachedTypedParams[name]
+}
+
+// getSnoozeScheduleAttrTypes returns the attribute types for snooze_schedule list elements.
+func getSnoozeScheduleAttrTypes() map[string]attr.Type {
+	attrTypesOnce.Do(initAttrTypes)
+	return cachedSnoozeTypes
+}
+
+// getSnoozeRecurringAttrTypes returns the attribute types for the snooze recurring object.
+func getSnoozeRecurringAttrTypes() map[string]attr.Type {
+	attrTypesOnce.Do(initAttrTypes)
+	return cachedRecurringTypes
+}
@@ -0,0 +1,443 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	kibanaoapi "github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kibanacustomtypes"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// snoozeScheduleModel is the Terraform model for a rule snooze.
+type snoozeScheduleModel struct {
+	Start     types.String                       `tfsdk:"start"`
+	Duration  kibanacustomtypes.AlertingDuration `tfsdk:"duration"`
+	Timezone  types.String                       `tfsdk:"timezone"`
+	Recurring types.Object                       `tfsdk:"recurring"`
+}
+
+// snoozeRecurringModel is the Terraform model for a snooze recurrence.
+type snoozeRecurringModel struct {
+	Every       kibanacustomtypes.AlertingDuration `tfsdk:"every"`
+	End         types.String                       `tfsdk:"end"`
+	Occurrences types.Int64                        `tfsdk:"occurrences"`
+	OnWeekDay   types.List                         `tfsdk:"on_week_day"`
+	OnMonthDay  types.List                         `tfsdk:"on_month_day"`
+	OnMonth     types.List                         `tfsdk:"on_month"`
+}
+
+// RRule frequencies as numbered by Kibana (RFC 5545 order).
+const (
+	rruleFreqYearly  = 0
+	rruleFreqMonthly = 1
+	rruleFreqWeekly  = 2
+	rruleFreqDaily   = 3
+)
+
+var rruleFreqByUnit = map[byte]int{
+	'y': rruleFreqYearly,
+	'M': rruleFreqMonthly,
+	'w': rruleFreqWeekly,
+	'd': rruleFreqDaily,
+}
+
+// toAPIModel converts the snooze to Kibana's RRule based form. A snooze
+// without recurrence occurs exactly once.
+func (s snoozeScheduleModel) toAPIModel(ctx context.Context) (models.AlertingRuleSnooze, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	duration, d := s.Duration.Parse()
+	diags.Append(d...)
+	if diags.HasError() {
+		return models.AlertingRuleSnooze{}, diags
+	}
+
+	snooze := models.AlertingRuleSnooze{
+		Duration: duration.Milliseconds(),
+		RRule: models.AlertingRuleRRule{
+			DTStart: s.Start.ValueString(),
+			TZID:    s.Timezone.ValueString(),
+		},
+	}
+	if snooze.RRule.TZID == "" {
+		snooze.RRule.TZID = "UTC"
+	}
+
+	if !typeutils.IsKnown(s.Recurring) {
+		snooze.RRule.Count = new(1)
+		return snooze, diags
+	}
+
+	var recurring snoozeRecurringModel
+	diags.Append(s.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return models.AlertingRuleSnooze{}, diags
+	}
+
+	every := recurring.Every.ValueString()
+	interval, freq, ok := parseSnoozeEvery(every)
+	if !ok {
+		diags.AddError("Invalid snooze recurrence", fmt.Sprintf("Unable to convert recurring.every %q into a recurrence frequency", every))
+		return models.AlertingRuleSnooze{}, diags
+	}
+	snooze.RRule.Freq = &freq
+	snooze.RRule.Interval = &interval
+
+	if typeutils.IsKnown(recurring.End) {
+		snooze.RRule.Until = recurring.End.ValueStringPointer()
+	}
+	if typeutils.IsKnown(recurring.Occurrences) {
+		snooze.RRule.Count = new(int(recurring.Occurrences.ValueInt64()))
+	}
+	if typeutils.IsKnown(recurring.OnWeekDay) {
+		diags.Append(recurring.OnWeekDay.ElementsAs(ctx, &snooze.RRule.ByWeekday, false)...)
+	}
+	if typeutils.IsKnown(recurring.OnMonthDay) {
+		diags.Append(recurring.OnMonthDay.ElementsAs(ctx, &snooze.RRule.ByMonthDay, false)...)
+	}
+	if typeutils.IsKnown(recurring.OnMonth) {
+		diags.Append(recurring.OnMonth.ElementsAs(ctx, &snooze.RRule.ByMonth, false)...)
+	}
+
+	return snooze, diags
+}
+
+// parseSnoozeEvery splits a recurrence such as "2w" into its interval and
+// RRule frequency.
+func parseSnoozeEvery(every string) (int, int, bool) {
+	if len(every) < 2 {
+		return 0, 0, false
+	}
+	freq, ok := rruleFreqByUnit[every[len(every)-1]]
+	interval, err := strconv.Atoi(every[:len(every)-1])
+	return interval, freq, ok && err == nil
+}
+
+// snoozeScheduleFromAPI converts a Kibana snooze into its Terraform model.
+func snoozeScheduleFromAPI(ctx context.Context, snooze models.AlertingRuleSnooze) (snoozeScheduleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s := snoozeScheduleModel{
+		Start:     types.StringValue(snooze.RRule.DTStart),
+		Duration:  kibanacustomtypes.NewAlertingDurationValue(formatSnoozeDuration(snooze.Duration)),
+		Timezone:  types.StringValue(snooze.RRule.TZID),
+		Recurring: types.ObjectNull(getSnoozeRecurringAttrTypes()),
+	}
+
+	rrule := snooze.RRule
+	if rrule.Freq == nil {
+		return s, diags
+	}
+
+	unit := ""
+	for u, f := range rruleFreqByUnit {
+		if f == *rrule.Freq {
+			unit = string(u)
+		}
+	}
+	if unit == "" {
+		diags.AddError("Unsupported snooze recurrence", fmt.Sprintf("The snooze starting at %s recurs with RRule frequency %d, which has no `recurring.every` unit. Remove the snooze in Kibana or stop managing `snooze_schedule`.", rrule.DTStart, *rrule.Freq))
+		return s, diags
+	}
+	interval := 1
+	if rrule.Interval != nil && *rrule.Interval > 0 {
+		interval = *rrule.Interval
+	}
+
+	recurring := snoozeRecurringModel{
+		Every:       kibanacustomtypes.NewAlertingDurationValue(strconv.Itoa(interval) + unit),
+		End:         types.StringPointerValue(rrule.Until),
+		Occurrences: types.Int64Null(),
+		OnWeekDay:   types.ListNull(types.StringType),
+		OnMonthDay:  types.ListNull(types.Int64Type),
+		OnMonth:     types.ListNull(types.Int64Type),
+	}
+	if rrule.Count != nil {
+		recurring.Occurrences = types.Int64Value(int64(*rrule.Count))
+	}
+
+	var d diag.Diagnostics
+	if len(rrule.ByWeekday) > 0 {
+		recurring.OnWeekDay, d = types.ListValueFrom(ctx, types.StringType, rrule.ByWeekday)
+		diags.Append(d...)
+	}
+	if len(rrule.ByMonthDay) > 0 {
+		recurring.OnMonthDay, d = types.ListValueFrom(ctx, types.Int64Type, rrule.ByMonthDay)
+		diags.Append(d...)
+	}
+	if len(rrule.ByMonth) > 0 {
+		recurring.OnMonth, d = types.ListValueFrom(ctx, types.Int64Type, rrule.ByMonth)
+		diags.Append(d...)
+	}
+
+	s.Recurring, d = types.ObjectValueFrom(ctx, getSnoozeRecurringAttrTypes(), recurring)
+	diags.Append(d...)
+	return s, diags
+}
+
+// formatSnoozeDuration renders milliseconds in the largest whole unit.
+func formatSnoozeDuration(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	switch {
+	case d > 0 && d%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(d/(24*time.Hour)), 10) + "d"
+	case d > 0 && d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d > 0 && d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	default:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+}
+
+// sameSnooze reports whether two snoozes describe the same schedule, ignoring
+// their IDs and formatting differences Kibana introduces in timestamps.
+func sameSnooze(a, b models.AlertingRuleSnooze) bool {
+	ra, rb := a.RRule, b.RRule
+	// A snooze without recurrence occurs once, whether or not count is sent.
+	countsEqual := equalIntPtr(ra.Count, rb.Count)
+	if ra.Freq == nil && rb.Freq == nil {
+		countsEqual = equalIntPtrDefault(ra.Count, rb.Count, 1)
+	}
+	return a.Duration == b.Duration &&
+		sameInstant(ra.DTStart, rb.DTStart) &&
+		ra.TZID == rb.TZID &&
+		equalIntPtr(ra.Freq, rb.Freq) &&
+		equalIntPtrDefault(ra.Interval, rb.Interval, 1) &&
+		countsEqual &&
+		((ra.Until == nil && rb.Until == nil) || (ra.Until != nil && rb.Until != nil && sameInstant(*ra.Until, *rb.Until))) &&
+		slices.Equal(ra.ByWeekday, rb.ByWeekday) &&
+		slices.Equal(ra.ByMonthDay, rb.ByMonthDay) &&
+		slices.Equal(ra.ByMonth, rb.ByMonth)
+}
+
+func sameInstant(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	return errA == nil && errB == nil && ta.Equal(tb)
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalIntPtrDefault(a, b *int, def int) bool {
+	if a == nil {
+		a = &def
+	}
+	if b == nil {
+		b = &def
+	}
+	return *a == *b
+}
+
+// snoozeExpired reports whether a snooze without recurrence has ended by now.
+// Kibana drops such snoozes from the rule once they end.
+func snoozeExpired(snooze models.AlertingRuleSnooze, now time.Time) bool {
+	if snooze.RRule.Freq != nil {
+		return false
+	}
+	start, err := time.Parse(time.RFC3339, snooze.RRule.DTStart)
+	if err != nil {
+		return false
+	}
+	return !start.Add(time.Duration(snooze.Duration) * time.Millisecond).After(now)
+}
+
+// unmatchedSnoozeIndex returns the index of the first snooze in candidates
+// that is the same schedule as snooze and not yet matched, or -1.
+func unmatchedSnoozeIndex(candidates []models.AlertingRuleSnooze, matched []bool, snooze models.AlertingRuleSnooze) int {
+	for i, candidate := range candidates {
+		if !matched[i] && sameSnooze(candidate, snooze) {
+			return i
+		}
+	}
+	return -1
+}
+
+// snoozeSchedulesToAPI converts the configured snooze_schedule list.
+func snoozeSchedulesToAPI(ctx context.Context, list types.List) ([]models.AlertingRuleSnooze, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var schedules []snoozeScheduleModel
+	diags.Append(list.ElementsAs(ctx, &schedules, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := make([]models.AlertingRuleSnooze, 0, len(schedules))
+	for _, s := range schedules {
+		snooze, d := s.toAPIModel(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		out = append(out, snooze)
+	}
+	return out, diags
+}
+
+// populateSnoozeScheduleFromAPI refreshes snooze_schedule from the rule's
+// snoozes. Entries matching a prior entry keep the prior element (and its
+// formatting) and position; snoozes unknown to the prior list are appended so
+// they surface as drift, and prior entries Kibana no longer has are dropped
+// unless they are one-off snoozes that ended before now.
+func populateSnoozeScheduleFromAPI(ctx context.Context, prior types.List, snoozes []models.AlertingRuleSnooze, now time.Time) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: getSnoozeScheduleAttrTypes()}
+
+	var priorElems []attr.Value
+	if typeutils.IsKnown(prior) {
+		priorElems = prior.Elements()
+	}
+
+	matched := make([]bool, len(snoozes))
+	elems := make([]attr.Value, 0, len(snoozes))
+	for _, priorElem := range priorElems {
+		obj, ok := priorElem.(types.Object)
+		if !ok {
+			continue
+		}
+		var priorModel snoozeScheduleModel
+		if d := obj.As(ctx, &priorModel, basetypes.ObjectAsOptions{}); d.HasError() {
+			continue
+		}
+		priorSnooze, d := priorModel.toAPIModel(ctx)
+		if d.HasError() {
+			continue
+		}
+		if i := unmatchedSnoozeIndex(snoozes, matched, priorSnooze); i >= 0 {
+			matched[i] = true
+			elems = append(elems, priorElem)
+		} else if snoozeExpired(priorSnooze, now) {
+			elems = append(elems, priorElem)
+		}
+	}
+
+	for i, snooze := range snoozes {
+		if matched[i] {
+			continue
+		}
+		s, d := snoozeScheduleFromAPI(ctx, snooze)
+		diags.Append(d...)
+		obj, d := types.ObjectValueFrom(ctx, getSnoozeScheduleAttrTypes(), s)
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	list, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return list, diags
+}
+
+// reconcileSnoozeAndMute brings the rule's mute state and snoozes in line with
+// the plan. Attributes that are null in the plan are not managed.
+func reconcileSnoozeAndMute(ctx context.Context, client *kibanaoapi.Client, spaceID string, plan alertingRuleModel, current *models.AlertingRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	ruleID := current.RuleID
+
+	if typeutils.IsKnown(plan.MuteAll) {
+		currentMuteAll := current.MuteAll != nil && *current.MuteAll
+		if plan.MuteAll.ValueBool() != currentMuteAll {
+			diags.Append(kibanaoapi.MuteAllAlertingRule(ctx, client, spaceID, ruleID, plan.MuteAll.ValueBool())...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	if typeutils.IsKnown(plan.MutedAlertIDs) {
+		var desired []string
+		diags.Append(plan.MutedAlertIDs.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for _, id := range desired {
+			if !slices.Contains(current.MutedAlertIDs, id) {
+				diags.Append(kibanaoapi.MuteAlertingRuleAlert(ctx, client, spaceID, ruleID, id, true)...)
+			}
+		}
+		for _, id := range current.MutedAlertIDs {
+			if !slices.Contains(desired, id) {
+				diags.Append(kibanaoapi.MuteAlertingRuleAlert(ctx, client, spaceID, ruleID, id, false)...)
+			}
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if typeutils.IsKnown(plan.SnoozeSchedule) {
+		desired, d := snoozeSchedulesToAPI(ctx, plan.SnoozeSchedule)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		kept := make([]bool, len(desired))
+		var unsnoozeIDs []string
+		for _, snooze := range current.SnoozeSchedule {
+			if idx := unmatchedSnoozeIndex(desired, kept, snooze); idx >= 0 {
+				kept[idx] = true
+				continue
+			}
+			if snooze.ID != "" {
+				unsnoozeIDs = append(unsnoozeIDs, snooze.ID)
+			}
+		}
+		if len(unsnoozeIDs) > 0 {
+			diags.Append(kibanaoapi.UnsnoozeAlertingRule(ctx, client, spaceID, ruleID, unsnoozeIDs)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		now := time.Now()
+		for i, snooze := range desired {
+			// Kibana would drop an ended one-off snooze again right away.
+			if kept[i] || snoozeExpired(snooze, now) {
+				continue
+			}
+			diags.Append(kibanaoapi.SnoozeAlertingRule(ctx, client, spaceID, ruleID, snooze)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
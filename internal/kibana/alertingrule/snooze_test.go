@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alertingrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kibanacustomtypes"
+	"github.com/elastic/terraform-provider-elasticstack/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func snoozeObject(t *testing.T, s snoozeScheduleModel) attr.Value {
+	t.Helper()
+	if s.Recurring.IsNull() {
+		s.Recurring = types.ObjectNull(getSnoozeRecurringAttrTypes())
+	}
+	obj, diags := types.ObjectValueFrom(context.Background(), getSnoozeScheduleAttrTypes(), s)
+	require.False(t, diags.HasError(), "%v", diags)
+	return obj
+}
+
+func TestSnoozeSchedule_ToAPIModel(t *testing.T) {
+	ctx := context.Background()
+
+	oneOff := snoozeScheduleModel{
+		Start:     types.StringValue("2026-01-01T10:00:00.000Z"),
+		Duration:  kibanacustomtypes.NewAlertingDurationValue("2h"),
+		Timezone:  types.StringValue("UTC"),
+		Recurring: types.ObjectNull(getSnoozeRecurringAttrTypes()),
+	}
+	got, diags := oneOff.toAPIModel(ctx)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, models.AlertingRuleSnooze{
+		Duration: 7200000,
+		RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T10:00:00.000Z", TZID: "UTC", Count: new(1)},
+	}, got)
+
+	recurring, diags := types.ObjectValueFrom(ctx, getSnoozeRecurringAttrTypes(), snoozeRecurringModel{
+		Every:       kibanacustomtypes.NewAlertingDurationValue("2w"),
+		End:         types.StringValue("2026-06-01T00:00:00.000Z"),
+		Occurrences: types.Int64Null(),
+		OnWeekDay:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MO")}),
+		OnMonthDay:  types.ListNull(types.Int64Type),
+		OnMonth:     types.ListNull(types.Int64Type),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+	weekly := oneOff
+	weekly.Recurring = recurring
+
+	got, diags = weekly.toAPIModel(ctx)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, models.AlertingRuleSnooze{
+		Duration: 7200000,
+		RRule: models.AlertingRuleRRule{
+			DTStart:   "2026-01-01T10:00:00.000Z",
+			TZID:      "UTC",
+			Freq:      new(rruleFreqWeekly),
+			Interval:  new(2),
+			Until:     new("2026-06-01T00:00:00.000Z"),
+			ByWeekday: []string{"MO"},
+		},
+	}, got)
+
+	roundTripped, diags := snoozeScheduleFromAPI(ctx, got)
+	require.False(t, diags.HasError(), "%v", diags)
+	back, diags := roundTripped.toAPIModel(ctx)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, sameSnooze(got, back))
+}
+
+func TestSameSnooze(t *testing.T) {
+	base := models.AlertingRuleSnooze{
+		Duration: 60000,
+		RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T10:00:00.000Z", TZID: "UTC", Count: new(1)},
+	}
+
+	reformatted := base
+	reformatted.ID = "abc"
+	reformatted.RRule.DTStart = "2026-01-01T10:00:00Z"
+	reformatted.RRule.Count = nil
+	assert.True(t, sameSnooze(base, reformatted))
+
+	longer := base
+	longer.Duration = 120000
+	assert.False(t, sameSnooze(base, longer))
+}
+
+func TestFormatSnoozeDuration(t *testing.T) {
+	assert.Equal(t, "1d", formatSnoozeDuration(86400000))
+	assert.Equal(t, "3h", formatSnoozeDuration(3*3600000))
+	assert.Equal(t, "90m", formatSnoozeDuration(90*60000))
+	assert.Equal(t, "45s", formatSnoozeDuration(45000))
+}
+
+func TestPopulateSnoozeScheduleFromAPI(t *testing.T) {
+	ctx := context.Background()
+	elemType := types.ObjectType{AttrTypes: getSnoozeScheduleAttrTypes()}
+
+	configured := snoozeObject(t, snoozeScheduleModel{
+		Start:    types.StringValue("2026-01-01T10:00:00.000Z"),
+		Duration: kibanacustomtypes.NewAlertingDurationValue("60m"),
+		Timezone: types.StringValue("UTC"),
+	})
+	removed := snoozeObject(t, snoozeScheduleModel{
+		Start:    types.StringValue("2026-02-01T10:00:00.000Z"),
+		Duration: kibanacustomtypes.NewAlertingDurationValue("1h"),
+		Timezone: types.StringValue("UTC"),
+	})
+	prior := types.ListValueMust(elemType, []attr.Value{configured, removed})
+
+	apiSnoozes := []models.AlertingRuleSnooze{
+		{
+			ID:       "added-outside-terraform",
+			Duration: 86400000,
+			RRule:    models.AlertingRuleRRule{DTStart: "2026-03-01T00:00:00.000Z", TZID: "Europe/Paris", Count: new(1)},
+		},
+		{
+			ID:       "kept",
+			Duration: 3600000,
+			RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T10:00:00Z", TZID: "UTC", Count: new(1)},
+		},
+	}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, diags := populateSnoozeScheduleFromAPI(ctx, prior, apiSnoozes, now)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, got.Elements(), 2)
+
+	// The matching prior entry keeps its position and formatting.
+	assert.True(t, got.Elements()[0].Equal(configured))
+
+	// The snooze added outside Terraform surfaces as drift.
+	added := got.Elements()[1].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("2026-03-01T00:00:00.000Z"), added["start"])
+	assert.Equal(t, "1d", added["duration"].(kibanacustomtypes.AlertingDuration).ValueString())
+	assert.Equal(t, types.StringValue("Europe/Paris"), added["timezone"])
+	assert.True(t, added["recurring"].IsNull())
+
+	// Once the removed snooze has ended, Kibana no longer reporting it is not
+	// drift.
+	now = time.Date(2026, 2, 1, 11, 0, 0, 0, time.UTC)
+	got, diags = populateSnoozeScheduleFromAPI(ctx, prior, apiSnoozes, now)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, got.Elements(), 3)
+	assert.True(t, got.Elements()[1].Equal(removed))
+}
+
+func TestSnoozeExpired(t *testing.T) {
+	snooze := models.AlertingRuleSnooze{
+		Duration: 3600000,
+		RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T10:00:00.000Z", TZID: "UTC", Count: new(1)},
+	}
+
+	assert.False(t, snoozeExpired(snooze, time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)))
+	assert.True(t, snoozeExpired(snooze, time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)))
+
+	recurring := snooze
+	recurring.RRule.Freq = new(rruleFreqDaily)
+	assert.False(t, snoozeExpired(recurring, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestSnoozeScheduleFromAPI_UnknownFrequency(t *testing.T) {
+	hourly := 4
+	_, diags := snoozeScheduleFromAPI(context.Background(), models.AlertingRuleSnooze{
+		Duration: 60000,
+		RRule:    models.AlertingRuleRRule{DTStart: "2026-01-01T10:00:00.000Z", TZID: "UTC", Freq: &hourly},
+	})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unsupported snooze recurrence", diags[0].Summary())
+}
+
+func TestPopulateFromAPI_MuteStateOnlyWhenManaged(t *testing.T) {
+	apiRule := &models.AlertingRule{
+		RuleID:        "rule-id",
+		SpaceID:       "default",
+		RuleTypeID:    ".index-threshold",
+		MuteAll:       new(true),
+		MutedAlertIDs: []string{"host-1"},
+	}
+
+	unmanaged := alertingRuleModel{}
+	diags := unmanaged.populateFromAPI(context.Background(), apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, unmanaged.MuteAll.IsNull())
+	assert.True(t, unmanaged.MutedAlertIDs.IsNull())
+
+	managed := alertingRuleModel{
+		MuteAll:       types.BoolValue(false),
+		MutedAlertIDs: types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+	diags = managed.populateFromAPI(context.Background(), apiRule)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, types.BoolValue(true), managed.MuteAll)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("host-1")}), managed.MutedAlertIDs)
+}
@@ -0,0 +1,52 @@
+variable "name" {
+  description = "The rule name"
+  type        = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_alerting_rule" "test" {
+  name     = var.name
+  consumer = "alerts"
+  params = jsonencode({
+    aggType             = "avg"
+    groupBy             = "top"
+    termSize            = 10
+    timeWindowSize      = 10
+    timeWindowUnit      = "s"
+    threshold           = [10]
+    thresholdComparator = ">"
+    index               = ["test-index"]
+    timeField           = "@timestamp"
+    aggField            = "version"
+    termField           = "name"
+  })
+  rule_type_id = ".index-threshold"
+  interval     = "1m"
+  enabled      = false
+  tags         = [var.name]
+}
+
+data "elasticstack_kibana_alerting_rules" "by_tag" {
+  tags         = [var.name]
+  rule_type_id = ".index-threshold"
+  consumer     = "alerts"
+  enabled      = false
+
+  depends_on = [elasticstack_kibana_alerting_rule.test]
+}
+
+data "elasticstack_kibana_alerting_rules" "by_name" {
+  search = var.name
+
+  depends_on = [elasticstack_kibana_alerting_rule.test]
+}
+
+data "elasticstack_kibana_alerting_rules" "none" {
+  tags    = [var.name]
+  enabled = true
+
+  depends_on = [elasticstack_kibana_alerting_rule.test]
+}
@@ -0,0 +1,34 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_alerting_rule" "test_rule" {
+  name         = var.name
+  consumer     = "alerts"
+  rule_type_id = ".index-threshold"
+  interval     = "1m"
+  enabled      = true
+
+  params = jsonencode({
+    aggType             = "count"
+    thresholdComparator = ">"
+    timeWindowSize      = 10
+    timeWindowUnit      = "m"
+    groupBy             = "all"
+    threshold           = [10]
+    index               = ["test-index"]
+    timeField           = "@timestamp"
+  })
+
+  mute_all        = true
+  muted_alert_ids = ["host-1"]
+
+  snooze_schedule = [{
+    start    = "2030-01-01T10:00:00.000Z"
+    duration = "1h"
+  }]
+}
@@ -0,0 +1,40 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_alerting_rule" "test_rule" {
+  name         = var.name
+  consumer     = "alerts"
+  rule_type_id = ".index-threshold"
+  interval     = "1m"
+  enabled      = true
+
+  params = jsonencode({
+    aggType             = "count"
+    thresholdComparator = ">"
+    timeWindowSize      = 10
+    timeWindowUnit      = "m"
+    groupBy             = "all"
+    threshold           = [10]
+    index               = ["test-index"]
+    timeField           = "@timestamp"
+  })
+
+  mute_all        = false
+  muted_alert_ids = ["host-1", "host-2"]
+
+  snooze_schedule = [{
+    start    = "2030-01-04T22:00:00.000Z"
+    duration = "2h"
+    timezone = "UTC"
+    recurring = {
+      every       = "1w"
+      on_week_day = ["SA"]
+      occurrences = 10
+    }
+  }]
+}
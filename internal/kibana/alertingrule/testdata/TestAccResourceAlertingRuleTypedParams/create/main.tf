@@ -0,0 +1,43 @@
+variable "name" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_alerting_rule" "index_threshold" {
+  name         = var.name
+  rule_type_id = ".index-threshold"
+  consumer     = "alerts"
+  interval     = "1m"
+  enabled      = false
+
+  index_threshold_params = {
+    index                = ["logs-*"]
+    time_field           = "@timestamp"
+    time_window_size     = 5
+    time_window_unit     = "m"
+    threshold            = [10]
+    threshold_comparator = ">"
+  }
+}
+
+resource "elasticstack_kibana_alerting_rule" "esql" {
+  name         = "${var.name}-esql"
+  rule_type_id = ".es-query"
+  consumer     = "alerts"
+  interval     = "1m"
+  enabled      = false
+
+  es_query_params = {
+    search_type          = "esqlQuery"
+    esql_query           = { esql = "FROM logs-* | STATS count = COUNT(*)" }
+    time_field           = "@timestamp"
+    size                 = 0
+    time_window_size     = 5
+    time_window_unit     = "m"
+    threshold            = [0]
+    threshold_comparator = ">"
+  }
+}
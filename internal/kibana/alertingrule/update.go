@@ -47,11 +47,16 @@ func updateAlertingRule(
 
 	oapiClient := client.GetKibanaOapiClient()
 
-	_, updateDiags := kibanaoapi.UpdateAlertingRule(ctx, oapiClient, rule.SpaceID, rule)
+	updatedRule, updateDiags := kibanaoapi.UpdateAlertingRule(ctx, oapiClient, rule.SpaceID, rule)
 	diags.Append(updateDiags...)
 	if diags.HasError() {
 		return entitycore.KibanaWriteResult[alertingRuleModel]{}, diags
 	}
 
+	diags.Append(reconcileSnoozeAndMute(ctx, oapiClient, rule.SpaceID, m, updatedRule)...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[alertingRuleModel]{}, diags
+	}
+
 	return entitycore.KibanaWriteResult[alertingRuleModel]{Model: m}, diags
 }
@@ -120,27 +120,100 @@ func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConf
 
 	validateNotifyWhenThrottleFrequencyExclusivity(ctx, &data, &resp.Diagnostics)
 
-	if !typeutils.IsKnown(data.Params) || !typeutils.IsKnown(data.RuleTypeID) {
+	typedName, typedParams, hasTypedParams := data.configuredTypedParams()
+	if data.Params.IsNull() && !hasTypedParams {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attrParams),
+			"Missing rule params",
+			"One of `params` or the typed params attribute matching `rule_type_id` must be set.",
+		)
 		return
 	}
 
-	params := typeutils.NormalizedTypeToMap[any](data.Params, path.Root("params"), &resp.Diagnostics)
+	if !typeutils.IsKnown(data.RuleTypeID) {
+		return
+	}
+
+	var params map[string]any
+	paramsPath := path.Root(attrParams)
+	if hasTypedParams {
+		// Typed params are validated as the params map they produce, so the same
+		// rule type checks apply as for raw params JSON.
+		if !typedParamsFullyKnown(typedParams) {
+			return
+		}
+		paramsPath = path.Root(typedName)
+		var d diag.Diagnostics
+		params, d = typedParamsToMap(typedParams)
+		resp.Diagnostics.Append(d...)
+	} else {
+		if !typeutils.IsKnown(data.Params) {
+			return
+		}
+		params = typeutils.NormalizedTypeToMap[any](data.Params, path.Root("params"), &resp.Diagnostics)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	errs := validateRuleParams(data.RuleTypeID.ValueString(), params)
+	if hasTypedParams {
+		errs = append(errs, validateTypedParams(typedName, params)...)
+	}
 	if len(errs) == 0 {
 		return
 	}
 
 	resp.Diagnostics.AddAttributeError(
-		path.Root("params"),
+		paramsPath,
 		fmt.Sprintf("Invalid params for rule_type_id %q", data.RuleTypeID.ValueString()),
 		formatParamsValidationErrors(errs),
 	)
 }
 
+// twoValueThresholdComparators are the comparators that take a lower and an
+// upper threshold.
+var twoValueThresholdComparators = []string{"between", "notBetween", "outside"}
+
+// validateTypedParams reports mistakes in typed params that the schema cannot
+// express and that Kibana would otherwise only reject on apply: the Query DSL
+// query required by the default search type, and the number of threshold
+// values a range comparator takes.
+func validateTypedParams(typedName string, params map[string]any) []string {
+	var errs []string
+
+	if typedName == attrESQueryParams {
+		searchType, _ := params["searchType"].(string)
+		if _, ok := params["esQuery"]; !ok && (searchType == "" || searchType == searchTypeESQuery) {
+			errs = append(errs, "`es_query` is required when `search_type` is `esQuery` or omitted")
+		}
+	}
+
+	if msg := thresholdCountError("", params["thresholdComparator"], params["threshold"]); msg != "" {
+		errs = append(errs, msg)
+	}
+	criteria, _ := params["criteria"].([]any)
+	for i, c := range criteria {
+		criterion, _ := c.(map[string]any)
+		if msg := thresholdCountError(fmt.Sprintf("criteria[%d].", i), criterion["comparator"], criterion["threshold"]); msg != "" {
+			errs = append(errs, msg)
+		}
+	}
+
+	return errs
+}
+
+// thresholdCountError returns an error message when a range comparator is not
+// given exactly two threshold values.
+func thresholdCountError(prefix string, comparator any, threshold any) string {
+	c, _ := comparator.(string)
+	values, ok := threshold.([]any)
+	if !ok || !slices.Contains(twoValueThresholdComparators, c) || len(values) == 2 {
+		return ""
+	}
+	return fmt.Sprintf("`%sthreshold` must have exactly two values for comparator %q, got %d", prefix, c, len(values))
+}
+
 // ruleTypeParamsOverrides contains explicit validation overrides for rule types
 // where OpenAPI does not match Kibana runtime behavior or where params are
 // nested unions requiring multiple variant attempts.
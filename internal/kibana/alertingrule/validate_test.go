@@ -1058,3 +1058,61 @@ func TestConfigActionsIncludeKnownFrequencyBlock(t *testing.T) {
 	diags = nil
 	assert.False(t, configActionsIncludeKnownFrequencyBlock(ctx, types.ListUnknown(types.ObjectType{AttrTypes: getActionsAttrTypes()}), &diags))
 }
+
+func TestValidateTypedParams(t *testing.T) {
+	tests := []struct {
+		name      string
+		typedName string
+		params    map[string]any
+		wantErrs  []string
+	}{
+		{
+			name:      "es query with default search type and query",
+			typedName: attrESQueryParams,
+			params:    map[string]any{"esQuery": `{"query":{"match_all":{}}}`, "thresholdComparator": ">", "threshold": []any{1.0}},
+		},
+		{
+			name:      "es query missing query when search type omitted",
+			typedName: attrESQueryParams,
+			params:    map[string]any{"thresholdComparator": ">", "threshold": []any{1.0}},
+			wantErrs:  []string{"`es_query` is required when `search_type` is `esQuery` or omitted"},
+		},
+		{
+			name:      "es query missing query with explicit esQuery search type",
+			typedName: attrESQueryParams,
+			params:    map[string]any{"searchType": searchTypeESQuery, "thresholdComparator": ">", "threshold": []any{1.0}},
+			wantErrs:  []string{"`es_query` is required when `search_type` is `esQuery` or omitted"},
+		},
+		{
+			name:      "es query with esql search type needs no query",
+			typedName: attrESQueryParams,
+			params:    map[string]any{"searchType": searchTypeESQLQuery, "thresholdComparator": ">", "threshold": []any{1.0}},
+		},
+		{
+			name:      "between with a single threshold",
+			typedName: attrIndexThresholdParams,
+			params:    map[string]any{"thresholdComparator": "between", "threshold": []any{1.0}},
+			wantErrs:  []string{"`threshold` must have exactly two values for comparator \"between\", got 1"},
+		},
+		{
+			name:      "notBetween with two thresholds",
+			typedName: attrIndexThresholdParams,
+			params:    map[string]any{"thresholdComparator": "notBetween", "threshold": []any{1.0, 5.0}},
+		},
+		{
+			name:      "outside criterion with a single threshold",
+			typedName: attrMetricThresholdParams,
+			params: map[string]any{"criteria": []any{
+				map[string]any{"comparator": ">", "threshold": []any{1.0}},
+				map[string]any{"comparator": "outside", "threshold": []any{1.0}},
+			}},
+			wantErrs: []string{"`criteria[1].threshold` must have exactly two values for comparator \"outside\", got 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErrs, validateTypedParams(tt.typedName, tt.params))
+		})
+	}
+}
@@ -431,6 +431,50 @@ func TestAccResourceKibanaConnectorWebhookAuthTypeNull(t *testing.T) {
 	})
 }
 
+func TestAccResourceKibanaConnectorTypedBlocks(t *testing.T) {
+
+	connectorName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: checkResourceKibanaConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"connector_name": config.StringVariable(connectorName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testCommonAttributes(connectorName, ".webhook"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.url", "https://hooks.example.com/services"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.method", "post"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.has_auth", "false"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.headers.Content-Type", "application/json"),
+					resource.TestMatchResourceAttr("elasticstack_kibana_action_connector.test", "config", regexp.MustCompile(`\"url\":\"https://hooks\.example\.com/services\"`)),
+					resource.TestMatchResourceAttr("elasticstack_kibana_action_connector.test", "config", regexp.MustCompile(`\"method\":\"post\"`)),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("update"),
+				ConfigVariables: config.Variables{
+					"connector_name": config.StringVariable(connectorName),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testCommonAttributes(connectorName, ".webhook"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.url", "https://hooks.example.com/services/updated"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.method", "put"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.has_auth", "true"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.auth_type", "webhook-authentication-basic"),
+					resource.TestCheckResourceAttr("elasticstack_kibana_action_connector.test", "webhook.user", "elastic"),
+					resource.TestMatchResourceAttr("elasticstack_kibana_action_connector.test", "config", regexp.MustCompile(`\"method\":\"put\"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceKibanaConnectorFromSDK(t *testing.T) {
 
 	connectorName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
@@ -42,6 +42,17 @@ type tfModel struct {
 	IsDeprecated     types.Bool           `tfsdk:"is_deprecated"`
 	IsMissingSecrets types.Bool           `tfsdk:"is_missing_secrets"`
 	IsPreconfigured  types.Bool           `tfsdk:"is_preconfigured"`
+	Webhook          types.Object         `tfsdk:"webhook"`
+	CasesWebhook     types.Object         `tfsdk:"cases_webhook"`
+	Slack            types.Object         `tfsdk:"slack"`
+	Teams            types.Object         `tfsdk:"teams"`
+	Pagerduty        types.Object         `tfsdk:"pagerduty"`
+	Email            types.Object         `tfsdk:"email"`
+	Index            types.Object         `tfsdk:"index"`
+	GenAI            types.Object         `tfsdk:"gen_ai"`
+	Bedrock          types.Object         `tfsdk:"bedrock"`
+	Servicenow       types.Object         `tfsdk:"servicenow"`
+	Jira             types.Object         `tfsdk:"jira"`
 }
 
 var _ entitycore.KibanaResourceModel = tfModel{}
@@ -76,6 +87,16 @@ func (model tfModel) toAPIModel() (models.KibanaActionConnector, diag.Diagnostic
 		ConnectorTypeID: model.ConnectorTypeID.ValueString(),
 	}
 
+	if tc, block, ok := model.typedConfigBlock(); ok {
+		configJSON, secretsJSON, diags := tc.toJSON(block)
+		if diags.HasError() {
+			return models.KibanaActionConnector{}, diags
+		}
+		apiModel.ConfigJSON = configJSON
+		apiModel.SecretsJSON = secretsJSON
+		return apiModel, nil
+	}
+
 	if typeutils.IsKnown(model.Config) {
 		sanitizedConfig, diags := model.Config.SanitizedValue()
 		if diags.HasError() {
@@ -84,9 +105,10 @@ func (model tfModel) toAPIModel() (models.KibanaActionConnector, diag.Diagnostic
 		apiModel.ConfigJSON = sanitizedConfig
 	}
 
-	if typeutils.IsKnown(model.SecretsWo) {
+	switch {
+	case typeutils.IsKnown(model.SecretsWo):
 		apiModel.SecretsJSON = model.SecretsWo.ValueString()
-	} else if typeutils.IsKnown(model.Secrets) {
+	case typeutils.IsKnown(model.Secrets):
 		apiModel.SecretsJSON = model.Secrets.ValueString()
 	}
 
@@ -111,6 +133,10 @@ func (model *tfModel) populateFromAPI(apiModel *models.KibanaActionConnector, co
 		}
 	}
 
+	if diags := model.populateTypedConfigFromAPI(apiModel.ConfigJSON); diags.HasError() {
+		return diags
+	}
+
 	// Secrets are intentionally NOT set here. The Kibana API never returns
 	// secrets in read responses for security reasons. By leaving Secrets
 	// untouched, the prior state value is preserved during refresh. For
@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package connectors
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/diagutil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (model *tfModel) typedConfigBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"webhook":       &model.Webhook,
+		"cases_webhook": &model.CasesWebhook,
+		"slack":         &model.Slack,
+		"teams":         &model.Teams,
+		"pagerduty":     &model.Pagerduty,
+		"email":         &model.Email,
+		"index":         &model.Index,
+		"gen_ai":        &model.GenAI,
+		"bedrock":       &model.Bedrock,
+		"servicenow":    &model.Servicenow,
+		"jira":          &model.Jira,
+	}
+}
+
+// typedConfigBlock returns the typed connector block configured on the model,
+// if any. Schema validation guarantees at most one block is set.
+func (model *tfModel) typedConfigBlock() (typedConnector, types.Object, bool) {
+	blocks := model.typedConfigBlocks()
+	for _, tc := range typedConnectors {
+		if block := blocks[tc.attrName]; !block.IsNull() {
+			return tc, *block, true
+		}
+	}
+	return typedConnector{}, types.Object{}, false
+}
+
+// populateTypedConfigFromAPI refreshes any typed connector block present on
+// the model from the config JSON returned by Kibana. Blocks the practitioner
+// did not use stay null so `config`-only configurations see no drift.
+func (model *tfModel) populateTypedConfigFromAPI(configJSON string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	blocks := model.typedConfigBlocks()
+	for _, tc := range typedConnectors {
+		block := blocks[tc.attrName]
+		if block.IsNull() {
+			continue
+		}
+		value, valueDiags := tc.fromConfigJSON(configJSON, *block)
+		diags.Append(valueDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		*block = value
+	}
+	return diags
+}
+
+// toJSON splits a typed connector block into the config and secrets JSON
+// documents expected by the Kibana connectors API. secretsJSON is empty when
+// no secret attribute is set.
+func (tc typedConnector) toJSON(block types.Object) (configJSON string, secretsJSON string, diags diag.Diagnostics) {
+	config := map[string]any{}
+	secrets := map[string]any{}
+	attrs := block.Attributes()
+	for name, field := range tc.fields {
+		value, ok := typedAttrValueToJSON(attrs[name])
+		if !ok {
+			continue
+		}
+		if field.secret {
+			secrets[field.jsonKey] = value
+		} else {
+			config[field.jsonKey] = value
+		}
+	}
+
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", "", diagutil.FrameworkDiagFromError(err)
+	}
+
+	if len(secrets) == 0 {
+		return string(configBytes), "", nil
+	}
+
+	secretsBytes, err := json.Marshal(secrets)
+	if err != nil {
+		return "", "", diagutil.FrameworkDiagFromError(err)
+	}
+
+	return string(configBytes), string(secretsBytes), nil
+}
+
+// fromConfigJSON builds a typed connector block from the config JSON returned
+// by Kibana. The API never returns secrets, so secret attributes are carried
+// over from prior.
+func (tc typedConnector) fromConfigJSON(configJSON string, prior types.Object) (types.Object, diag.Diagnostics) {
+	config := map[string]any{}
+	if configJSON != "" {
+		if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+			return types.ObjectNull(tc.attributeTypes()), diagutil.FrameworkDiagFromError(err)
+		}
+	}
+
+	priorAttrs := prior.Attributes()
+	attrs := make(map[string]attr.Value, len(tc.fields))
+	for name, field := range tc.fields {
+		if !field.secret {
+			attrs[name] = field.valueFromJSON(config[field.jsonKey])
+			continue
+		}
+
+		if priorValue, ok := priorAttrs[name]; ok && !priorValue.IsUnknown() {
+			attrs[name] = priorValue
+		} else {
+			attrs[name] = types.StringNull()
+		}
+	}
+
+	return types.ObjectValue(tc.attributeTypes(), attrs)
+}
+
+func typedAttrValueToJSON(value attr.Value) (any, bool) {
+	if value == nil || !typeutils.IsKnown(value) {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), true
+	case types.Bool:
+		return v.ValueBool(), true
+	case types.Int64:
+		return v.ValueInt64(), true
+	case types.Map:
+		out := make(map[string]string, len(v.Elements()))
+		for key, elem := range v.Elements() {
+			if s, ok := elem.(types.String); ok && typeutils.IsKnown(s) {
+				out[key] = s.ValueString()
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+func (f typedField) valueFromJSON(raw any) attr.Value {
+	switch f.kind {
+	case typedFieldBool:
+		if b, ok := raw.(bool); ok {
+			return types.BoolValue(b)
+		}
+		return types.BoolNull()
+	case typedFieldInt64:
+		if n, ok := raw.(float64); ok {
+			return types.Int64Value(int64(n))
+		}
+		return types.Int64Null()
+	case typedFieldStringMap:
+		m, ok := raw.(map[string]any)
+		if !ok {
+			return types.MapNull(types.StringType)
+		}
+		elems := make(map[string]attr.Value, len(m))
+		for key, elem := range m {
+			elems[key] = types.StringValue(fmt.Sprint(elem))
+		}
+		return types.MapValueMust(types.StringType, elems)
+	default:
+		if s, ok := raw.(string); ok {
+			return types.StringValue(s)
+		}
+		return types.StringNull()
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package connectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func typedBlockValue(t *testing.T, attrName string, values map[string]attr.Value) types.Object {
+	t.Helper()
+
+	var tc typedConnector
+	for _, candidate := range typedConnectors {
+		if candidate.attrName == attrName {
+			tc = candidate
+		}
+	}
+	require.NotEmpty(t, tc.attrName, "unknown typed connector %q", attrName)
+
+	attrs := make(map[string]attr.Value, len(tc.fields))
+	for name, field := range tc.fields {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		switch field.kind {
+		case typedFieldBool:
+			attrs[name] = types.BoolNull()
+		case typedFieldInt64:
+			attrs[name] = types.Int64Null()
+		case typedFieldStringMap:
+			attrs[name] = types.MapNull(types.StringType)
+		default:
+			attrs[name] = types.StringNull()
+		}
+	}
+
+	obj, diags := types.ObjectValue(tc.attributeTypes(), attrs)
+	require.False(t, diags.HasError(), "%v", diags)
+	return obj
+}
+
+func TestTypedConnectors_schema(t *testing.T) {
+	t.Parallel()
+
+	s := getSchema(context.Background())
+	seenTypeIDs := map[string]bool{}
+	for _, tc := range typedConnectors {
+		require.Contains(t, s.Attributes, tc.attrName)
+		require.False(t, seenTypeIDs[tc.typeID], "duplicate typed connector for %s", tc.typeID)
+		seenTypeIDs[tc.typeID] = true
+
+		for name, field := range tc.fields {
+			require.NotEmpty(t, field.jsonKey, "%s.%s", tc.attrName, name)
+			require.NotEmpty(t, field.description, "%s.%s", tc.attrName, name)
+			if field.secret {
+				require.Equal(t, typedFieldString, field.kind, "%s.%s", tc.attrName, name)
+				require.False(t, field.required, "%s.%s must stay optional as Kibana never returns secrets", tc.attrName, name)
+			}
+		}
+	}
+}
+
+func TestTfModel_toAPIModel_TypedBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("webhook splits config and secrets", func(t *testing.T) {
+		t.Parallel()
+		model := tfModel{
+			Name:            types.StringValue("test"),
+			ConnectorTypeID: types.StringValue(".webhook"),
+			Webhook: typedBlockValue(t, "webhook", map[string]attr.Value{
+				"url":      types.StringValue("https://example.com"),
+				"method":   types.StringValue("post"),
+				"headers":  types.MapValueMust(types.StringType, map[string]attr.Value{"X-Test": types.StringValue("1")}),
+				"user":     types.StringValue("elastic"),
+				"password": types.StringValue("changeme"),
+			}),
+		}
+		apiModel, diags := model.toAPIModel()
+		require.False(t, diags.HasError())
+		require.JSONEq(t, `{"url":"https://example.com","method":"post","headers":{"X-Test":"1"}}`, apiModel.ConfigJSON)
+		require.JSONEq(t, `{"user":"elastic","password":"changeme"}`, apiModel.SecretsJSON)
+	})
+
+	t.Run("index without secrets", func(t *testing.T) {
+		t.Parallel()
+		model := tfModel{
+			Name:            types.StringValue("test"),
+			ConnectorTypeID: types.StringValue(".index"),
+			Index: typedBlockValue(t, "index", map[string]attr.Value{
+				"index":   types.StringValue("my-index"),
+				"refresh": types.BoolValue(false),
+			}),
+		}
+		apiModel, diags := model.toAPIModel()
+		require.False(t, diags.HasError())
+		require.JSONEq(t, `{"index":"my-index","refresh":false}`, apiModel.ConfigJSON)
+		require.Empty(t, apiModel.SecretsJSON)
+	})
+
+	t.Run("pagerduty sends typed secrets", func(t *testing.T) {
+		t.Parallel()
+		model := tfModel{
+			Name:            types.StringValue("test"),
+			ConnectorTypeID: types.StringValue(".pagerduty"),
+			Pagerduty: typedBlockValue(t, "pagerduty", map[string]attr.Value{
+				"routing_key": types.StringValue("typed-key"),
+			}),
+		}
+		apiModel, diags := model.toAPIModel()
+		require.False(t, diags.HasError())
+		require.JSONEq(t, `{}`, apiModel.ConfigJSON)
+		require.JSONEq(t, `{"routingKey":"typed-key"}`, apiModel.SecretsJSON)
+	})
+}
+
+func TestTfModel_populateTypedConfigFromAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refreshes config and keeps prior secrets", func(t *testing.T) {
+		t.Parallel()
+		model := tfModel{
+			Email: typedBlockValue(t, "email", map[string]attr.Value{
+				"from":     types.StringValue("alerts@example.com"),
+				"port":     types.Int64Unknown(),
+				"password": types.StringValue("changeme"),
+			}),
+		}
+
+		diags := model.populateTypedConfigFromAPI(`{"from":"ops@example.com","host":"smtp.example.com","port":587,"secure":true,"service":"other","hasAuth":true}`)
+		require.False(t, diags.HasError())
+
+		attrs := model.Email.Attributes()
+		require.Equal(t, types.StringValue("ops@example.com"), attrs["from"])
+		require.Equal(t, types.StringValue("smtp.example.com"), attrs["host"])
+		require.Equal(t, types.Int64Value(587), attrs["port"])
+		require.Equal(t, types.BoolValue(true), attrs["secure"])
+		require.Equal(t, types.BoolValue(true), attrs["has_auth"])
+		require.Equal(t, types.StringNull(), attrs["client_id"])
+		require.Equal(t, types.StringValue("changeme"), attrs["password"])
+		require.Equal(t, types.StringNull(), attrs["user"])
+	})
+
+	t.Run("leaves unused blocks null", func(t *testing.T) {
+		t.Parallel()
+		model := tfModel{}
+		diags := model.populateTypedConfigFromAPI(`{"index":"my-index","refresh":true}`)
+		require.False(t, diags.HasError())
+		require.True(t, model.Index.IsNull())
+		require.True(t, model.Webhook.IsNull())
+	})
+}
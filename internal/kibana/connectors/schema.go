@@ -34,7 +34,7 @@ import (
 )
 
 func getSchema(_ context.Context) schema.Schema {
-	return schema.Schema{
+	s := schema.Schema{
 		Version:     1,
 		Description: "Creates a Kibana action connector. See https://www.elastic.co/guide/en/kibana/current/action-types.html",
 		Attributes: map[string]schema.Attribute{
@@ -70,10 +70,11 @@ func getSchema(_ context.Context) schema.Schema {
 				},
 			},
 			attrConfig: schema.StringAttribute{
-				CustomType:  NewConfigType(),
-				Description: customtypes.DescriptionWithContextWarning("The configuration for the connector. Configuration properties vary depending on the connector type."),
-				Optional:    true,
-				Computed:    true,
+				CustomType: NewConfigType(),
+				Description: customtypes.DescriptionWithContextWarning("The configuration for the connector. Configuration properties vary depending on the connector type. " +
+					"Common connector types can instead be configured with a typed block such as `webhook` or `index`, in which case this attribute is computed."),
+				Optional: true,
+				Computed: true,
 			},
 			attrSecrets: schema.StringAttribute{
 				CustomType: jsontypes.NormalizedType{},
@@ -118,4 +119,10 @@ func getSchema(_ context.Context) schema.Schema {
 			},
 		},
 	}
+
+	for _, tc := range typedConnectors {
+		s.Attributes[tc.attrName] = tc.schemaAttribute()
+	}
+
+	return s
 }
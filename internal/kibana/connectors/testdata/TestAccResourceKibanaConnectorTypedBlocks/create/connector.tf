@@ -0,0 +1,16 @@
+variable "connector_name" {
+  description = "The connector name"
+  type        = string
+}
+
+resource "elasticstack_kibana_action_connector" "test" {
+  name              = var.connector_name
+  connector_type_id = ".webhook"
+  webhook = {
+    url = "https://hooks.example.com/services"
+    headers = {
+      "Content-Type" = "application/json"
+    }
+    has_auth = false
+  }
+}
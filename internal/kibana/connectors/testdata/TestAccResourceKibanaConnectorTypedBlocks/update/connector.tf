@@ -0,0 +1,17 @@
+variable "connector_name" {
+  description = "The connector name"
+  type        = string
+}
+
+resource "elasticstack_kibana_action_connector" "test" {
+  name              = var.connector_name
+  connector_type_id = ".webhook"
+  webhook = {
+    url       = "https://hooks.example.com/services/updated"
+    method    = "put"
+    has_auth  = true
+    auth_type = "webhook-authentication-basic"
+    user      = "elastic"
+    password  = "changeme"
+  }
+}
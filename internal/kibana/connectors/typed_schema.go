@@ -0,0 +1,319 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package connectors
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/validators"
+)
+
+type typedFieldKind int
+
+const (
+	typedFieldString typedFieldKind = iota
+	typedFieldBool
+	typedFieldInt64
+	typedFieldStringMap
+)
+
+// typedField describes one attribute of a typed connector block and the key
+// it maps to in either the connector config or secrets JSON object.
+type typedField struct {
+	jsonKey       string
+	kind          typedFieldKind
+	secret        bool
+	required      bool
+	description   string
+	oneOf         []string
+	defaultString *string
+	defaultBool   *bool
+}
+
+// typedConnector describes a typed alternative to the free-form `config` and
+// `secrets` JSON attributes for a single connector type.
+type typedConnector struct {
+	attrName string
+	typeID   string
+	fields   map[string]typedField
+}
+
+const (
+	webhookAuthBasic = "webhook-authentication-basic"
+	webhookAuthSSL   = "webhook-authentication-ssl"
+)
+
+var (
+	webhookAuthTypes         = []string{webhookAuthBasic, webhookAuthSSL}
+	webhookCertTypes         = []string{"ssl-crt-key", "ssl-pfx"}
+	webhookVerificationModes = []string{"none", "certificate", "full"}
+	casesWebhookMethods      = []string{"post", "put", "patch"}
+)
+
+// webhookAuthFields are shared by the `.webhook` and `.cases-webhook` blocks.
+func webhookAuthFields() map[string]typedField {
+	return map[string]typedField{
+		"headers":           {jsonKey: "headers", kind: typedFieldStringMap, description: "Custom HTTP headers sent with each request."},
+		"cert_type":         {jsonKey: "certType", description: "The certificate type used for SSL authentication.", oneOf: webhookCertTypes},
+		"verification_mode": {jsonKey: "verificationMode", description: "Controls the verification of certificates.", oneOf: webhookVerificationModes},
+		"ca":                {jsonKey: "ca", description: "A base64 encoded certificate authority used to verify the server certificate."},
+		"user":              {jsonKey: "user", secret: true, description: "The username for basic authentication."},
+		"password":          {jsonKey: "password", secret: true, description: "The password for basic authentication."},
+		"crt":               {jsonKey: "crt", secret: true, description: "A base64 encoded CRT file used for SSL authentication."},
+		"key":               {jsonKey: "key", secret: true, description: "A base64 encoded KEY file used for SSL authentication."},
+		"pfx":               {jsonKey: "pfx", secret: true, description: "A base64 encoded PFX or P12 file used for SSL authentication."},
+	}
+}
+
+func withFields(base map[string]typedField, extra map[string]typedField) map[string]typedField {
+	for name, field := range extra {
+		base[name] = field
+	}
+	return base
+}
+
+// typedConnectors lists the connector types that can be configured with a
+// typed block. Defaults mirror kibanaoapi.ConnectorConfigWithDefaults so the
+// planned values match what Kibana stores.
+var typedConnectors = []typedConnector{
+	{
+		attrName: "webhook",
+		typeID:   ".webhook",
+		fields: withFields(webhookAuthFields(), map[string]typedField{
+			"url":       {jsonKey: "url", required: true, description: "The request URL."},
+			"method":    {jsonKey: "method", description: "The HTTP request method. Defaults to `post`.", oneOf: []string{"post", "put"}, defaultString: new("post")},
+			"has_auth":  {jsonKey: "hasAuth", kind: typedFieldBool, description: "Whether a user and password are required for the request."},
+			"auth_type": {jsonKey: "authType", description: "The type of authentication to use.", oneOf: webhookAuthTypes},
+		}),
+	},
+	{
+		attrName: "cases_webhook",
+		typeID:   ".cases-webhook",
+		fields: withFields(webhookAuthFields(), map[string]typedField{
+			"create_incident_url":                      {jsonKey: "createIncidentUrl", required: true, description: "The REST API URL to create a case in the third-party system."},
+			"create_incident_method":                   {jsonKey: "createIncidentMethod", description: "The REST API HTTP request method to create a case. Defaults to `post`.", oneOf: casesWebhookMethods, defaultString: new("post")},
+			"create_incident_json":                     {jsonKey: "createIncidentJson", required: true, description: "A JSON payload sent to the create case URL."},
+			"create_incident_response_key":             {jsonKey: "createIncidentResponseKey", required: true, description: "The JSON key in the create case response that contains the external case ID."},
+			"get_incident_url":                         {jsonKey: "getIncidentUrl", required: true, description: "The REST API URL to get the case by ID from the third-party system."},
+			"get_incident_response_external_title_key": {jsonKey: "getIncidentResponseExternalTitleKey", required: true, description: "The JSON key in the get case response that contains the external case title."},
+			"view_incident_url":                        {jsonKey: "viewIncidentUrl", required: true, description: "The URL to view the case in the external system."},
+			"update_incident_url":                      {jsonKey: "updateIncidentUrl", required: true, description: "The REST API URL to update the case by ID in the third-party system."},
+			"update_incident_method":                   {jsonKey: "updateIncidentMethod", description: "The REST API HTTP request method to update the case. Defaults to `put`.", oneOf: casesWebhookMethods, defaultString: new("put")},
+			"update_incident_json":                     {jsonKey: "updateIncidentJson", required: true, description: "The JSON payload sent to the update case URL."},
+			"create_comment_url":                       {jsonKey: "createCommentUrl", description: "The REST API URL to create a case comment by ID in the third-party system."},
+			"create_comment_method":                    {jsonKey: "createCommentMethod", description: "The REST API HTTP request method to create a case comment. Defaults to `put`.", oneOf: casesWebhookMethods, defaultString: new("put")},
+			"create_comment_json":                      {jsonKey: "createCommentJson", description: "A JSON payload sent to the create comment URL."},
+			"has_auth":                                 {jsonKey: "hasAuth", kind: typedFieldBool, description: "Whether a user and password are required. Defaults to `true`.", defaultBool: new(true)},
+			"auth_type":                                {jsonKey: "authType", description: "The type of authentication to use. Defaults to `" + webhookAuthBasic + "`.", oneOf: webhookAuthTypes, defaultString: new(webhookAuthBasic)},
+		}),
+	},
+	{
+		attrName: "slack",
+		typeID:   ".slack",
+		fields: map[string]typedField{
+			"webhook_url": {jsonKey: "webhookUrl", secret: true, description: "The Slack incoming webhook URL."},
+		},
+	},
+	{
+		attrName: "teams",
+		typeID:   ".teams",
+		fields: map[string]typedField{
+			"webhook_url": {jsonKey: "webhookUrl", secret: true, description: "The Microsoft Teams incoming webhook URL."},
+		},
+	},
+	{
+		attrName: "pagerduty",
+		typeID:   ".pagerduty",
+		fields: map[string]typedField{
+			"api_url":     {jsonKey: "apiUrl", description: "The PagerDuty event URL."},
+			"routing_key": {jsonKey: "routingKey", secret: true, description: "A 32 character PagerDuty Integration Key for an integration on a service."},
+		},
+	},
+	{
+		attrName: "email",
+		typeID:   ".email",
+		fields: map[string]typedField{
+			"from":            {jsonKey: "from", required: true, description: "The from address for all emails sent by the connector."},
+			"host":            {jsonKey: "host", description: "The host name of the service provider."},
+			"port":            {jsonKey: "port", kind: typedFieldInt64, description: "The port to connect to on the service provider."},
+			"secure":          {jsonKey: "secure", kind: typedFieldBool, description: "Whether the connection uses TLS with the service provider."},
+			"service":         {jsonKey: "service", description: "The name of the email service. Defaults to `other`.", oneOf: []string{"elastic_cloud", "exchange_server", "gmail", "other", "outlook365", "ses"}, defaultString: new("other")},
+			"has_auth":        {jsonKey: "hasAuth", kind: typedFieldBool, description: "Whether the connector authenticates with the service provider. Defaults to `true`.", defaultBool: new(true)},
+			"client_id":       {jsonKey: "clientId", description: "The client identifier for OAuth 2.0 authentication with Microsoft Exchange."},
+			"tenant_id":       {jsonKey: "tenantId", description: "The tenant identifier for OAuth 2.0 authentication with Microsoft Exchange."},
+			"oauth_token_url": {jsonKey: "oauthTokenUrl", description: "The token URL for OAuth 2.0 authentication with Microsoft Exchange."},
+			"user":            {jsonKey: "user", secret: true, description: "The username for authentication with the service provider."},
+			"password":        {jsonKey: "password", secret: true, description: "The password for authentication with the service provider."},
+			"client_secret":   {jsonKey: "clientSecret", secret: true, description: "The client secret for OAuth 2.0 authentication with Microsoft Exchange."},
+		},
+	},
+	{
+		attrName: "index",
+		typeID:   ".index",
+		fields: map[string]typedField{
+			"index":                {jsonKey: "index", required: true, description: "The Elasticsearch index to write documents to."},
+			"refresh":              {jsonKey: "refresh", kind: typedFieldBool, description: "Whether to refresh the index after each write. Defaults to `false`.", defaultBool: new(false)},
+			"execution_time_field": {jsonKey: "executionTimeField", description: "A field that indicates when the document was indexed."},
+		},
+	},
+	{
+		attrName: "gen_ai",
+		typeID:   ".gen-ai",
+		fields: map[string]typedField{
+			"api_provider":      {jsonKey: "apiProvider", required: true, description: "The OpenAI API provider.", oneOf: []string{"OpenAI", "Azure OpenAI", "Other"}},
+			"api_url":           {jsonKey: "apiUrl", required: true, description: "The OpenAI API endpoint."},
+			"default_model":     {jsonKey: "defaultModel", description: "The default model to use for requests."},
+			"verification_mode": {jsonKey: "verificationMode", description: "Controls the verification of certificates for the `Other` provider. Kibana defaults this to `full`.", oneOf: webhookVerificationModes},
+			"api_key":           {jsonKey: "apiKey", secret: true, description: "The OpenAI API key."},
+		},
+	},
+	{
+		attrName: "bedrock",
+		typeID:   ".bedrock",
+		fields: map[string]typedField{
+			"api_url":       {jsonKey: "apiUrl", required: true, description: "The Amazon Bedrock request URL."},
+			"default_model": {jsonKey: "defaultModel", description: "The generative artificial intelligence model for Amazon Bedrock to use.", defaultString: new("us.anthropic.claude-sonnet-4-5-20250929-v1:0")},
+			"access_key":    {jsonKey: "accessKey", secret: true, description: "The AWS access key for authentication."},
+			"secret":        {jsonKey: "secret", secret: true, description: "The AWS secret for authentication."},
+		},
+	},
+	{
+		attrName: "servicenow",
+		typeID:   ".servicenow",
+		fields: map[string]typedField{
+			"api_url":               {jsonKey: "apiUrl", required: true, description: "The ServiceNow instance URL."},
+			"is_oauth":              {jsonKey: "isOAuth", kind: typedFieldBool, description: "Whether to use OAuth 2.0 JWT authentication. Defaults to `false`.", defaultBool: new(false)},
+			"uses_table_api":        {jsonKey: "usesTableApi", kind: typedFieldBool, description: "Whether the connector uses the Table API instead of the Import Set API. Defaults to `true`.", defaultBool: new(true)},
+			"client_id":             {jsonKey: "clientId", description: "The client ID assigned to your OAuth application."},
+			"user_identifier_value": {jsonKey: "userIdentifierValue", description: "The identifier to use for OAuth authentication."},
+			"jwt_key_id":            {jsonKey: "jwtKeyId", description: "The key ID assigned to the JWT verifier map of your OAuth application."},
+			"username":              {jsonKey: "username", secret: true, description: "The username for basic authentication."},
+			"password":              {jsonKey: "password", secret: true, description: "The password for basic authentication."},
+			"client_secret":         {jsonKey: "clientSecret", secret: true, description: "The client secret assigned to your OAuth application."},
+			"private_key":           {jsonKey: "privateKey", secret: true, description: "The RSA private key that you created for use in ServiceNow."},
+			"private_key_password":  {jsonKey: "privateKeyPassword", secret: true, description: "The password for the RSA private key."},
+		},
+	},
+	{
+		attrName: "jira",
+		typeID:   ".jira",
+		fields: map[string]typedField{
+			"api_url":     {jsonKey: "apiUrl", required: true, description: "The Jira instance URL."},
+			"project_key": {jsonKey: "projectKey", required: true, description: "The Jira project key."},
+			"email":       {jsonKey: "email", secret: true, description: "The account email for HTTP Basic authentication."},
+			"api_token":   {jsonKey: "apiToken", secret: true, description: "The Jira API authentication token for HTTP Basic authentication."},
+		},
+	},
+}
+
+func (tc typedConnector) attributeTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(tc.fields))
+	for name, field := range tc.fields {
+		attrTypes[name] = field.attrType()
+	}
+	return attrTypes
+}
+
+func (tc typedConnector) schemaAttribute() schema.SingleNestedAttribute {
+	attributes := make(map[string]schema.Attribute, len(tc.fields))
+	for name, field := range tc.fields {
+		attributes[name] = field.schemaAttribute()
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Typed configuration for `%s` connectors, as an alternative to the `config` and `secrets` JSON attributes. "+
+			"Secret values set here are sent to Kibana on every write and stored in state; use `config` with `secrets_wo` instead to keep them out of state.", tc.typeID),
+		Optional:   true,
+		Attributes: attributes,
+		Validators: []validator.Object{
+			validators.AllowedIfDependentPathEquals(path.Root(attrConnectorTypeID), tc.typeID, validators.AllowedIfOptions{}),
+			objectvalidator.ConflictsWith(path.MatchRoot(attrConfig), path.MatchRoot(attrSecrets), path.MatchRoot("secrets_wo")),
+		},
+	}
+}
+
+func (f typedField) attrType() attr.Type {
+	switch f.kind {
+	case typedFieldBool:
+		return types.BoolType
+	case typedFieldInt64:
+		return types.Int64Type
+	case typedFieldStringMap:
+		return types.MapType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+// computed reports whether Kibana may populate the attribute when it is
+// omitted. Secrets are never returned by the API, so they are never computed.
+func (f typedField) computed() bool {
+	return !f.required && !f.secret
+}
+
+func (f typedField) schemaAttribute() schema.Attribute {
+	optional := !f.required
+	switch f.kind {
+	case typedFieldBool:
+		a := schema.BoolAttribute{Description: f.description, Required: f.required, Optional: optional, Computed: f.computed()}
+		if f.defaultBool != nil {
+			a.Default = booldefault.StaticBool(*f.defaultBool)
+		} else if f.computed() {
+			a.PlanModifiers = []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+		}
+		return a
+	case typedFieldInt64:
+		a := schema.Int64Attribute{Description: f.description, Required: f.required, Optional: optional, Computed: f.computed()}
+		if f.computed() {
+			a.PlanModifiers = []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
+		}
+		return a
+	case typedFieldStringMap:
+		a := schema.MapAttribute{Description: f.description, ElementType: types.StringType, Required: f.required, Optional: optional, Computed: f.computed()}
+		if f.computed() {
+			a.PlanModifiers = []planmodifier.Map{mapplanmodifier.UseStateForUnknown()}
+		}
+		return a
+	default:
+		a := schema.StringAttribute{Description: f.description, Required: f.required, Optional: optional, Computed: f.computed(), Sensitive: f.secret}
+		if len(f.oneOf) > 0 {
+			a.Validators = []validator.String{stringvalidator.OneOf(f.oneOf...)}
+		}
+		if f.defaultString != nil {
+			a.Default = stringdefault.StaticString(*f.defaultString)
+		} else if f.computed() {
+			a.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		}
+		return a
+	}
+}
@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exportsecuritydetectionrules
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// readDataSource is the envelope read callback for the export security detection rules data source.
+func readDataSource(ctx context.Context, kbClient *clients.KibanaScopedClient, config dataSourceModel) (dataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	spaceID := clients.DefaultSpaceID
+	if !config.SpaceID.IsNull() && !config.SpaceID.IsUnknown() {
+		spaceID = config.SpaceID.ValueString()
+	}
+
+	var ruleIDs []string
+	if !config.RuleIDs.IsNull() && !config.RuleIDs.IsUnknown() {
+		diags.Append(config.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+		if diags.HasError() {
+			return config, diags
+		}
+	}
+
+	excludeExportDetails := true
+	if !config.ExcludeExportDetails.IsNull() && !config.ExcludeExportDetails.IsUnknown() {
+		excludeExportDetails = config.ExcludeExportDetails.ValueBool()
+	}
+
+	exported, exportDiags := kibanaoapi.ExportDetectionRules(ctx, kbClient.GetKibanaOapiClient(), spaceID, ruleIDs, excludeExportDetails)
+	diags.Append(exportDiags...)
+	if diags.HasError() {
+		return config, diags
+	}
+
+	exportedRuleIDs, err := kibanaoapi.DetectionRuleIDsFromNDJSON(exported)
+	if err != nil {
+		diags.AddError("Failed to parse exported detection rules", err.Error())
+		return config, diags
+	}
+	if exportedRuleIDs == nil {
+		exportedRuleIDs = []string{}
+	}
+
+	compositeID := &clients.CompositeID{ClusterID: spaceID, ResourceID: "export"}
+
+	config.ID = types.StringValue(compositeID.String())
+	config.SpaceID = types.StringValue(spaceID)
+	config.ExcludeExportDetails = types.BoolValue(excludeExportDetails)
+	config.ExportedRules = types.StringValue(string(exported))
+
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, exportedRuleIDs)
+	diags.Append(listDiags...)
+	config.ExportedRuleIDs = list
+
+	return config, diags
+}
@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exportsecuritydetectionrules
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func getDataSourceSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Export Elastic Security detection rules as NDJSON. The result can be imported into another space or cluster with the `elasticstack_kibana_import_security_detection_rules` resource. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-exportrules",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Generated ID for the export.",
+				Computed:    true,
+			},
+			"space_id": kbschema.DataSourceSpaceIDAttribute(),
+			"rule_ids": schema.ListAttribute{
+				Description: "The `rule_id` of each rule to export. When omitted, all rules in the space are exported.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"exclude_export_details": schema.BoolAttribute{
+				Description: "Do not add the export summary line. Defaults to true.",
+				Optional:    true,
+			},
+			"exported_rules": schema.StringAttribute{
+				Description: "The exported rules in NDJSON format, including their exception lists and action connectors.",
+				Computed:    true,
+			},
+			"exported_rule_ids": schema.ListAttribute{
+				Description: "The `rule_id` of each exported rule, in export order.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/acctest"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanautil"
+	"github.com/elastic/terraform-provider-elasticstack/internal/versionutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/require"
+)
+
+var minVersionImportRules = version.Must(version.NewVersion("8.11.0"))
+
+const resourceName = "elasticstack_kibana_import_security_detection_rules.test"
+
+func TestAccResourceImportSecurityDetectionRules(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionImportRules, versionutils.FlavorAny)
+
+	ruleName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+	ruleID := uuid.NewString()
+	vars := config.Variables{
+		"name":    config.StringVariable(ruleName),
+		"rule_id": config.StringVariable(ruleID),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables:          vars,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "space_id", "default"),
+					resource.TestCheckResourceAttr(resourceName, "success", "true"),
+					resource.TestCheckResourceAttr(resourceName, "success_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.rule_id", ruleID),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.revision"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.status", "in_sync"),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables:          vars,
+				PreConfig: func() {
+					renameDetectionRule(t, ruleID, ruleName+"-changed")
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables:          vars,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "success", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.status", "in_sync"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceImportSecurityDetectionRulesRoundTrip(t *testing.T) {
+	versionutils.SkipIfUnsupported(t, minVersionImportRules, versionutils.FlavorAny)
+
+	ruleName := sdkacctest.RandStringFromCharSet(22, sdkacctest.CharSetAlphaNum)
+	ruleID := uuid.NewString()
+	spaceID := "rules_import_" + strings.ToLower(sdkacctest.RandStringFromCharSet(6, sdkacctest.CharSetAlphaNum))
+	targetName := "elasticstack_kibana_import_security_detection_rules.target"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: acctest.Providers,
+				ConfigDirectory:          acctest.NamedTestCaseDirectory("create"),
+				ConfigVariables: config.Variables{
+					"name":     config.StringVariable(ruleName),
+					"rule_id":  config.StringVariable(ruleID),
+					"space_id": config.StringVariable(spaceID),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_export_security_detection_rules.source", "exported_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.elasticstack_kibana_export_security_detection_rules.source", "exported_rule_ids.0", ruleID),
+					resource.TestCheckResourceAttr(targetName, "space_id", spaceID),
+					resource.TestCheckResourceAttr(targetName, "success", "true"),
+					resource.TestCheckResourceAttr(targetName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(targetName, "rules.0.rule_id", ruleID),
+					resource.TestCheckResourceAttr(targetName, "rules.0.status", "in_sync"),
+				),
+			},
+		},
+	})
+}
+
+func renameDetectionRule(t *testing.T, ruleID, name string) {
+	unsupported, err := versionutils.CheckIfVersionIsUnsupported(minVersionImportRules)()
+	require.NoError(t, err)
+	if unsupported {
+		return
+	}
+
+	client, err := clients.NewAcceptanceTestingKibanaScopedClient()
+	require.NoError(t, err)
+	oapiClient := client.GetKibanaOapiClient()
+
+	body, err := json.Marshal(map[string]any{"rule_id": ruleID, "name": name})
+	require.NoError(t, err)
+
+	reqURL := strings.TrimRight(oapiClient.URL, "/") + kibanautil.BuildSpaceAwarePath(clients.DefaultSpaceID, "/api/detection_engine/rules")
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPatch, reqURL, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := oapiClient.HTTP.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
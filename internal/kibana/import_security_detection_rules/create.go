@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients"
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/utils/typeutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func importRules(
+	ctx context.Context,
+	client *clients.KibanaScopedClient,
+	req entitycore.KibanaWriteRequest[importRulesModel],
+) (entitycore.KibanaWriteResult[importRulesModel], diag.Diagnostics) {
+	model := req.Plan
+	var diags diag.Diagnostics
+
+	fileContents := []byte(model.FileContents.ValueString())
+	ruleIDs, err := kibanaoapi.DetectionRuleIDsFromNDJSON(fileContents)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attrFileContents), "Invalid detection rules file", err.Error())
+		return entitycore.KibanaWriteResult[importRulesModel]{}, diags
+	}
+
+	oapiClient := client.GetKibanaOapiClient()
+
+	result, importDiags := kibanaoapi.ImportDetectionRules(ctx, oapiClient, req.SpaceID, fileContents, kibanaoapi.DetectionRulesImportOptions{
+		Overwrite:                 model.Overwrite.ValueBool(),
+		OverwriteExceptions:       model.OverwriteExceptions.ValueBool(),
+		OverwriteActionConnectors: model.OverwriteActionConnectors.ValueBool(),
+	})
+	diags.Append(importDiags...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[importRulesModel]{}, diags
+	}
+
+	revisions, findDiags := kibanaoapi.FindDetectionRuleRevisions(ctx, oapiClient, req.SpaceID, ruleIDs)
+	diags.Append(findDiags...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[importRulesModel]{}, diags
+	}
+
+	if !typeutils.IsKnown(model.ID) {
+		model.ID = types.StringValue(uuid.NewString())
+	}
+	model.SpaceID = types.StringValue(req.SpaceID)
+	diags.Append(model.populateFromImport(ctx, result, ruleIDs, revisions)...)
+	if diags.HasError() {
+		return entitycore.KibanaWriteResult[importRulesModel]{}, diags
+	}
+
+	if !result.Success && !model.IgnoreImportErrors.ValueBool() {
+		var detail strings.Builder
+		for _, e := range result.Errors {
+			fmt.Fprintf(&detail, "rule [%s]: HTTP %d: %s\n", e.RuleID, e.Error.StatusCode, e.Error.Message)
+		}
+		detail.WriteString("set ignore_import_errors = true to keep the successfully imported rules")
+
+		if result.SuccessCount > 0 {
+			diags.AddWarning("not all detection rules were imported successfully", detail.String())
+		} else {
+			diags.AddError("no detection rules imported successfully", detail.String())
+		}
+	}
+
+	return entitycore.KibanaWriteResult[importRulesModel]{Model: model}, diags
+}
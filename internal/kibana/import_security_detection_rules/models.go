@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	attrFileContents                 = "file_contents"
+	attrOverwrite                    = "overwrite"
+	attrOverwriteExceptions          = "overwrite_exceptions"
+	attrOverwriteActionConnectors    = "overwrite_action_connectors"
+	attrIgnoreImportErrors           = "ignore_import_errors"
+	attrSuccess                      = "success"
+	attrSuccessCount                 = "success_count"
+	attrRulesCount                   = "rules_count"
+	attrExceptionsSuccessCount       = "exceptions_success_count"
+	attrActionConnectorsSuccessCount = "action_connectors_success_count"
+	attrErrors                       = "errors"
+	attrRules                        = "rules"
+)
+
+const (
+	ruleStatusInSync   = "in_sync"
+	ruleStatusModified = "modified"
+	ruleStatusMissing  = "missing"
+)
+
+type importRulesModel struct {
+	entitycore.ResourceTimeoutsField
+	ID                           types.String `tfsdk:"id"`
+	SpaceID                      types.String `tfsdk:"space_id"`
+	KibanaConnection             types.List   `tfsdk:"kibana_connection"`
+	FileContents                 types.String `tfsdk:"file_contents"`
+	Overwrite                    types.Bool   `tfsdk:"overwrite"`
+	OverwriteExceptions          types.Bool   `tfsdk:"overwrite_exceptions"`
+	OverwriteActionConnectors    types.Bool   `tfsdk:"overwrite_action_connectors"`
+	IgnoreImportErrors           types.Bool   `tfsdk:"ignore_import_errors"`
+	Success                      types.Bool   `tfsdk:"success"`
+	SuccessCount                 types.Int64  `tfsdk:"success_count"`
+	RulesCount                   types.Int64  `tfsdk:"rules_count"`
+	ExceptionsSuccessCount       types.Int64  `tfsdk:"exceptions_success_count"`
+	ActionConnectorsSuccessCount types.Int64  `tfsdk:"action_connectors_success_count"`
+	Errors                       types.List   `tfsdk:"errors"`
+	Rules                        types.List   `tfsdk:"rules"`
+}
+
+type importErrorModel struct {
+	RuleID     types.String `tfsdk:"rule_id"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Message    types.String `tfsdk:"message"`
+}
+
+type importedRuleModel struct {
+	RuleID   types.String `tfsdk:"rule_id"`
+	ID       types.String `tfsdk:"id"`
+	Revision types.Int64  `tfsdk:"revision"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (m importRulesModel) GetID() types.String             { return m.ID }
+func (m importRulesModel) GetResourceID() types.String     { return m.ID }
+func (m importRulesModel) GetSpaceID() types.String        { return m.SpaceID }
+func (m importRulesModel) GetKibanaConnection() types.List { return m.KibanaConnection }
+
+var _ entitycore.KibanaResourceModel = importRulesModel{}
+
+func importErrorAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rule_id":     types.StringType,
+		"status_code": types.Int64Type,
+		"message":     types.StringType,
+	}
+}
+
+func importedRuleAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"rule_id":  types.StringType,
+		"id":       types.StringType,
+		"revision": types.Int64Type,
+		"status":   types.StringType,
+	}
+}
+
+// populateFromImport records the import response and the revision of every
+// rule in the file right after the import.
+func (m *importRulesModel) populateFromImport(ctx context.Context, result *kibanaoapi.DetectionRulesImportResult, ruleIDs []string, revisions map[string]kibanaoapi.DetectionRuleRevision) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Success = types.BoolValue(result.Success)
+	m.SuccessCount = types.Int64Value(result.SuccessCount)
+	m.RulesCount = types.Int64Value(result.RulesCount)
+	m.ExceptionsSuccessCount = types.Int64Value(result.ExceptionsSuccessCount)
+	m.ActionConnectorsSuccessCount = types.Int64Value(result.ActionConnectorsSuccessCount)
+
+	importErrors := make([]importErrorModel, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		importErrors = append(importErrors, importErrorModel{
+			RuleID:     types.StringValue(e.RuleID),
+			StatusCode: types.Int64Value(int64(e.Error.StatusCode)),
+			Message:    types.StringValue(e.Error.Message),
+		})
+	}
+	var d diag.Diagnostics
+	m.Errors, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: importErrorAttrTypes()}, importErrors)
+	diags.Append(d...)
+
+	rules := make([]importedRuleModel, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		rule := importedRuleModel{
+			RuleID:   types.StringValue(ruleID),
+			ID:       types.StringNull(),
+			Revision: types.Int64Null(),
+			Status:   types.StringValue(ruleStatusMissing),
+		}
+		if current, ok := revisions[ruleID]; ok {
+			rule.ID = types.StringValue(current.ID)
+			rule.Revision = types.Int64Value(current.Revision)
+			rule.Status = types.StringValue(ruleStatusInSync)
+		}
+		rules = append(rules, rule)
+	}
+	m.Rules, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: importedRuleAttrTypes()}, rules)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (m importRulesModel) importedRules(ctx context.Context) ([]importedRuleModel, diag.Diagnostics) {
+	var rules []importedRuleModel
+	if m.Rules.IsNull() || m.Rules.IsUnknown() {
+		return rules, nil
+	}
+	diags := m.Rules.ElementsAs(ctx, &rules, false)
+	return rules, diags
+}
+
+// refreshRuleStatuses compares the current revision of each tracked rule with
+// the revision recorded at import time. The recorded id and revision are kept
+// so that drift stays visible until the next import.
+func (m *importRulesModel) refreshRuleStatuses(ctx context.Context, revisions map[string]kibanaoapi.DetectionRuleRevision) diag.Diagnostics {
+	rules, diags := m.importedRules(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, rule := range rules {
+		current, ok := revisions[rule.RuleID.ValueString()]
+		switch {
+		case !ok:
+			rules[i].Status = types.StringValue(ruleStatusMissing)
+		case rule.ID.ValueString() != current.ID || rule.Revision.IsNull() || rule.Revision.ValueInt64() != current.Revision:
+			rules[i].Status = types.StringValue(ruleStatusModified)
+		default:
+			rules[i].Status = types.StringValue(ruleStatusInSync)
+		}
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: importedRuleAttrTypes()}, rules)
+	diags.Append(d...)
+	m.Rules = list
+	return diags
+}
+
+func (m importRulesModel) trackedRuleIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	rules, diags := m.importedRules(ctx)
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, rule.RuleID.ValueString())
+	}
+	return ruleIDs, diags
+}
+
+func (m importRulesModel) driftedRuleIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	rules, diags := m.importedRules(ctx)
+	var drifted []string
+	for _, rule := range rules {
+		if rule.Status.ValueString() != ruleStatusInSync {
+			drifted = append(drifted, rule.RuleID.ValueString())
+		}
+	}
+	return drifted, diags
+}
+
+// markForReimport sets every attribute computed by an import to unknown.
+func (m *importRulesModel) markForReimport() {
+	m.Success = types.BoolUnknown()
+	m.SuccessCount = types.Int64Unknown()
+	m.RulesCount = types.Int64Unknown()
+	m.ExceptionsSuccessCount = types.Int64Unknown()
+	m.ActionConnectorsSuccessCount = types.Int64Unknown()
+	m.Errors = types.ListUnknown(types.ObjectType{AttrTypes: importErrorAttrTypes()})
+	m.Rules = types.ListUnknown(types.ObjectType{AttrTypes: importedRuleAttrTypes()})
+}
@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/clients/kibanaoapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportRulesModel_driftTracking(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var model importRulesModel
+
+	diags := model.populateFromImport(ctx, &kibanaoapi.DetectionRulesImportResult{
+		Success:      true,
+		SuccessCount: 3,
+		RulesCount:   4,
+	}, []string{"rule-1", "rule-2", "rule-3", "rule-4"}, map[string]kibanaoapi.DetectionRuleRevision{
+		"rule-1": {ID: "so-1", RuleID: "rule-1", Revision: 1},
+		"rule-2": {ID: "so-2", RuleID: "rule-2", Revision: 1},
+		"rule-3": {ID: "so-3", RuleID: "rule-3", Revision: 1},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	drifted, diags := model.driftedRuleIDs(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"rule-4"}, drifted)
+
+	ruleIDs, diags := model.trackedRuleIDs(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"rule-1", "rule-2", "rule-3", "rule-4"}, ruleIDs)
+
+	diags = model.refreshRuleStatuses(ctx, map[string]kibanaoapi.DetectionRuleRevision{
+		// unchanged
+		"rule-1": {ID: "so-1", RuleID: "rule-1", Revision: 1},
+		// edited in Kibana
+		"rule-2": {ID: "so-2", RuleID: "rule-2", Revision: 2},
+		// rule-3 deleted, rule-4 created outside of the import
+		"rule-4": {ID: "so-4", RuleID: "rule-4", Revision: 1},
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	rules, diags := model.importedRules(ctx)
+	require.False(t, diags.HasError())
+	statuses := make(map[string]string, len(rules))
+	for _, rule := range rules {
+		statuses[rule.RuleID.ValueString()] = rule.Status.ValueString()
+	}
+	assert.Equal(t, map[string]string{
+		"rule-1": ruleStatusInSync,
+		"rule-2": ruleStatusModified,
+		"rule-3": ruleStatusMissing,
+		"rule-4": ruleStatusModified,
+	}, statuses)
+
+	// The recorded revision is kept so the drift stays visible until re-import.
+	assert.Equal(t, int64(1), rules[1].Revision.ValueInt64())
+
+	drifted, diags = model.driftedRuleIDs(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"rule-2", "rule-3", "rule-4"}, drifted)
+
+	model.markForReimport()
+	assert.True(t, model.Rules.IsUnknown())
+	assert.True(t, model.SuccessCount.IsUnknown())
+}
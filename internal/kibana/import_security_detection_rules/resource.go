@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/entitycore"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource               = newImportRulesResource()
+	_ resource.ResourceWithConfigure  = newImportRulesResource()
+	_ resource.ResourceWithModifyPlan = newImportRulesResource()
+)
+
+type ImportRulesResource struct {
+	*entitycore.KibanaResource[importRulesModel]
+}
+
+func newImportRulesResource() *ImportRulesResource {
+	return &ImportRulesResource{
+		KibanaResource: entitycore.NewKibanaResource[importRulesModel](
+			entitycore.ComponentKibana,
+			"import_security_detection_rules",
+			entitycore.KibanaResourceOptions[importRulesModel]{
+				Schema: getSchema,
+				Read:   readImportedRules,
+				Delete: deleteImportedRules,
+				Create: importRules,
+				Update: importRules,
+			},
+		),
+	}
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return newImportRulesResource()
+}
+
+// ModifyPlan plans a new import when rules tracked in state drifted since the
+// last import. Drift can only be corrected with overwrite enabled, otherwise
+// the import would fail with conflicts, so it is reported as a warning.
+func (r *ImportRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state, plan importRulesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	drifted, diags := state.driftedRuleIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(drifted) == 0 {
+		return
+	}
+
+	if !plan.Overwrite.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(attrRules),
+			"Imported detection rules drifted",
+			fmt.Sprintf("The following rules were modified or deleted outside of Terraform: %s. "+
+				"Set overwrite = true to restore them on the next apply.", strings.Join(drifted, ", ")),
+		)
+		return
+	}
+
+	plan.markForReimport()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
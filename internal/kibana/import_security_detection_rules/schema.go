@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package importsecuritydetectionrules
+
+import (
+	"context"
+
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/kbschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func getSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: resourceDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Generated ID for the import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": kbschema.ResourceSpaceIDAttributeRequiresReplaceOnly(),
+			attrFileContents: schema.StringAttribute{
+				MarkdownDescription: "The contents of an NDJSON file created by the detection rules export API. Exception lists and action connectors included in the file are imported alongside the rules.",
+				Required:            true,
+			},
+			attrOverwrite: schema.BoolAttribute{
+				MarkdownDescription: "Overwrites existing rules with the same `rule_id`. Required to restore rules that drifted after the import. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			attrOverwriteExceptions: schema.BoolAttribute{
+				MarkdownDescription: "Overwrites existing exception lists with the same `list_id`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			attrOverwriteActionConnectors: schema.BoolAttribute{
+				MarkdownDescription: "Overwrites existing action connectors with the same ID. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			attrIgnoreImportErrors: schema.BoolAttribute{
+				MarkdownDescription: "If set to true, per-rule import errors do not fail the apply. They are still reported in `errors`.",
+				Optional:            true,
+			},
+			attrSuccess: schema.BoolAttribute{
+				MarkdownDescription: "Whether all rules were imported successfully.",
+				Computed:            true,
+			},
+			attrSuccessCount: schema.Int64Attribute{
+				MarkdownDescription: "Number of rules imported successfully.",
+				Computed:            true,
+			},
+			attrRulesCount: schema.Int64Attribute{
+				MarkdownDescription: "Number of rules in the imported file.",
+				Computed:            true,
+			},
+			attrExceptionsSuccessCount: schema.Int64Attribute{
+				MarkdownDescription: "Number of exception lists and items imported successfully.",
+				Computed:            true,
+			},
+			attrActionConnectorsSuccessCount: schema.Int64Attribute{
+				MarkdownDescription: "Number of action connectors imported successfully.",
+				Computed:            true,
+			},
+			attrErrors: schema.ListNestedAttribute{
+				MarkdownDescription: "Per-rule errors reported by the last import.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "The `rule_id` of the rule that failed to import.",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "HTTP status code of the error.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Error message.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			attrRules: schema.ListNestedAttribute{
+				MarkdownDescription: "Rules from `file_contents` and their state in Kibana, in file order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "The `rule_id` of the rule.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The Kibana object ID of the rule after the import.",
+							Computed:            true,
+						},
+						"revision": schema.Int64Attribute{
+							MarkdownDescription: "The rule revision recorded after the import.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "One of `in_sync`, `modified` (the rule changed in Kibana since the import) or `missing` (the rule does not exist in Kibana).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+variable "name" {
+  type = string
+}
+
+variable "rule_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_import_security_detection_rules" "test" {
+  file_contents = templatefile("${path.module}/rules.ndjson.tftpl", {
+    name    = var.name
+    rule_id = var.rule_id
+  })
+  overwrite = true
+}
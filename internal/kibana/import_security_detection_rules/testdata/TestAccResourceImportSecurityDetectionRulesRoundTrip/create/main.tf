@@ -0,0 +1,42 @@
+variable "name" {
+  type = string
+}
+
+variable "rule_id" {
+  type = string
+}
+
+variable "space_id" {
+  type = string
+}
+
+provider "elasticstack" {
+  kibana {}
+}
+
+resource "elasticstack_kibana_space" "target" {
+  space_id = var.space_id
+  name     = var.space_id
+}
+
+resource "elasticstack_kibana_security_detection_rule" "source" {
+  rule_id     = var.rule_id
+  name        = var.name
+  description = "Exported by Terraform"
+  type        = "query"
+  severity    = "low"
+  risk_score  = 21
+  enabled     = false
+  query       = "user.name:*"
+  language    = "kuery"
+  index       = ["logs-*"]
+}
+
+data "elasticstack_kibana_export_security_detection_rules" "source" {
+  rule_ids = [elasticstack_kibana_security_detection_rule.source.rule_id]
+}
+
+resource "elasticstack_kibana_import_security_detection_rules" "target" {
+  space_id      = elasticstack_kibana_space.target.space_id
+  file_contents = data.elasticstack_kibana_export_security_detection_rules.source.exported_rules
+}
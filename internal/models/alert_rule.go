@@ -39,6 +39,32 @@ type AlertingRule struct {
 	ExecutionStatus AlertingRuleExecutionStatus
 	AlertDelay      *float32
 	Flapping        *AlertingRuleFlapping
+
+	MuteAll        *bool
+	MutedAlertIDs  []string
+	SnoozeSchedule []AlertingRuleSnooze
+}
+
+// AlertingRuleSnooze is a single rule snooze in Kibana's RRule based form.
+type AlertingRuleSnooze struct {
+	ID string
+	// Duration is the snooze length in milliseconds.
+	Duration int64
+	RRule    AlertingRuleRRule
+}
+
+// AlertingRuleRRule is the recurrence rule of a snooze. Freq follows the
+// RFC 5545 numbering used by Kibana (0 yearly, 1 monthly, 2 weekly, 3 daily).
+type AlertingRuleRRule struct {
+	DTStart    string
+	TZID       string
+	Freq       *int
+	Interval   *int
+	Count      *int
+	Until      *string
+	ByWeekday  []string
+	ByMonthDay []int
+	ByMonth    []int
 }
 
 // AlertingRuleFlapping is rule-level flapping detection settings (Kibana 8.16+).
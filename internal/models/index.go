@@ -86,6 +86,27 @@ type IndexTemplateResponse struct {
 	IndexTemplate IndexTemplate `json:"index_template"`
 }
 
+// SimulatedIndexTemplate mirrors the body of the index template simulation
+// APIs. Like IndexTemplatesResponse, the resolved template is decoded as raw
+// maps so that no setting or mapping parameter is dropped.
+type SimulatedIndexTemplate struct {
+	Template    SimulatedTemplate          `json:"template"`
+	Overlapping []OverlappingIndexTemplate `json:"overlapping,omitempty"`
+}
+
+type SimulatedTemplate struct {
+	Aliases  map[string]any `json:"aliases,omitempty"`
+	Mappings map[string]any `json:"mappings,omitempty"`
+	Settings map[string]any `json:"settings,omitempty"`
+}
+
+// OverlappingIndexTemplate is a template whose index patterns overlap with the
+// simulated template, but which has a lower priority.
+type OverlappingIndexTemplate struct {
+	Name          string   `json:"name"`
+	IndexPatterns []string `json:"index_patterns"`
+}
+
 type Policy struct {
 	Name     string           `json:"-"`
 	Metadata map[string]any   `json:"_meta,omitempty"`
@@ -99,6 +120,27 @@ type Phase struct {
 
 type Action map[string]any
 
+// IlmExplainResponse mirrors the body of the ILM explain API.
+type IlmExplainResponse struct {
+	Indices map[string]IlmExplainIndex `json:"indices"`
+}
+
+// IlmExplainIndex is the lifecycle state of a single index. Fields other than
+// Index and Managed are only returned for managed indices.
+type IlmExplainIndex struct {
+	Index                string         `json:"index"`
+	Managed              bool           `json:"managed"`
+	Policy               string         `json:"policy,omitempty"`
+	Phase                string         `json:"phase,omitempty"`
+	Action               string         `json:"action,omitempty"`
+	Step                 string         `json:"step,omitempty"`
+	FailedStep           string         `json:"failed_step,omitempty"`
+	StepInfo             map[string]any `json:"step_info,omitempty"`
+	Age                  string         `json:"age,omitempty"`
+	IsAutoRetryableError *bool          `json:"is_auto_retryable_error,omitempty"`
+	FailedStepRetryCount *int64         `json:"failed_step_retry_count,omitempty"`
+}
+
 type Index struct {
 	Name     string                `json:"-"`
 	Aliases  map[string]IndexAlias `json:"aliases,omitempty"`
@@ -27,6 +27,8 @@ import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/clients/config"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr/autofollow"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr/followerindex"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr/followerstats"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ccr/promote"
 	clusterinfo "github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster/info"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster/script"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/cluster/settings"
@@ -38,12 +40,18 @@ import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/componenttemplate"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/datastream"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/datastreamlifecycle"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/datastreams"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/ilm"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/ilmactions"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/ilmexplain"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/index"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/indexactions"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/indexmappings"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/indices"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/rollover"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/template"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/templateilmattachment"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/index/templatesimulate"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/inference/inferenceendpoint"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/ingest"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/logstash"
@@ -69,22 +77,34 @@ import (
 	snapshotlifecycle "github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/snapshot/lifecycle"
 	snapshotrepo "github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/snapshot/repository"
 	snapshotrestore "github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/snapshot/restore"
+	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/snapshot/searchablesnapshot"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/synonyms"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/transform"
 	"github.com/elastic/terraform-provider-elasticstack/internal/elasticsearch/watcher/watch"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/agentactions"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/agentdownloadsource"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/agentpolicy"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/agentpolicyfull"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/agentsds"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/customintegration"
 	elasticdefendintegrationpolicy "github.com/elastic/terraform-provider-elasticstack/internal/fleet/elastic_defend_integration_policy"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/enrollmenttoken"
+	enrollmenttokenephemeral "github.com/elastic/terraform-provider-elasticstack/internal/fleet/enrollmenttoken/ephemeral"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/enrollmenttokens"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/integration"
 	integrationpolicy "github.com/elastic/terraform-provider-elasticstack/internal/fleet/integration_policy"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/integrationdatastreams"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/integrationds"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/managedintegration"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/output"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/outputds"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/outputhealth"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/packagesds"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/proxy"
 	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/serverhost"
+	fleetsettings "github.com/elastic/terraform-provider-elasticstack/internal/fleet/settings"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/spacesettings"
+	"github.com/elastic/terraform-provider-elasticstack/internal/fleet/uninstalltokens"
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/agentbuilderagent"
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/agentbuilderskill"
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/agentbuildertool"
@@ -95,7 +115,9 @@ import (
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/dataview"
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/defaultdataview"
 	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/exportsavedobjects"
+	"github.com/elastic/terraform-provider-elasticstack/internal/kibana/exportsecuritydetectionrules"
 	importsavedobjects "github.com/elastic/terraform-provider-elasticstack/internal/kibana/import_saved_objects"
+	importsecuritydetectionrules "github.com/elastic/terraform-provider-elasticstack/internal/kibana/import_security_detection_rules"
 	maintenancewindow "github.com/elastic/terraform-provider-elasticstack/internal/kibana/maintenance_window"
 	osquerypack "github.com/elastic/terraform-provider-elasticstack/internal/kibana/osquery_pack"
 	osquerysavedquery "github.com/elastic/terraform-provider-elasticstack/internal/kibana/osquery_saved_query"
@@ -199,7 +221,24 @@ func (p *Provider) Actions(_ context.Context) []func() action.Action {
 	return []func() action.Action{
 		snapshotrestore.NewRestoreAction,
 		snapshotcreate.NewCreateAction,
+		ilmactions.NewMoveToStepAction,
+		ilmactions.NewRetryAction,
+		ilmactions.NewRemovePolicyAction,
+		ilmactions.NewStartAction,
+		ilmactions.NewStopAction,
+		rollover.NewAction,
+		indexactions.NewBlockAction,
+		indexactions.NewOpenAction,
+		indexactions.NewCloseAction,
+		indexactions.NewShrinkAction,
+		indexactions.NewSplitAction,
+		indexactions.NewCloneAction,
+		promote.NewAction,
 		sync_job_create.NewAction,
+		agentactions.NewUpgradeAction,
+		agentactions.NewReassignAction,
+		agentactions.NewUnenrollAction,
+		agentactions.NewUpdateTagsAction,
 	}
 }
 
@@ -226,6 +265,7 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		apikeyephemeral.NewResource,
+		enrollmenttokenephemeral.NewResource,
 	}
 }
 
@@ -234,6 +274,7 @@ func (p *Provider) resources(_ context.Context) []func() resource.Resource {
 		agentconfiguration.NewAgentConfigurationResource,
 		sourcemap.NewSourceMapResource,
 		importsavedobjects.NewResource,
+		importsecuritydetectionrules.NewResource,
 		alertingrule.NewResource,
 		dashboard.NewResource,
 		dataview.NewResource,
@@ -262,6 +303,9 @@ func (p *Provider) resources(_ context.Context) []func() resource.Resource {
 		agentdownloadsource.NewResource,
 		serverhost.NewResource,
 		proxy.NewResource,
+		enrollmenttoken.NewResource,
+		fleetsettings.NewResource,
+		spacesettings.NewResource,
 		systemuser.NewSystemUserResource,
 		securityuser.NewUserResource,
 		role.NewRoleResource,
@@ -308,6 +352,7 @@ func (p *Provider) resources(_ context.Context) []func() resource.Resource {
 		spaces.NewResource,
 		snapshotlifecycle.NewSlmResource,
 		snapshotrepo.NewSnapshotRepositoryResource,
+		searchablesnapshot.NewMountResource,
 		transform.NewTransformResource,
 		followerindex.NewFollowerIndexResource,
 		autofollow.NewAutoFollowPatternResource,
@@ -327,20 +372,33 @@ func (p *Provider) dataSources(_ context.Context) []func() datasource.DataSource
 		snapshotrepo.NewSnapshotRepositoryDataSource,
 		clusterinfo.NewDataSource,
 		indices.NewDataSource,
+		datastreams.NewDataSource,
 		template.NewDataSource,
+		templatesimulate.NewDataSource,
+		componenttemplate.NewDataSource,
+		ilm.NewDataSource,
+		ilmexplain.NewDataSource,
+		followerstats.NewDataSource,
 		spaces.NewDataSource,
 		security_role.NewDataSource,
 		securityentitystoreresolutiongroup.NewDataSource,
 		securityentitystore.NewDataSource,
 		securityentitystoreentities.NewDataSource,
 		connectors.NewDataSource,
+		alertingrule.NewDataSource,
 		osquerysavedquery.NewDataSource,
 		agentbuilderagent.NewDataSource,
 		agentbuilderskill.NewDataSource,
 		agentbuildertool.NewDataSource,
 		agentbuilderworkflow.NewDataSource,
 		exportsavedobjects.NewDataSource,
+		exportsecuritydetectionrules.NewDataSource,
 		enrollmenttokens.NewDataSource,
+		agentpolicyfull.NewDataSource,
+		agentsds.NewDataSource,
+		uninstalltokens.NewDataSource,
+		packagesds.NewDataSource,
+		integrationdatastreams.NewDataSource,
 		integrationds.NewDataSource,
 		enrich.NewEnrichPolicyDataSource,
 		synonyms.NewSynonymSetDataSource,
@@ -350,6 +408,7 @@ func (p *Provider) dataSources(_ context.Context) []func() datasource.DataSource
 		role.NewRoleDataSource,
 		securityuser.NewUserDataSource,
 		outputds.NewDataSource,
+		outputhealth.NewDataSource,
 		osquerypack.NewDataSource,
 		ingest.NewProcessorAppendDataSource,
 		ingest.NewProcessorBytesDataSource,